@@ -2,32 +2,289 @@ package server
 
 import (
 	"sagara_backend_test/config"
+	"sagara_backend_test/internal/infrastructures/notifier"
+	"sagara_backend_test/internal/infrastructures/publisher"
 	"sagara_backend_test/internal/interfaces/dao"
 	"sagara_backend_test/internal/usecases"
+	"sagara_backend_test/internal/usecases/alert"
+	"sagara_backend_test/internal/usecases/apikey"
+	"sagara_backend_test/internal/usecases/audit"
+	"sagara_backend_test/internal/usecases/category"
+	"sagara_backend_test/internal/usecases/idempotency"
+	"sagara_backend_test/internal/usecases/importer"
+	"sagara_backend_test/internal/usecases/location"
+	"sagara_backend_test/internal/usecases/outbox"
+	"sagara_backend_test/internal/usecases/product"
+	"sagara_backend_test/internal/usecases/purchase"
+	"sagara_backend_test/internal/usecases/reservation"
+	"sagara_backend_test/internal/usecases/role"
+	"sagara_backend_test/internal/usecases/sales"
+	"sagara_backend_test/internal/usecases/tag"
 	"sagara_backend_test/internal/usecases/wardrobe"
+	"sagara_backend_test/internal/usecases/webhook"
 	"sagara_backend_test/lib/database/sql"
+	libHttp "sagara_backend_test/lib/http"
+	"sagara_backend_test/lib/txmanager"
+	"strings"
 )
 
 type container struct {
-	Cfg        config.MainConfig
-	WardrobeUc usecases.WardrobeUseCases
+	Cfg             config.MainConfig
+	WardrobeUc      usecases.WardrobeUseCases
+	ReservationUc   usecases.ReservationUseCases
+	ProductUc       usecases.ProductUseCases
+	CategoryUc      usecases.CategoryUseCases
+	TagUc           usecases.TagUseCases
+	ImportUc        usecases.ImportUseCases
+	AuditUc         usecases.AuditUseCases
+	AlertUc         usecases.AlertUseCases
+	OutboxUc        usecases.OutboxUseCases
+	WebhookUc       usecases.WebhookUseCases
+	LocationUc      usecases.LocationUseCases
+	PurchaseOrderUc usecases.PurchaseOrderUseCases
+	SalesOrderUc    usecases.SalesOrderUseCases
+	APIKeyUc        usecases.APIKeyUseCases
+	RoleUc          usecases.RoleUseCases
+	IdempotencyUc   usecases.IdempotencyUseCases
 }
 
 type options struct {
-	Cfg *config.MainConfig
-	DB  *sql.Store
+	Cfg   *config.MainConfig
+	DB    *sql.Store
+	TxMgr *txmanager.Manager
 }
 
 func newContainer(opts *options) *container {
 	wardrobeRepo := dao.NewWardrobeRepository(&dao.OptsWardrobeRepository{DB: opts.DB})
+	stockMovementRepo := dao.NewStockMovementRepository(&dao.OptsStockMovementRepository{DB: opts.DB})
+	reservationRepo := dao.NewReservationRepository(&dao.OptsReservationRepository{DB: opts.DB})
+	productRepo := dao.NewProductRepository(&dao.OptsProductRepository{DB: opts.DB})
+	categoryRepo := dao.NewCategoryRepository(&dao.OptsCategoryRepository{DB: opts.DB})
+	tagRepo := dao.NewTagRepository(&dao.OptsTagRepository{DB: opts.DB})
+	priceChangeRepo := dao.NewPriceChangeRepository(&dao.OptsPriceChangeRepository{DB: opts.DB})
+	priceScheduleRepo := dao.NewPriceScheduleRepository(&dao.OptsPriceScheduleRepository{DB: opts.DB})
+	importJobRepo := dao.NewImportJobRepository(&dao.OptsImportJobRepository{DB: opts.DB})
+	auditRepo := dao.NewAuditRepository(&dao.OptsAuditRepository{DB: opts.DB})
+	stockAlertRuleRepo := dao.NewStockAlertRuleRepository(&dao.OptsStockAlertRuleRepository{DB: opts.DB})
+	stockAlertRepo := dao.NewStockAlertRepository(&dao.OptsStockAlertRepository{DB: opts.DB})
+	outboxRepo := dao.NewOutboxRepository(&dao.OptsOutboxRepository{DB: opts.DB})
+	webhookSubscriptionRepo := dao.NewWebhookSubscriptionRepository(&dao.OptsWebhookSubscriptionRepository{DB: opts.DB})
+	webhookDeliveryRepo := dao.NewWebhookDeliveryRepository(&dao.OptsWebhookDeliveryRepository{DB: opts.DB})
+	locationRepo := dao.NewLocationRepository(&dao.OptsLocationRepository{DB: opts.DB})
+	locationStockRepo := dao.NewLocationStockRepository(&dao.OptsLocationStockRepository{DB: opts.DB})
+	stockTransferRepo := dao.NewStockTransferRepository(&dao.OptsStockTransferRepository{DB: opts.DB})
+	purchaseOrderRepo := dao.NewPurchaseOrderRepository(&dao.OptsPurchaseOrderRepository{DB: opts.DB})
+	purchaseReceiptRepo := dao.NewPurchaseReceiptRepository(&dao.OptsPurchaseReceiptRepository{DB: opts.DB})
+	salesOrderRepo := dao.NewSalesOrderRepository(&dao.OptsSalesOrderRepository{DB: opts.DB})
+	apiKeyRepo := dao.NewAPIKeyRepository(&dao.OptsAPIKeyRepository{DB: opts.DB})
+	roleAssignmentRepo := dao.NewRoleAssignmentRepository(&dao.OptsRoleAssignmentRepository{DB: opts.DB})
+	idempotencyKeyRepo := dao.NewIdempotencyKeyRepository(&dao.OptsIdempotencyKeyRepository{DB: opts.DB})
 
 	wardrobeUc := wardrobe.New(&wardrobe.Opts{
+		WardrobeRepo:      wardrobeRepo,
+		ProductRepo:       productRepo,
+		CategoryRepo:      categoryRepo,
+		StockMovementRepo: stockMovementRepo,
+		PriceChangeRepo:   priceChangeRepo,
+		PriceScheduleRepo: priceScheduleRepo,
+		AuditRepo:         auditRepo,
+		OutboxRepo:        outboxRepo,
+		AlertRuleRepo:     stockAlertRuleRepo,
+		AlertRepo:         stockAlertRepo,
+		LocationRepo:      locationRepo,
+		LocationStockRepo: locationStockRepo,
+		StockTransferRepo: stockTransferRepo,
+		TxMgr:             opts.TxMgr,
+		TrashRetention:    opts.Cfg.Trash.Retention,
+		LowStockThreshold: opts.Cfg.Alert.DefaultThreshold,
+		ExportTimeout:     opts.Cfg.Export.Timeout,
+	})
+
+	reservationUc := reservation.New(&reservation.Opts{
+		ReservationRepo: reservationRepo,
+		WardrobeRepo:    wardrobeRepo,
+		WardrobeUc:      wardrobeUc,
+		TxMgr:           opts.TxMgr,
+		DefaultTTL:      opts.Cfg.Reservation.DefaultTTL,
+		MaxTTL:          opts.Cfg.Reservation.MaxTTL,
+	})
+
+	productUc := product.New(&product.Opts{
+		ProductRepo:  productRepo,
+		WardrobeRepo: wardrobeRepo,
+		WardrobeUc:   wardrobeUc,
+		TxMgr:        opts.TxMgr,
+	})
+
+	categoryUc := category.New(&category.Opts{
+		CategoryRepo: categoryRepo,
+	})
+
+	tagUc := tag.New(&tag.Opts{
+		TagRepo:      tagRepo,
 		WardrobeRepo: wardrobeRepo,
-		TxMgr:        nil,
+		TxMgr:        opts.TxMgr,
+	})
+
+	importUc := importer.New(&importer.Opts{
+		ImportJobRepo: importJobRepo,
+		WardrobeRepo:  wardrobeRepo,
+		ProductRepo:   productRepo,
+		WardrobeUc:    wardrobeUc,
+		TxMgr:         opts.TxMgr,
+		AsyncRows:     opts.Cfg.Import.AsyncRows,
+		MaxRows:       opts.Cfg.Import.MaxRows,
+	})
+
+	locationUc := location.New(&location.Opts{
+		LocationRepo: locationRepo,
+	})
+
+	purchaseOrderUc := purchase.New(&purchase.Opts{
+		PurchaseOrderRepo: purchaseOrderRepo,
+		ReceiptRepo:       purchaseReceiptRepo,
+		WardrobeRepo:      wardrobeRepo,
+		LocationRepo:      locationRepo,
+		WardrobeUc:        wardrobeUc,
+		TxMgr:             opts.TxMgr,
+	})
+
+	salesOrderUc := sales.New(&sales.Opts{
+		SalesOrderRepo: salesOrderRepo,
+		WardrobeRepo:   wardrobeRepo,
+		LocationRepo:   locationRepo,
+		WardrobeUc:     wardrobeUc,
+		TxMgr:          opts.TxMgr,
+	})
+
+	apiKeyUc := apikey.New(&apikey.Opts{
+		APIKeyRepo: apiKeyRepo,
+	})
+
+	var admins []string
+	for _, subject := range strings.Split(opts.Cfg.Auth.Admins, ",") {
+		if subject = strings.TrimSpace(subject); subject != "" {
+			admins = append(admins, subject)
+		}
+	}
+
+	roleUc := role.New(&role.Opts{
+		RoleAssignmentRepo: roleAssignmentRepo,
+		Admins:             admins,
+		DefaultRole:        opts.Cfg.Auth.DefaultRole,
+	})
+
+	idempotencyUc := idempotency.New(&idempotency.Opts{
+		IdempotencyKeyRepo: idempotencyKeyRepo,
+		TTL:                opts.Cfg.Idempotency.TTL,
+		LockTimeout:        opts.Cfg.Idempotency.LockTimeout,
+	})
+
+	auditUc := audit.New(&audit.Opts{
+		AuditRepo: auditRepo,
+	})
+
+	alertUc := alert.New(&alert.Opts{
+		AlertRuleRepo:     stockAlertRuleRepo,
+		AlertRepo:         stockAlertRepo,
+		WardrobeRepo:      wardrobeRepo,
+		CategoryRepo:      categoryRepo,
+		WardrobeUc:        wardrobeUc,
+		TxMgr:             opts.TxMgr,
+		Notifiers:         newNotifiers(&opts.Cfg.Alert),
+		NotifyBatch:       opts.Cfg.Alert.NotifyBatch,
+		MaxNotifyAttempts: opts.Cfg.Alert.MaxNotifyAttempts,
+	})
+
+	webhookUc := webhook.New(&webhook.Opts{
+		SubscriptionRepo: webhookSubscriptionRepo,
+		DeliveryRepo:     webhookDeliveryRepo,
+		TxMgr:            opts.TxMgr,
+		Timeout:          opts.Cfg.Webhook.Timeout,
+		Retry: libHttp.RetryConfig{
+			MaxRetry:          opts.Cfg.Webhook.MaxRetry,
+			RetryInitialDelay: opts.Cfg.Webhook.RetryInitialDelay,
+			MaxJitter:         opts.Cfg.Webhook.MaxJitter,
+			WithBackOff:       opts.Cfg.Webhook.WithBackOff,
+		},
+		BatchSize:    opts.Cfg.Webhook.BatchSize,
+		DisableAfter: opts.Cfg.Webhook.DisableAfter,
+	})
+
+	outboxUc := outbox.New(&outbox.Opts{
+		OutboxRepo: outboxRepo,
+		// queuing the webhook deliveries of an event published twice is a no-op
+		Publisher:      publisher.NewFanout(publisher.Func(webhookUc.EnqueueEvent), newPublisher(&opts.Cfg.Outbox.Publisher)),
+		TxMgr:          opts.TxMgr,
+		BatchSize:      opts.Cfg.Outbox.BatchSize,
+		Lease:          opts.Cfg.Outbox.Lease,
+		PublishTimeout: opts.Cfg.Outbox.PublishTimeout,
+		MaxAttempts:    opts.Cfg.Outbox.MaxAttempts,
+		RetryDelay:     opts.Cfg.Outbox.RetryDelay,
+		MaxRetryDelay:  opts.Cfg.Outbox.MaxRetryDelay,
 	})
 
 	return &container{
-		Cfg:        *opts.Cfg,
-		WardrobeUc: wardrobeUc,
+		Cfg:             *opts.Cfg,
+		WardrobeUc:      wardrobeUc,
+		ReservationUc:   reservationUc,
+		ProductUc:       productUc,
+		CategoryUc:      categoryUc,
+		TagUc:           tagUc,
+		ImportUc:        importUc,
+		AuditUc:         auditUc,
+		AlertUc:         alertUc,
+		OutboxUc:        outboxUc,
+		WebhookUc:       webhookUc,
+		LocationUc:      locationUc,
+		PurchaseOrderUc: purchaseOrderUc,
+		SalesOrderUc:    salesOrderUc,
+		APIKeyUc:        apiKeyUc,
+		RoleUc:          roleUc,
+		IdempotencyUc:   idempotencyUc,
+	}
+}
+
+// newPublisher returns the publisher of the domain events, the log one unless http is configured
+func newPublisher(cfg *config.OutboxPublisherConfig) publisher.Publisher {
+	if cfg.Kind == publisher.KindHTTP {
+		return publisher.NewHTTP(&publisher.HTTPOptions{
+			URL:     cfg.URL,
+			Timeout: cfg.Timeout,
+		})
 	}
+
+	return publisher.NewLog()
+}
+
+// newNotifiers returns the alert channels that are configured
+func newNotifiers(cfg *config.AlertConfig) []notifier.Notifier {
+	var notifiers []notifier.Notifier
+
+	if cfg.Webhook.URL != "" {
+		notifiers = append(notifiers, notifier.NewWebhook(&notifier.WebhookOptions{
+			URL:     cfg.Webhook.URL,
+			Timeout: cfg.Webhook.Timeout,
+		}))
+	}
+
+	if cfg.SMTP.Host != "" {
+		var to []string
+		for _, recipient := range strings.Split(cfg.SMTP.To, ",") {
+			if recipient = strings.TrimSpace(recipient); recipient != "" {
+				to = append(to, recipient)
+			}
+		}
+
+		notifiers = append(notifiers, notifier.NewSMTP(&notifier.SMTPOptions{
+			Host:     cfg.SMTP.Host,
+			Port:     cfg.SMTP.Port,
+			Username: cfg.SMTP.Username,
+			Password: cfg.SMTP.Password,
+			From:     cfg.SMTP.From,
+			To:       to,
+		}))
+	}
+
+	return notifiers
 }
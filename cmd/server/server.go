@@ -1,13 +1,17 @@
 package server
 
 import (
+	"context"
 	"github.com/spf13/cobra"
 	"os"
 	"os/signal"
 	"sagara_backend_test/config"
 	"sagara_backend_test/internal/handler/api"
+	"sagara_backend_test/internal/handler/scheduler"
 	"sagara_backend_test/lib/database/sql"
 	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/txmanager"
+	txSql "sagara_backend_test/lib/txmanager/sql"
 	"syscall"
 )
 
@@ -40,19 +44,54 @@ func run(cmd *cobra.Command, args []string) error {
 		ConnMaxLifetime: cfg.Database.ConnMaxLifetime,
 	}, sql.DriverPostgres)
 
+	txMgr, err := txmanager.New(context.Background(), &txmanager.DriverConfig{
+		Type:   "sql",
+		Config: txSql.Config{DB: database},
+	})
+	if err != nil {
+		log.Fatal("Could not initiate transaction manager: " + err.Error())
+	}
+
 	appContainer := newContainer(&options{
-		Cfg: cfg,
-		DB:  database,
+		Cfg:   cfg,
+		DB:    database,
+		TxMgr: txMgr,
 	})
 
 	server := api.New(&api.Options{
-		Cfg:        appContainer.Cfg,
-		WardrobeUc: appContainer.WardrobeUc,
+		Cfg:             appContainer.Cfg,
+		WardrobeUc:      appContainer.WardrobeUc,
+		ReservationUc:   appContainer.ReservationUc,
+		ProductUc:       appContainer.ProductUc,
+		CategoryUc:      appContainer.CategoryUc,
+		TagUc:           appContainer.TagUc,
+		ImportUc:        appContainer.ImportUc,
+		AuditUc:         appContainer.AuditUc,
+		AlertUc:         appContainer.AlertUc,
+		WebhookUc:       appContainer.WebhookUc,
+		LocationUc:      appContainer.LocationUc,
+		PurchaseOrderUc: appContainer.PurchaseOrderUc,
+		SalesOrderUc:    appContainer.SalesOrderUc,
+		APIKeyUc:        appContainer.APIKeyUc,
+		RoleUc:          appContainer.RoleUc,
+		IdempotencyUc:   appContainer.IdempotencyUc,
+	})
+
+	jobs := scheduler.New(&scheduler.Options{
+		Cfg:           appContainer.Cfg,
+		ReservationUc: appContainer.ReservationUc,
+		WardrobeUc:    appContainer.WardrobeUc,
+		AlertUc:       appContainer.AlertUc,
+		OutboxUc:      appContainer.OutboxUc,
+		WebhookUc:     appContainer.WebhookUc,
+		IdempotencyUc: appContainer.IdempotencyUc,
 	})
 
 	go server.Run()
+	jobs.Start()
+	defer jobs.Stop()
 
-	term := make(chan os.Signal)
+	term := make(chan os.Signal, 1)
 	signal.Notify(term, os.Interrupt, syscall.SIGTERM)
 	select {
 	case <-term:
@@ -8,9 +8,20 @@ import (
 
 type (
 	MainConfig struct {
-		Server   ServerConfig `yaml:"Server"`
-		API      APIConfig    `yaml:"API"`
-		Database DBConfig     `yaml:"Database"`
+		Server      ServerConfig      `yaml:"Server"`
+		API         APIConfig         `yaml:"API"`
+		Database    DBConfig          `yaml:"Database"`
+		Reservation ReservationConfig `yaml:"Reservation"`
+		Pricing     PricingConfig     `yaml:"Pricing"`
+		Trash       TrashConfig       `yaml:"Trash"`
+		Import      ImportConfig      `yaml:"Import"`
+		Export      ExportConfig      `yaml:"Export"`
+		Alert       AlertConfig       `yaml:"Alert"`
+		Outbox      OutboxConfig      `yaml:"Outbox"`
+		Webhook     WebhookConfig     `yaml:"Webhook"`
+		Auth        AuthConfig        `yaml:"Auth"`
+		RateLimit   RateLimitConfig   `yaml:"RateLimit"`
+		Idempotency IdempotencyConfig `yaml:"Idempotency"`
 	}
 
 	ServerConfig struct {
@@ -23,6 +34,8 @@ type (
 		BasePath      string        `yaml:"BasePath" env:"API_BASE_PATH"`
 		APITimeout    time.Duration `yaml:"APITimeout" env:"API_TIMEOUT"`
 		EnableSwagger bool          `yaml:"EnableSwagger" env:"API_ENABLE_SWAGGER" default:"false"`
+		// ProxyHeader holds the IP of the client behind a proxy that overwrites it, such as X-Forwarded-For
+		ProxyHeader string `yaml:"ProxyHeader" env:"API_PROXY_HEADER"`
 	}
 
 	DBConfig struct {
@@ -33,6 +46,136 @@ type (
 		MaxConn         int    `yaml:"MaxConn" env:"DB_MAX_CONN"`
 		ConnMaxLifetime string `yaml:"ConnMaxLifetime" env:"DB_CONN_MAX_LIFETIME"`
 	}
+
+	ReservationConfig struct {
+		DefaultTTL    time.Duration `yaml:"DefaultTTL" env:"RESERVATION_DEFAULT_TTL" default:"15m"`
+		MaxTTL        time.Duration `yaml:"MaxTTL" env:"RESERVATION_MAX_TTL" default:"24h"`
+		SweepInterval time.Duration `yaml:"SweepInterval" env:"RESERVATION_SWEEP_INTERVAL" default:"1m"`
+	}
+
+	PricingConfig struct {
+		ScheduleInterval time.Duration `yaml:"ScheduleInterval" env:"PRICING_SCHEDULE_INTERVAL" default:"1m"`
+	}
+
+	TrashConfig struct {
+		// Retention is how long a deleted wardrobe stays in the trash before it can be purged
+		Retention     time.Duration `yaml:"Retention" env:"TRASH_RETENTION" default:"720h"`
+		PurgeInterval time.Duration `yaml:"PurgeInterval" env:"TRASH_PURGE_INTERVAL" default:"24h"`
+	}
+
+	ImportConfig struct {
+		// AsyncRows is the number of rows above which a file is imported in the background
+		AsyncRows int `yaml:"AsyncRows" env:"IMPORT_ASYNC_ROWS" default:"200"`
+		MaxRows   int `yaml:"MaxRows" env:"IMPORT_MAX_ROWS" default:"10000"`
+	}
+
+	ExportConfig struct {
+		// Timeout is how long an export may stream before it is given up, a slow download included
+		Timeout time.Duration `yaml:"Timeout" env:"EXPORT_TIMEOUT" default:"10m"`
+	}
+
+	AlertConfig struct {
+		// DefaultThreshold applies to the wardrobes without an alert rule, and to GET /v1/wardrobe/less without an amount
+		DefaultThreshold int           `yaml:"DefaultThreshold" env:"ALERT_DEFAULT_THRESHOLD" default:"5"`
+		NotifyInterval   time.Duration `yaml:"NotifyInterval" env:"ALERT_NOTIFY_INTERVAL" default:"30s"`
+		NotifyBatch      int           `yaml:"NotifyBatch" env:"ALERT_NOTIFY_BATCH" default:"50"`
+		// MaxNotifyAttempts is how many times an alert is sent before its notification is given up
+		MaxNotifyAttempts int                `yaml:"MaxNotifyAttempts" env:"ALERT_MAX_NOTIFY_ATTEMPTS" default:"5"`
+		Webhook           AlertWebhookConfig `yaml:"Webhook"`
+		SMTP              AlertSMTPConfig    `yaml:"SMTP"`
+	}
+
+	// AlertWebhookConfig posts the alerts as json to URL, an empty URL disables the channel
+	AlertWebhookConfig struct {
+		URL     string        `yaml:"URL" env:"ALERT_WEBHOOK_URL"`
+		Timeout time.Duration `yaml:"Timeout" env:"ALERT_WEBHOOK_TIMEOUT" default:"10s"`
+	}
+
+	// AlertSMTPConfig mails the alerts, an empty Host disables the channel. Without a Username no authentication is made,
+	// which is what a local SMTP stand-in such as MailHog expects.
+	AlertSMTPConfig struct {
+		Host     string `yaml:"Host" env:"ALERT_SMTP_HOST"`
+		Port     int    `yaml:"Port" env:"ALERT_SMTP_PORT" default:"25"`
+		Username string `yaml:"Username" env:"ALERT_SMTP_USERNAME"`
+		Password string `yaml:"Password" env:"ALERT_SMTP_PASSWORD"`
+		From     string `yaml:"From" env:"ALERT_SMTP_FROM"`
+		// To is a comma separated list of recipients
+		To string `yaml:"To" env:"ALERT_SMTP_TO"`
+	}
+
+	OutboxConfig struct {
+		RelayInterval time.Duration `yaml:"RelayInterval" env:"OUTBOX_RELAY_INTERVAL" default:"5s"`
+		BatchSize     int           `yaml:"BatchSize" env:"OUTBOX_BATCH_SIZE" default:"100"`
+		// Lease is how long a claimed batch is kept from the other instances, it must be longer than PublishTimeout
+		Lease time.Duration `yaml:"Lease" env:"OUTBOX_LEASE" default:"5m"`
+		// PublishTimeout bounds the publishing of a single event
+		PublishTimeout time.Duration `yaml:"PublishTimeout" env:"OUTBOX_PUBLISH_TIMEOUT" default:"30s"`
+		// MaxAttempts is how many times an event is published before it is dead lettered
+		MaxAttempts int `yaml:"MaxAttempts" env:"OUTBOX_MAX_ATTEMPTS" default:"10"`
+		// RetryDelay is doubled after every failed attempt up to MaxRetryDelay
+		RetryDelay    time.Duration         `yaml:"RetryDelay" env:"OUTBOX_RETRY_DELAY" default:"5s"`
+		MaxRetryDelay time.Duration         `yaml:"MaxRetryDelay" env:"OUTBOX_MAX_RETRY_DELAY" default:"10m"`
+		Publisher     OutboxPublisherConfig `yaml:"Publisher"`
+	}
+
+	// OutboxPublisherConfig picks where the events go, log writes them to the service log and http posts them to URL
+	OutboxPublisherConfig struct {
+		Kind    string        `yaml:"Kind" env:"OUTBOX_PUBLISHER_KIND" default:"log"`
+		URL     string        `yaml:"URL" env:"OUTBOX_PUBLISHER_URL"`
+		Timeout time.Duration `yaml:"Timeout" env:"OUTBOX_PUBLISHER_TIMEOUT" default:"10s"`
+	}
+
+	// WebhookConfig drives the delivery job, MaxRetry, RetryInitialDelay, WithBackOff and MaxJitter space the attempts
+	// of a delivery the way the lib/http retries do
+	WebhookConfig struct {
+		DeliverInterval   time.Duration `yaml:"DeliverInterval" env:"WEBHOOK_DELIVER_INTERVAL" default:"10s"`
+		BatchSize         int           `yaml:"BatchSize" env:"WEBHOOK_BATCH_SIZE" default:"50"`
+		Timeout           time.Duration `yaml:"Timeout" env:"WEBHOOK_TIMEOUT" default:"10s"`
+		MaxRetry          int           `yaml:"MaxRetry" env:"WEBHOOK_MAX_RETRY" default:"8"`
+		RetryInitialDelay time.Duration `yaml:"RetryInitialDelay" env:"WEBHOOK_RETRY_INITIAL_DELAY" default:"30s"`
+		WithBackOff       bool          `yaml:"WithBackOff" env:"WEBHOOK_WITH_BACKOFF" default:"true"`
+		MaxJitter         time.Duration `yaml:"MaxJitter" env:"WEBHOOK_MAX_JITTER" default:"0s"`
+		// DisableAfter is how many attempts in a row can fail before the webhook is disabled
+		DisableAfter int `yaml:"DisableAfter" env:"WEBHOOK_DISABLE_AFTER" default:"20"`
+	}
+
+	// AuthConfig verifies the bearer tokens of the API, Secret for HS256 and one of JWKSFile or JWKSURL for RS256 and
+	// ES256. Disabled makes every route public and is only meant for local development.
+	// Admins is a comma separated list of subjects that are always admin, DefaultRole is the role of the subjects
+	// without an assignment.
+	AuthConfig struct {
+		Disabled    bool          `yaml:"Disabled" env:"AUTH_DISABLED" default:"false"`
+		Secret      string        `yaml:"Secret" env:"AUTH_SECRET"`
+		JWKSFile    string        `yaml:"JWKSFile" env:"AUTH_JWKS_FILE"`
+		JWKSURL     string        `yaml:"JWKSURL" env:"AUTH_JWKS_URL"`
+		JWKSRefresh time.Duration `yaml:"JWKSRefresh" env:"AUTH_JWKS_REFRESH" default:"1h"`
+		Issuer      string        `yaml:"Issuer" env:"AUTH_ISSUER"`
+		Audience    string        `yaml:"Audience" env:"AUTH_AUDIENCE"`
+		Leeway      time.Duration `yaml:"Leeway" env:"AUTH_LEEWAY" default:"30s"`
+		Admins      string        `yaml:"Admins" env:"AUTH_ADMINS"`
+		DefaultRole string        `yaml:"DefaultRole" env:"AUTH_DEFAULT_ROLE" default:"read-only"`
+	}
+
+	// RateLimitConfig is the token bucket of every caller on the routes without a limit of their own, Burst requests
+	// at once refilled at Limit requests per Period. A Limit of 0 turns the rate limits off. The buckets are kept in
+	// the memory of each instance.
+	// IPLimit is the bucket of every IP, taken per Period before the credentials are checked so guessing them is
+	// limited too. The callers behind the same IP share it, an IPLimit of 0 turns it off.
+	RateLimitConfig struct {
+		Limit   int           `yaml:"Limit" env:"RATE_LIMIT_LIMIT" default:"300"`
+		Period  time.Duration `yaml:"Period" env:"RATE_LIMIT_PERIOD" default:"1m"`
+		Burst   int           `yaml:"Burst" env:"RATE_LIMIT_BURST" default:"0"`
+		IPLimit int           `yaml:"IPLimit" env:"RATE_LIMIT_IP_LIMIT" default:"1200"`
+	}
+
+	// IdempotencyConfig keeps the responses of the requests sent with an Idempotency-Key for TTL, a retry within it gets
+	// the stored response. LockTimeout is how long a request being processed holds its key, a retry after it runs a
+	// request that never answered again.
+	IdempotencyConfig struct {
+		TTL           time.Duration `yaml:"TTL" env:"IDEMPOTENCY_TTL" default:"24h"`
+		LockTimeout   time.Duration `yaml:"LockTimeout" env:"IDEMPOTENCY_LOCK_TIMEOUT" default:"1m"`
+		PurgeInterval time.Duration `yaml:"PurgeInterval" env:"IDEMPOTENCY_PURGE_INTERVAL" default:"1h"`
+	}
 )
 
 func ReadConfig(cfg any, configLocation string) {
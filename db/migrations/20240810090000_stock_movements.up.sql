@@ -0,0 +1,10 @@
+CREATE TABLE "stock_movements" (
+    id uuid NOT NULL PRIMARY KEY,
+    wardrobe_id uuid NOT NULL REFERENCES wardrobe (id) ON DELETE CASCADE,
+    delta int NOT NULL,
+    reason varchar(255),
+    balance int NOT NULL,
+    created_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_stock_movements_wardrobe_id ON stock_movements (wardrobe_id, created_at);
\ No newline at end of file
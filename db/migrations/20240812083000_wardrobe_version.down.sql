@@ -0,0 +1 @@
+ALTER TABLE wardrobe DROP COLUMN IF EXISTS version;
@@ -0,0 +1,12 @@
+CREATE TABLE "stock_reservations" (
+    id uuid NOT NULL PRIMARY KEY,
+    wardrobe_id uuid NOT NULL REFERENCES wardrobe (id) ON DELETE CASCADE,
+    quantity int NOT NULL,
+    status varchar(20) NOT NULL,
+    expires_at TIMESTAMP(6) WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_stock_reservations_active ON stock_reservations (wardrobe_id) WHERE status = 'active';
+CREATE INDEX idx_stock_reservations_expires_at ON stock_reservations (expires_at) WHERE status = 'active';
\ No newline at end of file
@@ -0,0 +1,6 @@
+ALTER TABLE wardrobe
+    DROP COLUMN IF EXISTS product_id,
+    DROP COLUMN IF EXISTS sku,
+    DROP COLUMN IF EXISTS price_overridden;
+
+DROP TABLE IF EXISTS products;
@@ -0,0 +1,16 @@
+CREATE TABLE "products" (
+    id uuid NOT NULL PRIMARY KEY,
+    name varchar(255) NOT NULL,
+    description text NOT NULL DEFAULT '',
+    price float DEFAULT 0,
+    created_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+ALTER TABLE wardrobe
+    ADD COLUMN product_id uuid REFERENCES products (id),
+    ADD COLUMN sku varchar(64),
+    ADD COLUMN price_overridden boolean NOT NULL DEFAULT false;
+
+CREATE UNIQUE INDEX idx_wardrobe_sku ON wardrobe (sku);
+CREATE INDEX idx_wardrobe_product_id ON wardrobe (product_id);
\ No newline at end of file
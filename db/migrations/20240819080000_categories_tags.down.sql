@@ -0,0 +1,6 @@
+ALTER TABLE wardrobe
+    DROP COLUMN IF EXISTS category_id;
+
+DROP TABLE IF EXISTS wardrobe_tags;
+DROP TABLE IF EXISTS tags;
+DROP TABLE IF EXISTS categories;
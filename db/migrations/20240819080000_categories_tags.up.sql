@@ -0,0 +1,29 @@
+CREATE TABLE "categories" (
+    id uuid NOT NULL PRIMARY KEY,
+    parent_id uuid REFERENCES categories (id),
+    name varchar(255) NOT NULL,
+    created_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_categories_parent_id ON categories (parent_id);
+
+CREATE TABLE "tags" (
+    id uuid NOT NULL PRIMARY KEY,
+    name varchar(100) NOT NULL UNIQUE,
+    created_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE TABLE "wardrobe_tags" (
+    wardrobe_id uuid NOT NULL REFERENCES wardrobe (id) ON DELETE CASCADE,
+    tag_id uuid NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+    PRIMARY KEY (wardrobe_id, tag_id)
+);
+
+CREATE INDEX idx_wardrobe_tags_tag_id ON wardrobe_tags (tag_id);
+
+ALTER TABLE wardrobe
+    ADD COLUMN category_id uuid REFERENCES categories (id);
+
+CREATE INDEX idx_wardrobe_category_id ON wardrobe (category_id);
\ No newline at end of file
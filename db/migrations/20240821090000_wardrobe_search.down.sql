@@ -0,0 +1,4 @@
+ALTER TABLE wardrobe
+    DROP COLUMN IF EXISTS search_vector;
+
+DROP FUNCTION IF EXISTS immutable_unaccent(text);
@@ -0,0 +1,14 @@
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+-- unaccent is only STABLE, generated columns and indexes need an IMMUTABLE function
+CREATE OR REPLACE FUNCTION immutable_unaccent(text) RETURNS text AS
+$$ SELECT public.unaccent('public.unaccent', $1) $$
+LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT;
+
+ALTER TABLE wardrobe
+    ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', immutable_unaccent(coalesce(name, ''))), 'A') ||
+        setweight(to_tsvector('simple', immutable_unaccent(coalesce(color, '') || ' ' || coalesce(sku, ''))), 'B')
+    ) STORED;
+
+CREATE INDEX idx_wardrobe_search_vector ON wardrobe USING GIN (search_vector);
\ No newline at end of file
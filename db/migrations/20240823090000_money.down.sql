@@ -0,0 +1,11 @@
+ALTER TABLE products
+    DROP CONSTRAINT chk_products_price,
+    DROP COLUMN currency,
+    ALTER COLUMN price DROP NOT NULL,
+    ALTER COLUMN price TYPE float USING price::float;
+
+ALTER TABLE wardrobe
+    DROP CONSTRAINT chk_wardrobe_price,
+    DROP COLUMN currency,
+    ALTER COLUMN price DROP NOT NULL,
+    ALTER COLUMN price TYPE float USING price::float;
@@ -0,0 +1,14 @@
+ALTER TABLE wardrobe
+    ALTER COLUMN price TYPE numeric(19, 4) USING round(coalesce(price, 0)::numeric, 4),
+    ALTER COLUMN price SET DEFAULT 0,
+    ALTER COLUMN price SET NOT NULL,
+    ADD COLUMN currency char(3) NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE products
+    ALTER COLUMN price TYPE numeric(19, 4) USING round(coalesce(price, 0)::numeric, 4),
+    ALTER COLUMN price SET DEFAULT 0,
+    ALTER COLUMN price SET NOT NULL,
+    ADD COLUMN currency char(3) NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE wardrobe ADD CONSTRAINT chk_wardrobe_price CHECK (price >= 0);
+ALTER TABLE products ADD CONSTRAINT chk_products_price CHECK (price >= 0);
\ No newline at end of file
@@ -0,0 +1,34 @@
+CREATE TABLE "price_schedules" (
+    id uuid NOT NULL PRIMARY KEY,
+    wardrobe_id uuid NOT NULL REFERENCES wardrobe (id) ON DELETE CASCADE,
+    price numeric(19, 4) NOT NULL CHECK (price >= 0),
+    currency char(3) NOT NULL,
+    effective_from TIMESTAMP(6) WITH TIME ZONE NOT NULL,
+    effective_until TIMESTAMP(6) WITH TIME ZONE CHECK (effective_until > effective_from),
+    status varchar(20) NOT NULL,
+    reason varchar(255) NOT NULL DEFAULT '',
+    created_by varchar(255) NOT NULL,
+    previous_price numeric(19, 4),
+    previous_currency char(3),
+    created_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_price_schedules_wardrobe_id ON price_schedules (wardrobe_id, effective_from);
+CREATE INDEX idx_price_schedules_pending ON price_schedules (effective_from) WHERE status = 'pending';
+CREATE INDEX idx_price_schedules_active ON price_schedules (effective_until) WHERE status = 'active';
+
+CREATE TABLE "price_changes" (
+    id uuid NOT NULL PRIMARY KEY,
+    wardrobe_id uuid NOT NULL REFERENCES wardrobe (id) ON DELETE CASCADE,
+    schedule_id uuid REFERENCES price_schedules (id) ON DELETE SET NULL,
+    old_price numeric(19, 4) NOT NULL,
+    old_currency char(3) NOT NULL,
+    new_price numeric(19, 4) NOT NULL,
+    new_currency char(3) NOT NULL,
+    changed_by varchar(255) NOT NULL,
+    reason varchar(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_price_changes_wardrobe_id ON price_changes (wardrobe_id, created_at);
\ No newline at end of file
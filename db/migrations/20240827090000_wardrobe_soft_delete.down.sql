@@ -0,0 +1,8 @@
+DELETE FROM wardrobe WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_wardrobe_deleted_at;
+DROP INDEX IF EXISTS idx_wardrobe_sku;
+CREATE UNIQUE INDEX idx_wardrobe_sku ON wardrobe (sku);
+
+ALTER TABLE "wardrobe"
+    DROP COLUMN IF EXISTS deleted_at;
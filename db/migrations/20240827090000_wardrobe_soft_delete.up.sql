@@ -0,0 +1,7 @@
+ALTER TABLE "wardrobe"
+    ADD COLUMN deleted_at TIMESTAMP(6) WITH TIME ZONE;
+
+-- a deleted wardrobe gives its sku back, restoring it fails while another wardrobe holds the sku
+DROP INDEX idx_wardrobe_sku;
+CREATE UNIQUE INDEX idx_wardrobe_sku ON wardrobe (sku) WHERE deleted_at IS NULL;
+CREATE INDEX idx_wardrobe_deleted_at ON wardrobe (deleted_at) WHERE deleted_at IS NOT NULL;
\ No newline at end of file
@@ -0,0 +1,17 @@
+CREATE TABLE "import_jobs" (
+    id uuid NOT NULL PRIMARY KEY,
+    file_name varchar(255) NOT NULL DEFAULT '',
+    format varchar(10) NOT NULL,
+    dry_run boolean NOT NULL DEFAULT false,
+    status varchar(20) NOT NULL,
+    total_rows integer NOT NULL DEFAULT 0,
+    created integer NOT NULL DEFAULT 0,
+    updated integer NOT NULL DEFAULT 0,
+    failed integer NOT NULL DEFAULT 0,
+    report jsonb NOT NULL DEFAULT '[]',
+    error text NOT NULL DEFAULT '',
+    created_by varchar(255) NOT NULL,
+    finished_at TIMESTAMP(6) WITH TIME ZONE,
+    created_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now()
+);
\ No newline at end of file
@@ -0,0 +1,14 @@
+CREATE TABLE "audit_logs" (
+    id uuid NOT NULL PRIMARY KEY,
+    entity varchar(50) NOT NULL,
+    entity_id uuid NOT NULL,
+    action varchar(20) NOT NULL,
+    actor varchar(255) NOT NULL,
+    request_id varchar(255) NOT NULL DEFAULT '',
+    diff jsonb NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_audit_logs_entity ON audit_logs (entity, entity_id, created_at);
+CREATE INDEX idx_audit_logs_actor ON audit_logs (actor, created_at);
+CREATE INDEX idx_audit_logs_created_at ON audit_logs (created_at);
\ No newline at end of file
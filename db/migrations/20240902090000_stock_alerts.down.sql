@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS stock_alerts;
+DROP TABLE IF EXISTS stock_alert_rules;
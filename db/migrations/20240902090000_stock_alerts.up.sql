@@ -0,0 +1,35 @@
+CREATE TABLE "stock_alert_rules" (
+    id uuid NOT NULL PRIMARY KEY,
+    wardrobe_id uuid REFERENCES wardrobe (id) ON DELETE CASCADE,
+    category_id uuid REFERENCES categories (id) ON DELETE CASCADE,
+    threshold int NOT NULL,
+    created_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now(),
+    CONSTRAINT stock_alert_rules_target CHECK ((wardrobe_id IS NULL) <> (category_id IS NULL))
+);
+
+CREATE UNIQUE INDEX idx_stock_alert_rules_wardrobe_id ON stock_alert_rules (wardrobe_id) WHERE wardrobe_id IS NOT NULL;
+CREATE UNIQUE INDEX idx_stock_alert_rules_category_id ON stock_alert_rules (category_id) WHERE category_id IS NOT NULL;
+
+CREATE TABLE "stock_alerts" (
+    id uuid NOT NULL PRIMARY KEY,
+    wardrobe_id uuid NOT NULL REFERENCES wardrobe (id) ON DELETE CASCADE,
+    rule_id uuid REFERENCES stock_alert_rules (id) ON DELETE SET NULL,
+    threshold int NOT NULL,
+    stock int NOT NULL,
+    status varchar(20) NOT NULL,
+    notified_channels jsonb NOT NULL DEFAULT '[]',
+    notified_at TIMESTAMP(6) WITH TIME ZONE,
+    notify_attempts int NOT NULL DEFAULT 0,
+    notify_error text NOT NULL DEFAULT '',
+    acknowledged_by varchar(255),
+    acknowledged_at TIMESTAMP(6) WITH TIME ZONE,
+    resolved_at TIMESTAMP(6) WITH TIME ZONE,
+    created_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+-- a wardrobe has at most one alert until its stock is back above the threshold
+CREATE UNIQUE INDEX idx_stock_alerts_unresolved ON stock_alerts (wardrobe_id) WHERE status <> 'resolved';
+CREATE INDEX idx_stock_alerts_status ON stock_alerts (status, created_at);
+CREATE INDEX idx_stock_alerts_unnotified ON stock_alerts (created_at) WHERE notified_at IS NULL;
\ No newline at end of file
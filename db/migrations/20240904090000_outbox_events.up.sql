@@ -0,0 +1,21 @@
+CREATE TABLE "outbox_events" (
+    id uuid NOT NULL PRIMARY KEY,
+    seq bigserial NOT NULL UNIQUE,
+    aggregate_type varchar(50) NOT NULL,
+    aggregate_id uuid NOT NULL,
+    event_type varchar(100) NOT NULL,
+    payload jsonb NOT NULL,
+    actor varchar(255) NOT NULL,
+    request_id varchar(255) NOT NULL DEFAULT '',
+    status varchar(20) NOT NULL DEFAULT 'pending',
+    attempts int NOT NULL DEFAULT 0,
+    last_error text NOT NULL DEFAULT '',
+    next_attempt_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now(),
+    published_at TIMESTAMP(6) WITH TIME ZONE,
+    created_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_outbox_events_pending ON outbox_events (seq) WHERE status = 'pending';
+CREATE INDEX idx_outbox_events_aggregate ON outbox_events (aggregate_id, seq) WHERE status = 'pending';
+CREATE INDEX idx_outbox_events_status ON outbox_events (status, created_at);
\ No newline at end of file
@@ -0,0 +1,44 @@
+CREATE TABLE "webhook_subscriptions" (
+    id uuid NOT NULL PRIMARY KEY,
+    url text NOT NULL,
+    event_types jsonb NOT NULL DEFAULT '[]',
+    secret varchar(255) NOT NULL,
+    active boolean NOT NULL DEFAULT true,
+    consecutive_failures int NOT NULL DEFAULT 0,
+    disabled_at TIMESTAMP(6) WITH TIME ZONE,
+    disabled_reason text NOT NULL DEFAULT '',
+    created_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE TABLE "webhook_deliveries" (
+    id uuid NOT NULL PRIMARY KEY,
+    subscription_id uuid NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+    event_id uuid NOT NULL,
+    event_type varchar(100) NOT NULL,
+    payload jsonb NOT NULL,
+    status varchar(20) NOT NULL DEFAULT 'pending',
+    attempts int NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now(),
+    delivered_at TIMESTAMP(6) WITH TIME ZONE,
+    last_error text NOT NULL DEFAULT '',
+    created_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX uq_webhook_deliveries_event ON webhook_deliveries (subscription_id, event_id);
+CREATE INDEX idx_webhook_deliveries_pending ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX idx_webhook_deliveries_subscription ON webhook_deliveries (subscription_id, created_at);
+
+CREATE TABLE "webhook_delivery_attempts" (
+    id uuid NOT NULL PRIMARY KEY,
+    delivery_id uuid NOT NULL REFERENCES webhook_deliveries (id) ON DELETE CASCADE,
+    attempt int NOT NULL,
+    status_code int NOT NULL DEFAULT 0,
+    response_body text NOT NULL DEFAULT '',
+    error text NOT NULL DEFAULT '',
+    duration_ms bigint NOT NULL DEFAULT 0,
+    created_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_webhook_delivery_attempts_delivery ON webhook_delivery_attempts (delivery_id, created_at);
\ No newline at end of file
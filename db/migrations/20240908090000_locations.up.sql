@@ -0,0 +1,47 @@
+CREATE TABLE "locations" (
+    id uuid NOT NULL PRIMARY KEY,
+    code varchar(50) NOT NULL,
+    name varchar(255) NOT NULL,
+    kind varchar(20) NOT NULL,
+    is_default boolean NOT NULL DEFAULT false,
+    created_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_locations_code ON locations (code);
+CREATE UNIQUE INDEX idx_locations_default ON locations (is_default) WHERE is_default;
+
+CREATE TABLE "wardrobe_stocks" (
+    wardrobe_id uuid NOT NULL REFERENCES wardrobe (id) ON DELETE CASCADE,
+    location_id uuid NOT NULL REFERENCES locations (id) ON DELETE CASCADE,
+    quantity int NOT NULL CHECK (quantity >= 0),
+    updated_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now(),
+    PRIMARY KEY (wardrobe_id, location_id)
+);
+
+CREATE INDEX idx_wardrobe_stocks_location_id ON wardrobe_stocks (location_id);
+
+CREATE TABLE "stock_transfers" (
+    id uuid NOT NULL PRIMARY KEY,
+    wardrobe_id uuid NOT NULL REFERENCES wardrobe (id) ON DELETE CASCADE,
+    from_location_id uuid NOT NULL REFERENCES locations (id),
+    to_location_id uuid NOT NULL REFERENCES locations (id),
+    quantity int NOT NULL CHECK (quantity > 0),
+    status varchar(20) NOT NULL,
+    note text NOT NULL DEFAULT '',
+    created_by varchar(255) NOT NULL,
+    received_at TIMESTAMP(6) WITH TIME ZONE,
+    cancelled_at TIMESTAMP(6) WITH TIME ZONE,
+    created_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now(),
+    CHECK (from_location_id <> to_location_id)
+);
+
+CREATE INDEX idx_stock_transfers_wardrobe_id ON stock_transfers (wardrobe_id, created_at);
+CREATE INDEX idx_stock_transfers_status ON stock_transfers (status, created_at);
+
+-- the stock held so far is moved to a default warehouse, wardrobe.stock stays the sum over the locations
+INSERT INTO locations (id, code, name, kind, is_default) VALUES (gen_random_uuid(), 'MAIN', 'Main warehouse', 'warehouse', true);
+
+INSERT INTO wardrobe_stocks (wardrobe_id, location_id, quantity)
+SELECT w.id, l.id, w.stock FROM wardrobe w CROSS JOIN locations l WHERE l.is_default AND w.stock > 0;
\ No newline at end of file
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS purchase_receipt_lines;
+DROP TABLE IF EXISTS purchase_receipts;
+DROP TABLE IF EXISTS purchase_order_lines;
+DROP TABLE IF EXISTS purchase_orders;
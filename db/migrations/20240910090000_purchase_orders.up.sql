@@ -0,0 +1,49 @@
+CREATE TABLE "purchase_orders" (
+    id uuid NOT NULL PRIMARY KEY,
+    supplier varchar(255) NOT NULL,
+    currency char(3) NOT NULL,
+    location_id uuid REFERENCES locations (id),
+    status varchar(20) NOT NULL,
+    note text NOT NULL DEFAULT '',
+    created_by varchar(255) NOT NULL,
+    sent_at TIMESTAMP(6) WITH TIME ZONE,
+    received_at TIMESTAMP(6) WITH TIME ZONE,
+    cancelled_at TIMESTAMP(6) WITH TIME ZONE,
+    created_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_purchase_orders_status ON purchase_orders (status, created_at);
+
+CREATE TABLE "purchase_order_lines" (
+    id uuid NOT NULL PRIMARY KEY,
+    purchase_order_id uuid NOT NULL REFERENCES purchase_orders (id) ON DELETE CASCADE,
+    wardrobe_id uuid NOT NULL REFERENCES wardrobe (id),
+    quantity int NOT NULL CHECK (quantity > 0),
+    unit_cost numeric(19, 4) NOT NULL CHECK (unit_cost >= 0),
+    received_quantity int NOT NULL DEFAULT 0,
+    created_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now(),
+    CHECK (received_quantity >= 0 AND received_quantity <= quantity),
+    UNIQUE (purchase_order_id, wardrobe_id)
+);
+
+CREATE INDEX idx_purchase_order_lines_wardrobe_id ON purchase_order_lines (wardrobe_id);
+
+CREATE TABLE "purchase_receipts" (
+    id uuid NOT NULL PRIMARY KEY,
+    purchase_order_id uuid NOT NULL REFERENCES purchase_orders (id) ON DELETE CASCADE,
+    location_id uuid NOT NULL REFERENCES locations (id),
+    note text NOT NULL DEFAULT '',
+    received_by varchar(255) NOT NULL,
+    created_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_purchase_receipts_purchase_order_id ON purchase_receipts (purchase_order_id, created_at);
+
+CREATE TABLE "purchase_receipt_lines" (
+    receipt_id uuid NOT NULL REFERENCES purchase_receipts (id) ON DELETE CASCADE,
+    line_id uuid NOT NULL REFERENCES purchase_order_lines (id) ON DELETE CASCADE,
+    quantity int NOT NULL CHECK (quantity > 0),
+    PRIMARY KEY (receipt_id, line_id)
+);
\ No newline at end of file
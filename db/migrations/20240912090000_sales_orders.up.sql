@@ -0,0 +1,29 @@
+CREATE TABLE "sales_orders" (
+    id uuid NOT NULL PRIMARY KEY,
+    customer varchar(255) NOT NULL DEFAULT '',
+    currency char(3) NOT NULL,
+    total numeric(19, 4) NOT NULL,
+    location_id uuid NOT NULL REFERENCES locations (id),
+    status varchar(20) NOT NULL,
+    note text NOT NULL DEFAULT '',
+    created_by varchar(255) NOT NULL,
+    fulfilled_at TIMESTAMP(6) WITH TIME ZONE,
+    cancelled_at TIMESTAMP(6) WITH TIME ZONE,
+    created_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_sales_orders_created_at ON sales_orders (created_at);
+CREATE INDEX idx_sales_orders_status ON sales_orders (status, created_at);
+
+CREATE TABLE "sales_order_lines" (
+    id uuid NOT NULL PRIMARY KEY,
+    sales_order_id uuid NOT NULL REFERENCES sales_orders (id) ON DELETE CASCADE,
+    wardrobe_id uuid NOT NULL REFERENCES wardrobe (id),
+    quantity int NOT NULL CHECK (quantity > 0),
+    unit_price numeric(19, 4) NOT NULL,
+    created_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now(),
+    UNIQUE (sales_order_id, wardrobe_id)
+);
+
+CREATE INDEX idx_sales_order_lines_wardrobe_id ON sales_order_lines (wardrobe_id);
\ No newline at end of file
@@ -0,0 +1,13 @@
+CREATE TABLE "api_keys" (
+    id uuid NOT NULL PRIMARY KEY,
+    name varchar(255) NOT NULL,
+    prefix varchar(32) NOT NULL UNIQUE,
+    secret_hash char(64) NOT NULL,
+    scopes text[] NOT NULL DEFAULT '{}',
+    created_by varchar(255) NOT NULL,
+    expires_at TIMESTAMP(6) WITH TIME ZONE,
+    last_used_at TIMESTAMP(6) WITH TIME ZONE,
+    revoked_at TIMESTAMP(6) WITH TIME ZONE,
+    created_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now()
+);
\ No newline at end of file
@@ -0,0 +1,7 @@
+CREATE TABLE "role_assignments" (
+    subject varchar(255) NOT NULL PRIMARY KEY,
+    role varchar(32) NOT NULL CHECK (role IN ('admin', 'manager', 'clerk', 'read-only')),
+    assigned_by varchar(255) NOT NULL,
+    created_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now()
+);
\ No newline at end of file
@@ -0,0 +1,13 @@
+CREATE TABLE "idempotency_keys" (
+    key text NOT NULL PRIMARY KEY,
+    fingerprint char(64) NOT NULL,
+    status_code int,
+    content_type varchar(255) NOT NULL DEFAULT '',
+    body bytea,
+    locked_until TIMESTAMP(6) WITH TIME ZONE NOT NULL,
+    expires_at TIMESTAMP(6) WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now(),
+    completed_at TIMESTAMP(6) WITH TIME ZONE
+);
+
+CREATE INDEX idx_idempotency_keys_expires_at ON idempotency_keys (expires_at);
\ No newline at end of file
@@ -0,0 +1,84 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"slices"
+	"time"
+)
+
+const (
+	StockAlertOpen         = "open"
+	StockAlertAcknowledged = "acknowledged"
+	// StockAlertResolved means the stock went back to the threshold or above, a new crossing raises a new alert
+	StockAlertResolved = "resolved"
+)
+
+// StockAlertRule sets the threshold under which the stock of a wardrobe raises an alert. It targets either one wardrobe
+// or a category with its descendants, the rule of the wardrobe wins over the rule of its closest category.
+type StockAlertRule struct {
+	BaseModel
+	ID         uuid.UUID  `db:"id"`
+	WardrobeID *uuid.UUID `db:"wardrobe_id"`
+	CategoryID *uuid.UUID `db:"category_id"`
+	Threshold  int        `db:"threshold"`
+}
+
+// StockAlert is raised once when the stock of a wardrobe falls below the threshold of its rule
+type StockAlert struct {
+	BaseModel
+	ID         uuid.UUID  `db:"id"`
+	WardrobeID uuid.UUID  `db:"wardrobe_id"`
+	RuleID     *uuid.UUID `db:"rule_id"`
+	Threshold  int        `db:"threshold"`
+	// Stock is the available stock that raised the alert
+	Stock  int    `db:"stock"`
+	Status string `db:"status"`
+
+	// NotifiedChannels are the channels the alert was delivered to, NotifiedAt is set once all of them got it
+	NotifiedChannels NotifiedChannels `db:"notified_channels"`
+	NotifiedAt       *time.Time       `db:"notified_at"`
+	NotifyAttempts   int              `db:"notify_attempts"`
+	NotifyError      string           `db:"notify_error"`
+
+	AcknowledgedBy *string    `db:"acknowledged_by"`
+	AcknowledgedAt *time.Time `db:"acknowledged_at"`
+	ResolvedAt     *time.Time `db:"resolved_at"`
+}
+
+// StockAlertSortFields are the fields the alerts can be sorted by
+var StockAlertSortFields = SortFields{"created_at": SortTime}
+
+// NotifiedChannels is stored as a json column
+type NotifiedChannels []string
+
+func (c NotifiedChannels) Contains(channel string) bool {
+	return slices.Contains(c, channel)
+}
+
+func (c *NotifiedChannels) Scan(src any) error {
+	switch value := src.(type) {
+	case nil:
+		*c = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(value, c)
+	case string:
+		return json.Unmarshal([]byte(value), c)
+	default:
+		return fmt.Errorf("can not scan %T into NotifiedChannels", src)
+	}
+}
+
+func (c NotifiedChannels) Value() (driver.Value, error) {
+	if c == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,32 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"time"
+)
+
+// APIKey lets a machine call the API without an interactive login. Only the hash of its secret is kept, Prefix finds
+// the key back from what the caller sends.
+type APIKey struct {
+	BaseModel
+	ID         uuid.UUID      `db:"id"`
+	Name       string         `db:"name"`
+	Prefix     string         `db:"prefix"`
+	SecretHash string         `db:"secret_hash"`
+	Scopes     pq.StringArray `db:"scopes"`
+	CreatedBy  string         `db:"created_by"`
+	ExpiresAt  *time.Time     `db:"expires_at"`
+	LastUsedAt *time.Time     `db:"last_used_at"`
+	RevokedAt  *time.Time     `db:"revoked_at"`
+}
+
+// IsActive tells whether the key can still be used at the time
+func (k *APIKey) IsActive(at time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || at.Before(*k.ExpiresAt))
+}
+
+// Subject is who the key acts as, the same for the claims and the role assignments
+func (k *APIKey) Subject() string {
+	return "api-key:" + k.ID.String()
+}
@@ -0,0 +1,132 @@
+package model
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"time"
+)
+
+const AuditEntityWardrobe = "wardrobe"
+
+const (
+	AuditActionCreate      = "create"
+	AuditActionUpdate      = "update"
+	AuditActionDelete      = "delete"
+	AuditActionRestore     = "restore"
+	AuditActionStockChange = "stock_change"
+)
+
+// AuditLog records one change made to an entity, it is written in the transaction of the change
+type AuditLog struct {
+	ID        uuid.UUID `db:"id"`
+	Entity    string    `db:"entity"`
+	EntityID  uuid.UUID `db:"entity_id"`
+	Action    string    `db:"action"`
+	Actor     string    `db:"actor"`
+	RequestID string    `db:"request_id"`
+	Diff      AuditDiff `db:"diff"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// AuditFilter narrows the audit logs down, empty fields are not filtered on
+type AuditFilter struct {
+	Entity   string
+	EntityID *uuid.UUID
+	Actor    string
+	From     *time.Time
+	To       *time.Time
+}
+
+// AuditSortFields are the fields the audit logs can be sorted by
+var AuditSortFields = SortFields{"created_at": SortTime}
+
+// AuditChange is the value of a field before and after the change, a side is null when the entity did not exist
+type AuditChange struct {
+	Before json.RawMessage `json:"before"`
+	After  json.RawMessage `json:"after"`
+}
+
+// AuditDiff holds the changed fields by their json name, it is stored as a json column
+type AuditDiff map[string]AuditChange
+
+// NewAuditDiff compares the json forms of before and after field by field, either may be nil
+func NewAuditDiff(before, after any) (AuditDiff, error) {
+	beforeFields, err := jsonFields(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := jsonFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := AuditDiff{}
+	for field, value := range afterFields {
+		if !bytes.Equal(beforeFields[field], value) {
+			diff[field] = AuditChange{Before: nullIfMissing(beforeFields[field]), After: value}
+		}
+	}
+	for field, value := range beforeFields {
+		if _, found := afterFields[field]; !found {
+			diff[field] = AuditChange{Before: value, After: nullIfMissing(nil)}
+		}
+	}
+
+	return diff, nil
+}
+
+func jsonFields(value any) (map[string]json.RawMessage, error) {
+	fields := map[string]json.RawMessage{}
+	if value == nil {
+		return fields, nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(data, []byte("null")) {
+		return fields, nil
+	}
+
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+func nullIfMissing(value json.RawMessage) json.RawMessage {
+	if value == nil {
+		return json.RawMessage("null")
+	}
+	return value
+}
+
+func (d *AuditDiff) Scan(src any) error {
+	switch value := src.(type) {
+	case nil:
+		*d = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(value, d)
+	case string:
+		return json.Unmarshal([]byte(value), d)
+	default:
+		return fmt.Errorf("can not scan %T into AuditDiff", src)
+	}
+}
+
+func (d AuditDiff) Value() (driver.Value, error) {
+	if d == nil {
+		return "{}", nil
+	}
+	data, err := json.Marshal(d)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
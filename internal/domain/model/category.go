@@ -0,0 +1,11 @@
+package model
+
+import "github.com/google/uuid"
+
+// Category is a node of the category tree, root categories have no parent
+type Category struct {
+	BaseModel
+	ID       uuid.UUID  `db:"id"`
+	ParentID *uuid.UUID `db:"parent_id"`
+	Name     string     `db:"name"`
+}
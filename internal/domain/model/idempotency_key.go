@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// IdempotencyKey remembers a request sent with an Idempotency-Key so its retries are answered without running it again.
+// StatusCode is empty while the request is processed, LockedUntil is when a retry may take over a request that never
+// answered.
+type IdempotencyKey struct {
+	Key         string     `db:"key"`
+	Fingerprint string     `db:"fingerprint"`
+	StatusCode  *int       `db:"status_code"`
+	ContentType string     `db:"content_type"`
+	Body        []byte     `db:"body"`
+	LockedUntil time.Time  `db:"locked_until"`
+	ExpiresAt   time.Time  `db:"expires_at"`
+	CreatedAt   time.Time  `db:"created_at"`
+	CompletedAt *time.Time `db:"completed_at"`
+}
@@ -0,0 +1,79 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	ImportJobPending   = "pending"
+	ImportJobRunning   = "running"
+	ImportJobSucceeded = "succeeded"
+	// ImportJobFailed means nothing was imported, the report tells which rows were at fault
+	ImportJobFailed = "failed"
+)
+
+const (
+	ImportActionCreate = "create"
+	ImportActionUpdate = "update"
+	ImportActionError  = "error"
+)
+
+// ImportJob is one upload of a wardrobe file, it keeps the report of every row once it is done
+type ImportJob struct {
+	BaseModel
+	ID         uuid.UUID    `db:"id"`
+	FileName   string       `db:"file_name"`
+	Format     string       `db:"format"`
+	DryRun     bool         `db:"dry_run"`
+	Status     string       `db:"status"`
+	TotalRows  int          `db:"total_rows"`
+	Created    int          `db:"created"`
+	Updated    int          `db:"updated"`
+	Failed     int          `db:"failed"`
+	Report     ImportReport `db:"report"`
+	Error      string       `db:"error"`
+	CreatedBy  string       `db:"created_by"`
+	FinishedAt *time.Time   `db:"finished_at"`
+}
+
+// ImportRowResult is what happened, or would happen on a dry run, to one row of the file
+type ImportRowResult struct {
+	// Row is the line of the file, the header being line 1
+	Row        int        `json:"row"`
+	Action     string     `json:"action"`
+	SKU        string     `json:"sku,omitempty"`
+	WardrobeID *uuid.UUID `json:"wardrobe_id,omitempty"`
+	Errors     []string   `json:"errors,omitempty"`
+}
+
+// ImportReport is stored as a json column
+type ImportReport []ImportRowResult
+
+func (r *ImportReport) Scan(src any) error {
+	switch value := src.(type) {
+	case nil:
+		*r = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(value, r)
+	case string:
+		return json.Unmarshal([]byte(value), r)
+	default:
+		return fmt.Errorf("can not scan %T into ImportReport", src)
+	}
+}
+
+func (r ImportReport) Value() (driver.Value, error) {
+	if r == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	LocationStore     = "store"
+	LocationWarehouse = "warehouse"
+)
+
+const (
+	// TransferInTransit transfers have left their origin, the quantity is in none of the locations meanwhile
+	TransferInTransit = "in_transit"
+	TransferReceived  = "received"
+	// TransferCancelled transfers went back to their origin
+	TransferCancelled = "cancelled"
+)
+
+// Location is a store or a warehouse holding stock. The default location takes the stock changes that do not name one.
+type Location struct {
+	BaseModel
+	ID        uuid.UUID `db:"id"`
+	Code      string    `db:"code"`
+	Name      string    `db:"name"`
+	Kind      string    `db:"kind"`
+	IsDefault bool      `db:"is_default"`
+}
+
+// LocationStock is the stock of a wardrobe at a location, the stock of the wardrobe is the sum over its locations
+type LocationStock struct {
+	WardrobeID   uuid.UUID `db:"wardrobe_id"`
+	LocationID   uuid.UUID `db:"location_id"`
+	LocationCode string    `db:"location_code"`
+	LocationName string    `db:"location_name"`
+	IsDefault    bool      `db:"is_default"`
+	Quantity     int       `db:"quantity"`
+	UpdatedAt    time.Time `db:"updated_at"`
+}
+
+// StockTransfer moves a quantity of a wardrobe between two locations, it is taken from the origin when it is created
+// and added to the destination when it is received
+type StockTransfer struct {
+	BaseModel
+	ID             uuid.UUID  `db:"id"`
+	WardrobeID     uuid.UUID  `db:"wardrobe_id"`
+	FromLocationID uuid.UUID  `db:"from_location_id"`
+	ToLocationID   uuid.UUID  `db:"to_location_id"`
+	Quantity       int        `db:"quantity"`
+	Status         string     `db:"status"`
+	Note           string     `db:"note"`
+	CreatedBy      string     `db:"created_by"`
+	ReceivedAt     *time.Time `db:"received_at"`
+	CancelledAt    *time.Time `db:"cancelled_at"`
+}
+
+// StockTransferFilter narrows the transfers down, empty fields are not filtered on
+type StockTransferFilter struct {
+	WardrobeID *uuid.UUID
+	LocationID *uuid.UUID
+	Status     string
+}
+
+// StockTransferSortFields are the fields the transfers can be sorted by
+var StockTransferSortFields = SortFields{"created_at": SortTime}
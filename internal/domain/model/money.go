@@ -0,0 +1,151 @@
+package model
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// MoneyScale is the number of decimals a Money keeps, it matches the scale of the numeric price columns
+const MoneyScale = 4
+
+const moneyUnit = 10000
+
+var ErrMoneyInvalid = errors.New("money must be a decimal number with at most 4 decimals")
+
+// Money is an exact decimal amount stored as an integer count of 1/10000,
+// it never goes through a float and is written to JSON as a string. The currency is kept next to it.
+type Money int64
+
+// ParseMoney reads a plain decimal such as "12.5" or "-0.25", exponents are not accepted
+func ParseMoney(s string) (Money, error) {
+	s = strings.TrimSpace(s)
+
+	negative := strings.HasPrefix(s, "-")
+	if negative || strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+
+	whole, fraction, _ := strings.Cut(s, ".")
+	if (whole == "" && fraction == "") || len(fraction) > MoneyScale || !isDigits(whole) || !isDigits(fraction) {
+		return 0, ErrMoneyInvalid
+	}
+
+	if whole == "" {
+		whole = "0"
+	}
+	units, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || units > math.MaxInt64/moneyUnit-1 {
+		return 0, ErrMoneyInvalid
+	}
+
+	var cents int64
+	if fraction != "" {
+		cents, _ = strconv.ParseInt(fraction+strings.Repeat("0", MoneyScale-len(fraction)), 10, 64)
+	}
+
+	amount := units*moneyUnit + cents
+	if negative {
+		amount = -amount
+	}
+	return Money(amount), nil
+}
+
+// NormalizeCurrency makes currency codes compare equal regardless of case and surrounding spaces
+func NormalizeCurrency(currency string) string {
+	return strings.ToUpper(strings.TrimSpace(currency))
+}
+
+func (m Money) IsNegative() bool {
+	return m < 0
+}
+
+// Mul is the amount of quantity items at m each, e.g. the total of an order line
+func (m Money) Mul(quantity int) Money {
+	return m * Money(quantity)
+}
+
+// String formats the amount with at least two decimals, e.g. "12.50" or "0.1234"
+func (m Money) String() string {
+	sign := ""
+	amount := int64(m)
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	fraction := strings.TrimRight(fmt.Sprintf("%04d", amount%moneyUnit), "0")
+	for len(fraction) < 2 {
+		fraction += "0"
+	}
+
+	return fmt.Sprintf("%s%d.%s", sign, amount/moneyUnit, fraction)
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(m.String())), nil
+}
+
+// UnmarshalJSON accepts the amount either as a string or as a bare number, the number is read as text so no precision is lost
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	text := string(data)
+	if unquoted, err := strconv.Unquote(text); err == nil {
+		text = unquoted
+	}
+
+	money, err := ParseMoney(text)
+	if err != nil {
+		return err
+	}
+
+	*m = money
+	return nil
+}
+
+// Scan reads a numeric column, which the driver hands over as text
+func (m *Money) Scan(src any) error {
+	switch value := src.(type) {
+	case nil:
+		*m = 0
+		return nil
+	case []byte:
+		return m.scanText(string(value))
+	case string:
+		return m.scanText(value)
+	case int64:
+		*m = Money(value * moneyUnit)
+		return nil
+	default:
+		return fmt.Errorf("can not scan %T into Money", src)
+	}
+}
+
+func (m *Money) scanText(text string) error {
+	money, err := ParseMoney(text)
+	if err != nil {
+		return err
+	}
+	*m = money
+	return nil
+}
+
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	// OutboxPending events are waiting to be published, or to be retried after a failure
+	OutboxPending = "pending"
+	// OutboxPublished events were accepted by the publisher
+	OutboxPublished = "published"
+	// OutboxDead events failed too many times, they are kept for inspection and no longer block their aggregate
+	OutboxDead = "dead"
+)
+
+const OutboxAggregateWardrobe = "wardrobe"
+
+const (
+	EventWardrobeCreated      = "wardrobe.created"
+	EventWardrobeUpdated      = "wardrobe.updated"
+	EventWardrobeDeleted      = "wardrobe.deleted"
+	EventWardrobeRestored     = "wardrobe.restored"
+	EventWardrobeStockChanged = "wardrobe.stock_changed"
+)
+
+// OutboxEvent is a domain event written in the transaction of the change it describes, it is published afterward by
+// the outbox relay. Seq orders the events, the events of an aggregate are published in that order.
+type OutboxEvent struct {
+	ID            uuid.UUID  `db:"id"`
+	Seq           int64      `db:"seq"`
+	AggregateType string     `db:"aggregate_type"`
+	AggregateID   uuid.UUID  `db:"aggregate_id"`
+	EventType     string     `db:"event_type"`
+	Payload       []byte     `db:"payload"`
+	Actor         string     `db:"actor"`
+	RequestID     string     `db:"request_id"`
+	Status        string     `db:"status"`
+	Attempts      int        `db:"attempts"`
+	LastError     string     `db:"last_error"`
+	NextAttemptAt time.Time  `db:"next_attempt_at"`
+	PublishedAt   *time.Time `db:"published_at"`
+	CreatedAt     time.Time  `db:"created_at"`
+	UpdatedAt     time.Time  `db:"updated_at"`
+}
@@ -0,0 +1,101 @@
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"maps"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// SortKind is the type of a sort field, the cursor values of the field must parse as it
+type SortKind int
+
+const (
+	SortText SortKind = iota
+	SortInt
+	SortNumeric
+	SortTime
+)
+
+// numericValue is a plain decimal, the way numeric and float cursor values are written
+var numericValue = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+// Valid reports whether the database can compare the cursor value with a column of this kind
+func (k SortKind) Valid(value string) bool {
+	switch k {
+	case SortInt:
+		_, err := strconv.ParseInt(value, 10, 32)
+		return err == nil
+	case SortNumeric:
+		return numericValue.MatchString(value)
+	case SortTime:
+		t, err := time.Parse(time.RFC3339Nano, value)
+		// postgres has no year zero
+		return err == nil && t.Year() > 0
+	default:
+		return utf8.ValidString(value) && !strings.ContainsRune(value, 0)
+	}
+}
+
+// SortFields are the fields a list can be sorted by, with their kind
+type SortFields map[string]SortKind
+
+// With returns a copy of the fields with one more field
+func (f SortFields) With(field string, kind SortKind) SortFields {
+	fields := maps.Clone(f)
+	fields[field] = kind
+	return fields
+}
+
+// SortField orders a page by one field, ascending unless Desc
+type SortField struct {
+	Field string
+	Desc  bool
+}
+
+// Page asks for one page of a keyset paginated list
+type Page struct {
+	Limit int
+	Sort  []SortField
+
+	// After is the cursor of the last row of the previous page, empty for the first page
+	After *PageCursor
+
+	// WithTotal also counts every row matching the filter, it costs an extra query
+	WithTotal bool
+}
+
+// PageCursor holds the sort values of a row followed by its id, in the order of the page sort
+type PageCursor struct {
+	Values []string
+}
+
+// PageInfo describes the page that was returned, Next is empty on the last page
+type PageInfo struct {
+	Next  *PageCursor
+	Total *int64
+}
+
+func (c *PageCursor) Encode() string {
+	raw, _ := json.Marshal(c.Values)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func DecodePageCursor(cursor string) (*PageCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []string
+	err = json.Unmarshal(raw, &values)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PageCursor{Values: values}, nil
+}
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	PriceSchedulePending = "pending"
+	// PriceScheduleActive is applied and waits for its EffectiveUntil to restore the previous price
+	PriceScheduleActive = "active"
+	// PriceScheduleApplied is an open ended schedule that has been applied for good
+	PriceScheduleApplied   = "applied"
+	PriceScheduleEnded     = "ended"
+	PriceScheduleCancelled = "cancelled"
+)
+
+const (
+	PriceReasonScheduled = "scheduled price"
+	PriceReasonRestored  = "scheduled price ended"
+)
+
+// PriceChange is an immutable history row, every price change on a wardrobe is recorded as one
+type PriceChange struct {
+	ID          uuid.UUID  `db:"id"`
+	WardrobeID  uuid.UUID  `db:"wardrobe_id"`
+	ScheduleID  *uuid.UUID `db:"schedule_id"`
+	OldPrice    Money      `db:"old_price"`
+	OldCurrency string     `db:"old_currency"`
+	NewPrice    Money      `db:"new_price"`
+	NewCurrency string     `db:"new_currency"`
+	ChangedBy   string     `db:"changed_by"`
+	Reason      string     `db:"reason"`
+	CreatedAt   time.Time  `db:"created_at"`
+}
+
+// PriceSchedule is a future price of a wardrobe, it is applied at EffectiveFrom
+// and the price it replaced is restored at EffectiveUntil when there is one
+type PriceSchedule struct {
+	BaseModel
+	ID             uuid.UUID  `db:"id"`
+	WardrobeID     uuid.UUID  `db:"wardrobe_id"`
+	Price          Money      `db:"price"`
+	Currency       string     `db:"currency"`
+	EffectiveFrom  time.Time  `db:"effective_from"`
+	EffectiveUntil *time.Time `db:"effective_until"`
+	Status         string     `db:"status"`
+	Reason         string     `db:"reason"`
+	CreatedBy      string     `db:"created_by"`
+
+	// PreviousPrice and PreviousCurrency are kept once applied, to be restored at EffectiveUntil
+	PreviousPrice    *Money  `db:"previous_price"`
+	PreviousCurrency *string `db:"previous_currency"`
+}
+
+// Overlaps tells whether both schedules would be in effect at the same time, open ended schedules last forever
+func (p *PriceSchedule) Overlaps(other *PriceSchedule) bool {
+	startsBeforeOtherEnds := other.EffectiveUntil == nil || p.EffectiveFrom.Before(*other.EffectiveUntil)
+	endsAfterOtherStarts := p.EffectiveUntil == nil || p.EffectiveUntil.After(other.EffectiveFrom)
+	return startsBeforeOtherEnds && endsAfterOtherStarts
+}
@@ -0,0 +1,13 @@
+package model
+
+import "github.com/google/uuid"
+
+// Product groups the color and size variants of one item, its variants are stored as Wardrobe
+type Product struct {
+	BaseModel
+	ID          uuid.UUID `db:"id"`
+	Name        string    `db:"name"`
+	Description string    `db:"description"`
+	Price       Money     `db:"price"`
+	Currency    string    `db:"currency"`
+}
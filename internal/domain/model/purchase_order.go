@@ -0,0 +1,80 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	PurchaseOrderDraft = "draft"
+	// PurchaseOrderSent orders wait for the goods, they can not be edited anymore
+	PurchaseOrderSent              = "sent"
+	PurchaseOrderPartiallyReceived = "partially_received"
+	PurchaseOrderReceived          = "received"
+	// PurchaseOrderCancelled orders keep the goods received before the cancellation
+	PurchaseOrderCancelled = "cancelled"
+)
+
+// PurchaseOrder orders wardrobes from a supplier, its stock is added when the goods are received.
+// The goods go to LocationID, or to the default location when it is empty.
+type PurchaseOrder struct {
+	BaseModel
+	ID          uuid.UUID  `db:"id"`
+	Supplier    string     `db:"supplier"`
+	Currency    string     `db:"currency"`
+	LocationID  *uuid.UUID `db:"location_id"`
+	Status      string     `db:"status"`
+	Note        string     `db:"note"`
+	CreatedBy   string     `db:"created_by"`
+	SentAt      *time.Time `db:"sent_at"`
+	ReceivedAt  *time.Time `db:"received_at"`
+	CancelledAt *time.Time `db:"cancelled_at"`
+	// Total is the cost of every line, it is computed when the order is read
+	Total Money `db:"total"`
+}
+
+// IsOpen tells whether goods can still be received for the order
+func (p *PurchaseOrder) IsOpen() bool {
+	return p.Status == PurchaseOrderSent || p.Status == PurchaseOrderPartiallyReceived
+}
+
+type PurchaseOrderLine struct {
+	BaseModel
+	ID               uuid.UUID `db:"id"`
+	PurchaseOrderID  uuid.UUID `db:"purchase_order_id"`
+	WardrobeID       uuid.UUID `db:"wardrobe_id"`
+	Quantity         int       `db:"quantity"`
+	UnitCost         Money     `db:"unit_cost"`
+	ReceivedQuantity int       `db:"received_quantity"`
+}
+
+// Outstanding is the quantity of the line still to be received
+func (p *PurchaseOrderLine) Outstanding() int {
+	return p.Quantity - p.ReceivedQuantity
+}
+
+// PurchaseReceipt records one delivery of goods against a purchase order
+type PurchaseReceipt struct {
+	ID              uuid.UUID             `db:"id"`
+	PurchaseOrderID uuid.UUID             `db:"purchase_order_id"`
+	LocationID      uuid.UUID             `db:"location_id"`
+	Note            string                `db:"note"`
+	ReceivedBy      string                `db:"received_by"`
+	CreatedAt       time.Time             `db:"created_at"`
+	Lines           []PurchaseReceiptLine `db:"-"`
+}
+
+type PurchaseReceiptLine struct {
+	ReceiptID uuid.UUID `db:"receipt_id"`
+	LineID    uuid.UUID `db:"line_id"`
+	Quantity  int       `db:"quantity"`
+}
+
+// PurchaseOrderFilter narrows the purchase orders down, empty fields are not filtered on
+type PurchaseOrderFilter struct {
+	Status   string
+	Supplier string
+}
+
+// PurchaseOrderSortFields are the fields the purchase orders can be sorted by
+var PurchaseOrderSortFields = SortFields{"created_at": SortTime}
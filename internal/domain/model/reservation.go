@@ -0,0 +1,24 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	ReservationActive    = "active"
+	ReservationCommitted = "committed"
+	ReservationReleased  = "released"
+	ReservationExpired   = "expired"
+)
+
+// Reservation holds stock of a wardrobe for a while without deducting it,
+// only active and unexpired reservations count against the available stock
+type Reservation struct {
+	BaseModel
+	ID         uuid.UUID `db:"id"`
+	WardrobeID uuid.UUID `db:"wardrobe_id"`
+	Quantity   int       `db:"quantity"`
+	Status     string    `db:"status"`
+	ExpiresAt  time.Time `db:"expires_at"`
+}
@@ -0,0 +1,56 @@
+package model
+
+import (
+	"sagara_backend_test/pkg/constants"
+	"slices"
+)
+
+const (
+	RoleAdmin   = "admin"
+	RoleManager = "manager"
+	// RoleClerk adjusts the stock and edits the wardrobes, but can not change their prices or delete them
+	RoleClerk    = "clerk"
+	RoleReadOnly = "read-only"
+)
+
+// Roles are every role that can be assigned, from the most to the least trusted
+var Roles = []string{RoleAdmin, RoleManager, RoleClerk, RoleReadOnly}
+
+var rolePermissions = map[string][]string{
+	RoleAdmin: {
+		constants.PermissionWardrobeRead, constants.PermissionWardrobeCreate, constants.PermissionWardrobeUpdate,
+		constants.PermissionWardrobePrice, constants.PermissionWardrobeDelete, constants.PermissionStockAdjust,
+		constants.PermissionCatalogManage, constants.PermissionOrdersManage, constants.PermissionSettingsManage,
+		constants.PermissionAuditRead, constants.PermissionAPIKeysManage, constants.PermissionRolesManage,
+	},
+	RoleManager: {
+		constants.PermissionWardrobeRead, constants.PermissionWardrobeCreate, constants.PermissionWardrobeUpdate,
+		constants.PermissionWardrobePrice, constants.PermissionWardrobeDelete, constants.PermissionStockAdjust,
+		constants.PermissionCatalogManage, constants.PermissionOrdersManage, constants.PermissionSettingsManage,
+		constants.PermissionAuditRead,
+	},
+	RoleClerk: {
+		constants.PermissionWardrobeRead, constants.PermissionWardrobeUpdate, constants.PermissionStockAdjust,
+		constants.PermissionOrdersManage,
+	},
+	RoleReadOnly: {
+		constants.PermissionWardrobeRead,
+	},
+}
+
+// RoleAssignment gives a role to a subject, the subject of a token or api-key:<id> for an API key
+type RoleAssignment struct {
+	BaseModel
+	Subject    string `db:"subject"`
+	Role       string `db:"role"`
+	AssignedBy string `db:"assigned_by"`
+}
+
+func IsValidRole(role string) bool {
+	return slices.Contains(Roles, role)
+}
+
+// RolePermissions returns the permissions of the role, none for an unknown role
+func RolePermissions(role string) []string {
+	return slices.Clone(rolePermissions[role])
+}
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	// SalesOrderPlaced orders have taken their stock, cancelling them returns it
+	SalesOrderPlaced    = "placed"
+	SalesOrderFulfilled = "fulfilled"
+	SalesOrderCancelled = "cancelled"
+)
+
+// SalesOrder sells wardrobes from a location, the prices of its lines are the ones of the wardrobes when it was placed
+type SalesOrder struct {
+	BaseModel
+	ID          uuid.UUID  `db:"id"`
+	Customer    string     `db:"customer"`
+	Currency    string     `db:"currency"`
+	Total       Money      `db:"total"`
+	LocationID  uuid.UUID  `db:"location_id"`
+	Status      string     `db:"status"`
+	Note        string     `db:"note"`
+	CreatedBy   string     `db:"created_by"`
+	FulfilledAt *time.Time `db:"fulfilled_at"`
+	CancelledAt *time.Time `db:"cancelled_at"`
+}
+
+type SalesOrderLine struct {
+	ID           uuid.UUID `db:"id"`
+	SalesOrderID uuid.UUID `db:"sales_order_id"`
+	WardrobeID   uuid.UUID `db:"wardrobe_id"`
+	Quantity     int       `db:"quantity"`
+	UnitPrice    Money     `db:"unit_price"`
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+// SalesOrderFilter narrows the sales orders down, empty fields are not filtered on.
+// From and To bound the time the orders were placed, both inclusive.
+type SalesOrderFilter struct {
+	Status string
+	From   *time.Time
+	To     *time.Time
+}
+
+// SalesOrderSortFields are the fields the sales orders can be sorted by
+var SalesOrderSortFields = SortFields{"created_at": SortTime}
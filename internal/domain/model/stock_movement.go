@@ -0,0 +1,27 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	StockReasonInitial = "initial stock"
+	StockReasonAdd     = "stock added"
+	StockReasonSub     = "stock subtracted"
+	StockReasonUpdate  = "stock updated"
+
+	StockReasonTransferOut    = "transferred out"
+	StockReasonTransferIn     = "transferred in"
+	StockReasonTransferCancel = "transfer cancelled"
+)
+
+// StockMovement is an immutable ledger row, every stock change on a wardrobe is recorded as one
+type StockMovement struct {
+	ID         uuid.UUID `db:"id"`
+	WardrobeID uuid.UUID `db:"wardrobe_id"`
+	Delta      int       `db:"delta"`
+	Reason     string    `db:"reason"`
+	Balance    int       `db:"balance"`
+	CreatedAt  time.Time `db:"created_at"`
+}
@@ -0,0 +1,18 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"strings"
+)
+
+// Tag is a free-form label of wardrobes, the name is stored lowercased and is unique
+type Tag struct {
+	BaseModel
+	ID   uuid.UUID `db:"id"`
+	Name string    `db:"name"`
+}
+
+// NormalizeTag trims and lowercases a tag name so "Summer-2026" and "summer-2026 " are the same tag
+func NormalizeTag(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
@@ -1,13 +1,69 @@
 package model
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"time"
+)
 
 type Wardrobe struct {
 	BaseModel
-	ID    uuid.UUID `db:"id"`
-	Name  string    `db:"name"`
-	Color string    `db:"color"`
-	Size  string    `db:"size"`
-	Price float32   `db:"price"`
-	Stock int       `db:"stock"`
+	ID       uuid.UUID `db:"id"`
+	Name     string    `db:"name"`
+	Color    string    `db:"color"`
+	Size     string    `db:"size"`
+	Price    Money     `db:"price"`
+	Currency string    `db:"currency"`
+	Stock    int       `db:"stock"`
+	Version  int       `db:"version"`
+
+	// a wardrobe is a variant of its product, name and price follow the product unless PriceOverridden
+	ProductID       *uuid.UUID `db:"product_id"`
+	SKU             *string    `db:"sku"`
+	PriceOverridden bool       `db:"price_overridden"`
+
+	CategoryID *uuid.UUID `db:"category_id"`
+
+	// DeletedAt is set while the wardrobe is in the trash
+	DeletedAt *time.Time `db:"deleted_at"`
+
+	// Reserved is computed from the active reservations, it is not a column of wardrobe
+	Reserved int `db:"reserved"`
+
+	// Rank is the full-text relevance, it is only filled by searches with a query
+	Rank float32 `db:"rank"`
+}
+
+// Available is the stock that is not held by any active reservation
+func (w *Wardrobe) Available() int {
+	return w.Stock - w.Reserved
 }
+
+// WardrobeFilter narrows a wardrobe search down, empty fields are not filtered on
+type WardrobeFilter struct {
+	// Query is matched against the name, color and sku as word prefixes, ignoring case and accents
+	Query string
+	Color string
+	Size  string
+
+	// CategoryID matches the category itself and all of its descendants
+	CategoryID *uuid.UUID
+
+	// Tags matches wardrobes having any of the tags, or all of them when AllTags is set
+	Tags    []string
+	AllTags bool
+}
+
+// WardrobeSortFields are the fields a wardrobe list can be sorted by
+var WardrobeSortFields = SortFields{
+	"name":       SortText,
+	"price":      SortNumeric,
+	"stock":      SortInt,
+	"created_at": SortTime,
+	"updated_at": SortTime,
+}
+
+// WardrobeTrashSortFields are the fields the trash can be sorted by
+var WardrobeTrashSortFields = WardrobeSortFields.With("deleted_at", SortTime)
+
+// WardrobeRelevance sorts a search with a query by how well the wardrobes match it
+const WardrobeRelevance = "relevance"
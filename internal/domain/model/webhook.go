@@ -0,0 +1,101 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"slices"
+	"time"
+)
+
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	// WebhookDeliveryFailed deliveries ran out of attempts, they are only sent again when redelivered
+	WebhookDeliveryFailed = "failed"
+)
+
+// WebhookEvents are the events a webhook can subscribe to
+var WebhookEvents = []string{
+	EventWardrobeCreated,
+	EventWardrobeUpdated,
+	EventWardrobeDeleted,
+	EventWardrobeRestored,
+	EventWardrobeStockChanged,
+}
+
+// WebhookSubscription pushes the events of its types to URL, it is disabled after too many failures in a row
+type WebhookSubscription struct {
+	BaseModel
+	ID                  uuid.UUID         `db:"id"`
+	URL                 string            `db:"url"`
+	EventTypes          WebhookEventTypes `db:"event_types"`
+	Secret              string            `db:"secret"`
+	Active              bool              `db:"active"`
+	ConsecutiveFailures int               `db:"consecutive_failures"`
+	DisabledAt          *time.Time        `db:"disabled_at"`
+	DisabledReason      string            `db:"disabled_reason"`
+}
+
+// WebhookDelivery is one event to send to one subscription, Payload is the exact body that is signed and posted
+type WebhookDelivery struct {
+	BaseModel
+	ID             uuid.UUID  `db:"id"`
+	SubscriptionID uuid.UUID  `db:"subscription_id"`
+	EventID        uuid.UUID  `db:"event_id"`
+	EventType      string     `db:"event_type"`
+	Payload        []byte     `db:"payload"`
+	Status         string     `db:"status"`
+	Attempts       int        `db:"attempts"`
+	NextAttemptAt  time.Time  `db:"next_attempt_at"`
+	DeliveredAt    *time.Time `db:"delivered_at"`
+	LastError      string     `db:"last_error"`
+}
+
+// WebhookDeliveryAttempt logs one request made for a delivery, StatusCode is 0 when no answer came back
+type WebhookDeliveryAttempt struct {
+	ID           uuid.UUID `db:"id"`
+	DeliveryID   uuid.UUID `db:"delivery_id"`
+	Attempt      int       `db:"attempt"`
+	StatusCode   int       `db:"status_code"`
+	ResponseBody string    `db:"response_body"`
+	Error        string    `db:"error"`
+	DurationMs   int64     `db:"duration_ms"`
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+// WebhookDeliverySortFields are the fields the deliveries can be sorted by
+var WebhookDeliverySortFields = SortFields{"created_at": SortTime}
+
+// WebhookEventTypes is stored as a json column, an empty list subscribes to every event
+type WebhookEventTypes []string
+
+func (t WebhookEventTypes) Matches(eventType string) bool {
+	return len(t) == 0 || slices.Contains(t, eventType)
+}
+
+func (t *WebhookEventTypes) Scan(src any) error {
+	switch value := src.(type) {
+	case nil:
+		*t = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(value, t)
+	case string:
+		return json.Unmarshal([]byte(value), t)
+	default:
+		return fmt.Errorf("can not scan %T into WebhookEventTypes", src)
+	}
+}
+
+func (t WebhookEventTypes) Value() (driver.Value, error) {
+	if t == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"time"
+)
+
+type APIKeyRepository interface {
+	GetAll(ctx context.Context) (*[]model.APIKey, error)
+	GetById(ctx context.Context, id *uuid.UUID) (*model.APIKey, error)
+	GetByPrefix(ctx context.Context, prefix string) (*model.APIKey, error)
+	Insert(ctx context.Context, apiKey *model.APIKey) error
+	Revoke(ctx context.Context, id *uuid.UUID, at time.Time) error
+	Touch(ctx context.Context, id *uuid.UUID, at time.Time) error
+}
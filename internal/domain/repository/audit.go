@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"context"
+	"sagara_backend_test/internal/domain/model"
+)
+
+type AuditRepository interface {
+	Insert(ctx context.Context, auditLog *model.AuditLog) error
+	Get(ctx context.Context, filter *model.AuditFilter, page *model.Page) (*[]model.AuditLog, *model.PageInfo, error)
+}
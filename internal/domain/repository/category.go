@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+)
+
+type CategoryRepository interface {
+	Insert(ctx context.Context, category *model.Category) error
+	Update(ctx context.Context, category *model.Category) error
+	GetAll(ctx context.Context) (*[]model.Category, error)
+	GetById(ctx context.Context, id *uuid.UUID) (*model.Category, error)
+	Delete(ctx context.Context, id *uuid.UUID) error
+}
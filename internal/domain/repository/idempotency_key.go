@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"sagara_backend_test/internal/domain/model"
+	"time"
+)
+
+type IdempotencyKeyRepository interface {
+	Claim(ctx context.Context, key *model.IdempotencyKey) (bool, error)
+	GetByKey(ctx context.Context, key string) (*model.IdempotencyKey, error)
+	Complete(ctx context.Context, key *model.IdempotencyKey) error
+	Release(ctx context.Context, key string) error
+	DeleteExpired(ctx context.Context, at time.Time) (int64, error)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+)
+
+type ImportJobRepository interface {
+	Insert(ctx context.Context, job *model.ImportJob) error
+	GetById(ctx context.Context, id *uuid.UUID) (*model.ImportJob, error)
+	Update(ctx context.Context, job *model.ImportJob) error
+}
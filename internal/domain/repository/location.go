@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+)
+
+type LocationRepository interface {
+	GetAll(ctx context.Context) (*[]model.Location, error)
+	GetById(ctx context.Context, id *uuid.UUID) (*model.Location, error)
+	GetDefault(ctx context.Context) (*model.Location, error)
+	Insert(ctx context.Context, location *model.Location) error
+	Update(ctx context.Context, location *model.Location) error
+	Delete(ctx context.Context, id *uuid.UUID) error
+}
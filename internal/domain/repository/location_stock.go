@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+)
+
+type LocationStockRepository interface {
+	GetByWardrobeId(ctx context.Context, wardrobeId *uuid.UUID) (*[]model.LocationStock, error)
+	Adjust(ctx context.Context, wardrobeId, locationId *uuid.UUID, delta int) error
+}
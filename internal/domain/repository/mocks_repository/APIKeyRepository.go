@@ -0,0 +1,153 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_repository
+
+import (
+	context "context"
+	model "sagara_backend_test/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// APIKeyRepository is an autogenerated mock type for the APIKeyRepository type
+type APIKeyRepository struct {
+	mock.Mock
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *APIKeyRepository) GetAll(ctx context.Context) (*[]model.APIKey, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *[]model.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*[]model.APIKey, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *[]model.APIKey); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetById provides a mock function with given fields: ctx, id
+func (_m *APIKeyRepository) GetById(ctx context.Context, id *uuid.UUID) (*model.APIKey, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*model.APIKey, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *model.APIKey); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByPrefix provides a mock function with given fields: ctx, prefix
+func (_m *APIKeyRepository) GetByPrefix(ctx context.Context, prefix string) (*model.APIKey, error) {
+	ret := _m.Called(ctx, prefix)
+
+	var r0 *model.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.APIKey, error)); ok {
+		return rf(ctx, prefix)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.APIKey); ok {
+		r0 = rf(ctx, prefix)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, prefix)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, apiKey
+func (_m *APIKeyRepository) Insert(ctx context.Context, apiKey *model.APIKey) error {
+	ret := _m.Called(ctx, apiKey)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.APIKey) error); ok {
+		r0 = rf(ctx, apiKey)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Revoke provides a mock function with given fields: ctx, id, at
+func (_m *APIKeyRepository) Revoke(ctx context.Context, id *uuid.UUID, at time.Time) error {
+	ret := _m.Called(ctx, id, at)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, id, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Touch provides a mock function with given fields: ctx, id, at
+func (_m *APIKeyRepository) Touch(ctx context.Context, id *uuid.UUID, at time.Time) error {
+	ret := _m.Called(ctx, id, at)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, id, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewAPIKeyRepository creates a new instance of APIKeyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAPIKeyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *APIKeyRepository {
+	mock := &APIKeyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,78 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_repository
+
+import (
+	context "context"
+	model "sagara_backend_test/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AuditRepository is an autogenerated mock type for the AuditRepository type
+type AuditRepository struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: ctx, filter, page
+func (_m *AuditRepository) Get(ctx context.Context, filter *model.AuditFilter, page *model.Page) (*[]model.AuditLog, *model.PageInfo, error) {
+	ret := _m.Called(ctx, filter, page)
+
+	var r0 *[]model.AuditLog
+	var r1 *model.PageInfo
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.AuditFilter, *model.Page) (*[]model.AuditLog, *model.PageInfo, error)); ok {
+		return rf(ctx, filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.AuditFilter, *model.Page) *[]model.AuditLog); ok {
+		r0 = rf(ctx, filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.AuditLog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.AuditFilter, *model.Page) *model.PageInfo); ok {
+		r1 = rf(ctx, filter, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.PageInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *model.AuditFilter, *model.Page) error); ok {
+		r2 = rf(ctx, filter, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Insert provides a mock function with given fields: ctx, auditLog
+func (_m *AuditRepository) Insert(ctx context.Context, auditLog *model.AuditLog) error {
+	ret := _m.Called(ctx, auditLog)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.AuditLog) error); ok {
+		r0 = rf(ctx, auditLog)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewAuditRepository creates a new instance of AuditRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuditRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuditRepository {
+	mock := &AuditRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
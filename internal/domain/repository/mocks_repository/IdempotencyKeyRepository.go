@@ -0,0 +1,133 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_repository
+
+import (
+	context "context"
+	model "sagara_backend_test/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// IdempotencyKeyRepository is an autogenerated mock type for the IdempotencyKeyRepository type
+type IdempotencyKeyRepository struct {
+	mock.Mock
+}
+
+// Claim provides a mock function with given fields: ctx, key
+func (_m *IdempotencyKeyRepository) Claim(ctx context.Context, key *model.IdempotencyKey) (bool, error) {
+	ret := _m.Called(ctx, key)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.IdempotencyKey) (bool, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.IdempotencyKey) bool); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.IdempotencyKey) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Complete provides a mock function with given fields: ctx, key
+func (_m *IdempotencyKeyRepository) Complete(ctx context.Context, key *model.IdempotencyKey) error {
+	ret := _m.Called(ctx, key)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.IdempotencyKey) error); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteExpired provides a mock function with given fields: ctx, at
+func (_m *IdempotencyKeyRepository) DeleteExpired(ctx context.Context, at time.Time) (int64, error) {
+	ret := _m.Called(ctx, at)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, at)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByKey provides a mock function with given fields: ctx, key
+func (_m *IdempotencyKeyRepository) GetByKey(ctx context.Context, key string) (*model.IdempotencyKey, error) {
+	ret := _m.Called(ctx, key)
+
+	var r0 *model.IdempotencyKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.IdempotencyKey, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.IdempotencyKey); ok {
+		r0 = rf(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.IdempotencyKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Release provides a mock function with given fields: ctx, key
+func (_m *IdempotencyKeyRepository) Release(ctx context.Context, key string) error {
+	ret := _m.Called(ctx, key)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewIdempotencyKeyRepository creates a new instance of IdempotencyKeyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIdempotencyKeyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IdempotencyKeyRepository {
+	mock := &IdempotencyKeyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,85 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_repository
+
+import (
+	context "context"
+	model "sagara_backend_test/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ImportJobRepository is an autogenerated mock type for the ImportJobRepository type
+type ImportJobRepository struct {
+	mock.Mock
+}
+
+// GetById provides a mock function with given fields: ctx, id
+func (_m *ImportJobRepository) GetById(ctx context.Context, id *uuid.UUID) (*model.ImportJob, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.ImportJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*model.ImportJob, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *model.ImportJob); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ImportJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, job
+func (_m *ImportJobRepository) Insert(ctx context.Context, job *model.ImportJob) error {
+	ret := _m.Called(ctx, job)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ImportJob) error); ok {
+		r0 = rf(ctx, job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, job
+func (_m *ImportJobRepository) Update(ctx context.Context, job *model.ImportJob) error {
+	ret := _m.Called(ctx, job)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ImportJob) error); ok {
+		r0 = rf(ctx, job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewImportJobRepository creates a new instance of ImportJobRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewImportJobRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ImportJobRepository {
+	mock := &ImportJobRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,151 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_repository
+
+import (
+	context "context"
+	model "sagara_backend_test/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// LocationRepository is an autogenerated mock type for the LocationRepository type
+type LocationRepository struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *LocationRepository) Delete(ctx context.Context, id *uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *LocationRepository) GetAll(ctx context.Context) (*[]model.Location, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *[]model.Location
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*[]model.Location, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *[]model.Location); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.Location)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetById provides a mock function with given fields: ctx, id
+func (_m *LocationRepository) GetById(ctx context.Context, id *uuid.UUID) (*model.Location, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.Location
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*model.Location, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *model.Location); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Location)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDefault provides a mock function with given fields: ctx
+func (_m *LocationRepository) GetDefault(ctx context.Context) (*model.Location, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *model.Location
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*model.Location, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *model.Location); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Location)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, location
+func (_m *LocationRepository) Insert(ctx context.Context, location *model.Location) error {
+	ret := _m.Called(ctx, location)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Location) error); ok {
+		r0 = rf(ctx, location)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, location
+func (_m *LocationRepository) Update(ctx context.Context, location *model.Location) error {
+	ret := _m.Called(ctx, location)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Location) error); ok {
+		r0 = rf(ctx, location)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewLocationRepository creates a new instance of LocationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLocationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LocationRepository {
+	mock := &LocationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
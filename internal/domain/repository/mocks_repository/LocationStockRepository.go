@@ -0,0 +1,71 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_repository
+
+import (
+	context "context"
+	model "sagara_backend_test/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// LocationStockRepository is an autogenerated mock type for the LocationStockRepository type
+type LocationStockRepository struct {
+	mock.Mock
+}
+
+// Adjust provides a mock function with given fields: ctx, wardrobeId, locationId, delta
+func (_m *LocationStockRepository) Adjust(ctx context.Context, wardrobeId *uuid.UUID, locationId *uuid.UUID, delta int) error {
+	ret := _m.Called(ctx, wardrobeId, locationId, delta)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *uuid.UUID, int) error); ok {
+		r0 = rf(ctx, wardrobeId, locationId, delta)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByWardrobeId provides a mock function with given fields: ctx, wardrobeId
+func (_m *LocationStockRepository) GetByWardrobeId(ctx context.Context, wardrobeId *uuid.UUID) (*[]model.LocationStock, error) {
+	ret := _m.Called(ctx, wardrobeId)
+
+	var r0 *[]model.LocationStock
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*[]model.LocationStock, error)); ok {
+		return rf(ctx, wardrobeId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *[]model.LocationStock); ok {
+		r0 = rf(ctx, wardrobeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.LocationStock)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, wardrobeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewLocationStockRepository creates a new instance of LocationStockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLocationStockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LocationStockRepository {
+	mock := &LocationStockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,71 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_repository
+
+import (
+	context "context"
+	model "sagara_backend_test/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// PriceChangeRepository is an autogenerated mock type for the PriceChangeRepository type
+type PriceChangeRepository struct {
+	mock.Mock
+}
+
+// GetByWardrobeId provides a mock function with given fields: ctx, wardrobeId
+func (_m *PriceChangeRepository) GetByWardrobeId(ctx context.Context, wardrobeId *uuid.UUID) (*[]model.PriceChange, error) {
+	ret := _m.Called(ctx, wardrobeId)
+
+	var r0 *[]model.PriceChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*[]model.PriceChange, error)); ok {
+		return rf(ctx, wardrobeId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *[]model.PriceChange); ok {
+		r0 = rf(ctx, wardrobeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.PriceChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, wardrobeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, change
+func (_m *PriceChangeRepository) Insert(ctx context.Context, change *model.PriceChange) error {
+	ret := _m.Called(ctx, change)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PriceChange) error); ok {
+		r0 = rf(ctx, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewPriceChangeRepository creates a new instance of PriceChangeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPriceChangeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PriceChangeRepository {
+	mock := &PriceChangeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,172 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_repository
+
+import (
+	context "context"
+	model "sagara_backend_test/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// PriceScheduleRepository is an autogenerated mock type for the PriceScheduleRepository type
+type PriceScheduleRepository struct {
+	mock.Mock
+}
+
+// GetById provides a mock function with given fields: ctx, id
+func (_m *PriceScheduleRepository) GetById(ctx context.Context, id *uuid.UUID) (*model.PriceSchedule, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.PriceSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*model.PriceSchedule, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *model.PriceSchedule); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PriceSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByWardrobeId provides a mock function with given fields: ctx, wardrobeId, statuses
+func (_m *PriceScheduleRepository) GetByWardrobeId(ctx context.Context, wardrobeId *uuid.UUID, statuses ...string) (*[]model.PriceSchedule, error) {
+	_va := make([]interface{}, len(statuses))
+	for _i := range statuses {
+		_va[_i] = statuses[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, wardrobeId)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *[]model.PriceSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, ...string) (*[]model.PriceSchedule, error)); ok {
+		return rf(ctx, wardrobeId, statuses...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, ...string) *[]model.PriceSchedule); ok {
+		r0 = rf(ctx, wardrobeId, statuses...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.PriceSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, ...string) error); ok {
+		r1 = rf(ctx, wardrobeId, statuses...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEnding provides a mock function with given fields: ctx, now
+func (_m *PriceScheduleRepository) GetEnding(ctx context.Context, now time.Time) (*[]model.PriceSchedule, error) {
+	ret := _m.Called(ctx, now)
+
+	var r0 *[]model.PriceSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (*[]model.PriceSchedule, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) *[]model.PriceSchedule); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.PriceSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStarting provides a mock function with given fields: ctx, now
+func (_m *PriceScheduleRepository) GetStarting(ctx context.Context, now time.Time) (*[]model.PriceSchedule, error) {
+	ret := _m.Called(ctx, now)
+
+	var r0 *[]model.PriceSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (*[]model.PriceSchedule, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) *[]model.PriceSchedule); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.PriceSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, schedule
+func (_m *PriceScheduleRepository) Insert(ctx context.Context, schedule *model.PriceSchedule) error {
+	ret := _m.Called(ctx, schedule)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PriceSchedule) error); ok {
+		r0 = rf(ctx, schedule)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateStatus provides a mock function with given fields: ctx, schedule, fromStatus
+func (_m *PriceScheduleRepository) UpdateStatus(ctx context.Context, schedule *model.PriceSchedule, fromStatus string) error {
+	ret := _m.Called(ctx, schedule, fromStatus)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PriceSchedule, string) error); ok {
+		r0 = rf(ctx, schedule, fromStatus)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewPriceScheduleRepository creates a new instance of PriceScheduleRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPriceScheduleRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PriceScheduleRepository {
+	mock := &PriceScheduleRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,214 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_repository
+
+import (
+	context "context"
+	model "sagara_backend_test/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// PurchaseOrderRepository is an autogenerated mock type for the PurchaseOrderRepository type
+type PurchaseOrderRepository struct {
+	mock.Mock
+}
+
+// DeleteLines provides a mock function with given fields: ctx, orderId
+func (_m *PurchaseOrderRepository) DeleteLines(ctx context.Context, orderId *uuid.UUID) error {
+	ret := _m.Called(ctx, orderId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) error); ok {
+		r0 = rf(ctx, orderId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, filter, page
+func (_m *PurchaseOrderRepository) Get(ctx context.Context, filter *model.PurchaseOrderFilter, page *model.Page) (*[]model.PurchaseOrder, *model.PageInfo, error) {
+	ret := _m.Called(ctx, filter, page)
+
+	var r0 *[]model.PurchaseOrder
+	var r1 *model.PageInfo
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PurchaseOrderFilter, *model.Page) (*[]model.PurchaseOrder, *model.PageInfo, error)); ok {
+		return rf(ctx, filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PurchaseOrderFilter, *model.Page) *[]model.PurchaseOrder); ok {
+		r0 = rf(ctx, filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.PurchaseOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.PurchaseOrderFilter, *model.Page) *model.PageInfo); ok {
+		r1 = rf(ctx, filter, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.PageInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *model.PurchaseOrderFilter, *model.Page) error); ok {
+		r2 = rf(ctx, filter, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetById provides a mock function with given fields: ctx, id
+func (_m *PurchaseOrderRepository) GetById(ctx context.Context, id *uuid.UUID) (*model.PurchaseOrder, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.PurchaseOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*model.PurchaseOrder, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *model.PurchaseOrder); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PurchaseOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLines provides a mock function with given fields: ctx, orderId
+func (_m *PurchaseOrderRepository) GetLines(ctx context.Context, orderId *uuid.UUID) (*[]model.PurchaseOrderLine, error) {
+	ret := _m.Called(ctx, orderId)
+
+	var r0 *[]model.PurchaseOrderLine
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*[]model.PurchaseOrderLine, error)); ok {
+		return rf(ctx, orderId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *[]model.PurchaseOrderLine); ok {
+		r0 = rf(ctx, orderId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.PurchaseOrderLine)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, orderId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, order
+func (_m *PurchaseOrderRepository) Insert(ctx context.Context, order *model.PurchaseOrder) error {
+	ret := _m.Called(ctx, order)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PurchaseOrder) error); ok {
+		r0 = rf(ctx, order)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InsertLines provides a mock function with given fields: ctx, lines
+func (_m *PurchaseOrderRepository) InsertLines(ctx context.Context, lines []model.PurchaseOrderLine) error {
+	ret := _m.Called(ctx, lines)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []model.PurchaseOrderLine) error); ok {
+		r0 = rf(ctx, lines)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LockById provides a mock function with given fields: ctx, id
+func (_m *PurchaseOrderRepository) LockById(ctx context.Context, id *uuid.UUID) (*model.PurchaseOrder, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.PurchaseOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*model.PurchaseOrder, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *model.PurchaseOrder); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PurchaseOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReceiveLine provides a mock function with given fields: ctx, lineId, quantity
+func (_m *PurchaseOrderRepository) ReceiveLine(ctx context.Context, lineId *uuid.UUID, quantity int) error {
+	ret := _m.Called(ctx, lineId, quantity)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, int) error); ok {
+		r0 = rf(ctx, lineId, quantity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, order
+func (_m *PurchaseOrderRepository) Update(ctx context.Context, order *model.PurchaseOrder) error {
+	ret := _m.Called(ctx, order)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PurchaseOrder) error); ok {
+		r0 = rf(ctx, order)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewPurchaseOrderRepository creates a new instance of PurchaseOrderRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPurchaseOrderRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PurchaseOrderRepository {
+	mock := &PurchaseOrderRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
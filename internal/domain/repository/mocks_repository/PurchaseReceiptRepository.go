@@ -0,0 +1,71 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_repository
+
+import (
+	context "context"
+	model "sagara_backend_test/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// PurchaseReceiptRepository is an autogenerated mock type for the PurchaseReceiptRepository type
+type PurchaseReceiptRepository struct {
+	mock.Mock
+}
+
+// GetByPurchaseOrder provides a mock function with given fields: ctx, orderId
+func (_m *PurchaseReceiptRepository) GetByPurchaseOrder(ctx context.Context, orderId *uuid.UUID) (*[]model.PurchaseReceipt, error) {
+	ret := _m.Called(ctx, orderId)
+
+	var r0 *[]model.PurchaseReceipt
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*[]model.PurchaseReceipt, error)); ok {
+		return rf(ctx, orderId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *[]model.PurchaseReceipt); ok {
+		r0 = rf(ctx, orderId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.PurchaseReceipt)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, orderId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, receipt
+func (_m *PurchaseReceiptRepository) Insert(ctx context.Context, receipt *model.PurchaseReceipt) error {
+	ret := _m.Called(ctx, receipt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PurchaseReceipt) error); ok {
+		r0 = rf(ctx, receipt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewPurchaseReceiptRepository creates a new instance of PurchaseReceiptRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPurchaseReceiptRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PurchaseReceiptRepository {
+	mock := &PurchaseReceiptRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,123 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_repository
+
+import (
+	context "context"
+	model "sagara_backend_test/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// ReservationRepository is an autogenerated mock type for the ReservationRepository type
+type ReservationRepository struct {
+	mock.Mock
+}
+
+// ExpireStale provides a mock function with given fields: ctx, now
+func (_m *ReservationRepository) ExpireStale(ctx context.Context, now time.Time) (int64, error) {
+	ret := _m.Called(ctx, now)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, now)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetById provides a mock function with given fields: ctx, id
+func (_m *ReservationRepository) GetById(ctx context.Context, id *uuid.UUID) (*model.Reservation, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*model.Reservation, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *model.Reservation); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Reservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, reservation
+func (_m *ReservationRepository) Insert(ctx context.Context, reservation *model.Reservation) error {
+	ret := _m.Called(ctx, reservation)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Reservation) error); ok {
+		r0 = rf(ctx, reservation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateStatus provides a mock function with given fields: ctx, id, status
+func (_m *ReservationRepository) UpdateStatus(ctx context.Context, id *uuid.UUID, status string) (*model.Reservation, error) {
+	ret := _m.Called(ctx, id, status)
+
+	var r0 *model.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, string) (*model.Reservation, error)); ok {
+		return rf(ctx, id, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, string) *model.Reservation); ok {
+		r0 = rf(ctx, id, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Reservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, string) error); ok {
+		r1 = rf(ctx, id, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewReservationRepository creates a new instance of ReservationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReservationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReservationRepository {
+	mock := &ReservationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,109 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_repository
+
+import (
+	context "context"
+	model "sagara_backend_test/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RoleAssignmentRepository is an autogenerated mock type for the RoleAssignmentRepository type
+type RoleAssignmentRepository struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: ctx, subject
+func (_m *RoleAssignmentRepository) Delete(ctx context.Context, subject string) error {
+	ret := _m.Called(ctx, subject)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, subject)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *RoleAssignmentRepository) GetAll(ctx context.Context) (*[]model.RoleAssignment, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *[]model.RoleAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*[]model.RoleAssignment, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *[]model.RoleAssignment); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.RoleAssignment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBySubject provides a mock function with given fields: ctx, subject
+func (_m *RoleAssignmentRepository) GetBySubject(ctx context.Context, subject string) (*model.RoleAssignment, error) {
+	ret := _m.Called(ctx, subject)
+
+	var r0 *model.RoleAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.RoleAssignment, error)); ok {
+		return rf(ctx, subject)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.RoleAssignment); ok {
+		r0 = rf(ctx, subject)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RoleAssignment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upsert provides a mock function with given fields: ctx, assignment
+func (_m *RoleAssignmentRepository) Upsert(ctx context.Context, assignment *model.RoleAssignment) error {
+	ret := _m.Called(ctx, assignment)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.RoleAssignment) error); ok {
+		r0 = rf(ctx, assignment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewRoleAssignmentRepository creates a new instance of RoleAssignmentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRoleAssignmentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RoleAssignmentRepository {
+	mock := &RoleAssignmentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
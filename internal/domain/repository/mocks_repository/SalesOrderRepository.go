@@ -0,0 +1,186 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_repository
+
+import (
+	context "context"
+	model "sagara_backend_test/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// SalesOrderRepository is an autogenerated mock type for the SalesOrderRepository type
+type SalesOrderRepository struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: ctx, filter, page
+func (_m *SalesOrderRepository) Get(ctx context.Context, filter *model.SalesOrderFilter, page *model.Page) (*[]model.SalesOrder, *model.PageInfo, error) {
+	ret := _m.Called(ctx, filter, page)
+
+	var r0 *[]model.SalesOrder
+	var r1 *model.PageInfo
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SalesOrderFilter, *model.Page) (*[]model.SalesOrder, *model.PageInfo, error)); ok {
+		return rf(ctx, filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SalesOrderFilter, *model.Page) *[]model.SalesOrder); ok {
+		r0 = rf(ctx, filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.SalesOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.SalesOrderFilter, *model.Page) *model.PageInfo); ok {
+		r1 = rf(ctx, filter, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.PageInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *model.SalesOrderFilter, *model.Page) error); ok {
+		r2 = rf(ctx, filter, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetById provides a mock function with given fields: ctx, id
+func (_m *SalesOrderRepository) GetById(ctx context.Context, id *uuid.UUID) (*model.SalesOrder, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.SalesOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*model.SalesOrder, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *model.SalesOrder); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SalesOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLines provides a mock function with given fields: ctx, orderId
+func (_m *SalesOrderRepository) GetLines(ctx context.Context, orderId *uuid.UUID) (*[]model.SalesOrderLine, error) {
+	ret := _m.Called(ctx, orderId)
+
+	var r0 *[]model.SalesOrderLine
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*[]model.SalesOrderLine, error)); ok {
+		return rf(ctx, orderId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *[]model.SalesOrderLine); ok {
+		r0 = rf(ctx, orderId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.SalesOrderLine)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, orderId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, order
+func (_m *SalesOrderRepository) Insert(ctx context.Context, order *model.SalesOrder) error {
+	ret := _m.Called(ctx, order)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SalesOrder) error); ok {
+		r0 = rf(ctx, order)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InsertLines provides a mock function with given fields: ctx, lines
+func (_m *SalesOrderRepository) InsertLines(ctx context.Context, lines []model.SalesOrderLine) error {
+	ret := _m.Called(ctx, lines)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []model.SalesOrderLine) error); ok {
+		r0 = rf(ctx, lines)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LockById provides a mock function with given fields: ctx, id
+func (_m *SalesOrderRepository) LockById(ctx context.Context, id *uuid.UUID) (*model.SalesOrder, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.SalesOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*model.SalesOrder, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *model.SalesOrder); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SalesOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, order
+func (_m *SalesOrderRepository) Update(ctx context.Context, order *model.SalesOrder) error {
+	ret := _m.Called(ctx, order)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SalesOrder) error); ok {
+		r0 = rf(ctx, order)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewSalesOrderRepository creates a new instance of SalesOrderRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSalesOrderRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SalesOrderRepository {
+	mock := &SalesOrderRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
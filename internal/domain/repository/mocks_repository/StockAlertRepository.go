@@ -0,0 +1,172 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_repository
+
+import (
+	context "context"
+	model "sagara_backend_test/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// StockAlertRepository is an autogenerated mock type for the StockAlertRepository type
+type StockAlertRepository struct {
+	mock.Mock
+}
+
+// GetById provides a mock function with given fields: ctx, id
+func (_m *StockAlertRepository) GetById(ctx context.Context, id *uuid.UUID) (*model.StockAlert, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.StockAlert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*model.StockAlert, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *model.StockAlert); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.StockAlert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByStatus provides a mock function with given fields: ctx, status, page
+func (_m *StockAlertRepository) GetByStatus(ctx context.Context, status string, page *model.Page) (*[]model.StockAlert, *model.PageInfo, error) {
+	ret := _m.Called(ctx, status, page)
+
+	var r0 *[]model.StockAlert
+	var r1 *model.PageInfo
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.Page) (*[]model.StockAlert, *model.PageInfo, error)); ok {
+		return rf(ctx, status, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.Page) *[]model.StockAlert); ok {
+		r0 = rf(ctx, status, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.StockAlert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *model.Page) *model.PageInfo); ok {
+		r1 = rf(ctx, status, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.PageInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, *model.Page) error); ok {
+		r2 = rf(ctx, status, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetUnresolved provides a mock function with given fields: ctx, wardrobeId
+func (_m *StockAlertRepository) GetUnresolved(ctx context.Context, wardrobeId *uuid.UUID) (*model.StockAlert, error) {
+	ret := _m.Called(ctx, wardrobeId)
+
+	var r0 *model.StockAlert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*model.StockAlert, error)); ok {
+		return rf(ctx, wardrobeId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *model.StockAlert); ok {
+		r0 = rf(ctx, wardrobeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.StockAlert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, wardrobeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, alert
+func (_m *StockAlertRepository) Insert(ctx context.Context, alert *model.StockAlert) error {
+	ret := _m.Called(ctx, alert)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockAlert) error); ok {
+		r0 = rf(ctx, alert)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LockUnnotified provides a mock function with given fields: ctx, limit, maxAttempts
+func (_m *StockAlertRepository) LockUnnotified(ctx context.Context, limit int, maxAttempts int) (*[]model.StockAlert, error) {
+	ret := _m.Called(ctx, limit, maxAttempts)
+
+	var r0 *[]model.StockAlert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (*[]model.StockAlert, error)); ok {
+		return rf(ctx, limit, maxAttempts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) *[]model.StockAlert); ok {
+		r0 = rf(ctx, limit, maxAttempts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.StockAlert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, limit, maxAttempts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, alert
+func (_m *StockAlertRepository) Update(ctx context.Context, alert *model.StockAlert) error {
+	ret := _m.Called(ctx, alert)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockAlert) error); ok {
+		r0 = rf(ctx, alert)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewStockAlertRepository creates a new instance of StockAlertRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStockAlertRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StockAlertRepository {
+	mock := &StockAlertRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,123 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_repository
+
+import (
+	context "context"
+	model "sagara_backend_test/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// StockAlertRuleRepository is an autogenerated mock type for the StockAlertRuleRepository type
+type StockAlertRuleRepository struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *StockAlertRuleRepository) Delete(ctx context.Context, id *uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *StockAlertRuleRepository) GetAll(ctx context.Context) (*[]model.StockAlertRule, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *[]model.StockAlertRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*[]model.StockAlertRule, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *[]model.StockAlertRule); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.StockAlertRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetForWardrobe provides a mock function with given fields: ctx, wardrobe
+func (_m *StockAlertRuleRepository) GetForWardrobe(ctx context.Context, wardrobe *model.Wardrobe) (*model.StockAlertRule, error) {
+	ret := _m.Called(ctx, wardrobe)
+
+	var r0 *model.StockAlertRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Wardrobe) (*model.StockAlertRule, error)); ok {
+		return rf(ctx, wardrobe)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Wardrobe) *model.StockAlertRule); ok {
+		r0 = rf(ctx, wardrobe)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.StockAlertRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Wardrobe) error); ok {
+		r1 = rf(ctx, wardrobe)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upsert provides a mock function with given fields: ctx, rule
+func (_m *StockAlertRuleRepository) Upsert(ctx context.Context, rule *model.StockAlertRule) (*model.StockAlertRule, error) {
+	ret := _m.Called(ctx, rule)
+
+	var r0 *model.StockAlertRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockAlertRule) (*model.StockAlertRule, error)); ok {
+		return rf(ctx, rule)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockAlertRule) *model.StockAlertRule); ok {
+		r0 = rf(ctx, rule)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.StockAlertRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.StockAlertRule) error); ok {
+		r1 = rf(ctx, rule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewStockAlertRuleRepository creates a new instance of StockAlertRuleRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStockAlertRuleRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StockAlertRuleRepository {
+	mock := &StockAlertRuleRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
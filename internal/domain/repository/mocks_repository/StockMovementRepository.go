@@ -0,0 +1,71 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_repository
+
+import (
+	context "context"
+	model "sagara_backend_test/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// StockMovementRepository is an autogenerated mock type for the StockMovementRepository type
+type StockMovementRepository struct {
+	mock.Mock
+}
+
+// GetByWardrobeId provides a mock function with given fields: ctx, wardrobeId
+func (_m *StockMovementRepository) GetByWardrobeId(ctx context.Context, wardrobeId *uuid.UUID) (*[]model.StockMovement, error) {
+	ret := _m.Called(ctx, wardrobeId)
+
+	var r0 *[]model.StockMovement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*[]model.StockMovement, error)); ok {
+		return rf(ctx, wardrobeId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *[]model.StockMovement); ok {
+		r0 = rf(ctx, wardrobeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.StockMovement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, wardrobeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, movement
+func (_m *StockMovementRepository) Insert(ctx context.Context, movement *model.StockMovement) error {
+	ret := _m.Called(ctx, movement)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockMovement) error); ok {
+		r0 = rf(ctx, movement)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewStockMovementRepository creates a new instance of StockMovementRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStockMovementRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StockMovementRepository {
+	mock := &StockMovementRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
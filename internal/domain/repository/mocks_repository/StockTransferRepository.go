@@ -0,0 +1,170 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_repository
+
+import (
+	context "context"
+	model "sagara_backend_test/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// StockTransferRepository is an autogenerated mock type for the StockTransferRepository type
+type StockTransferRepository struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: ctx, filter, page
+func (_m *StockTransferRepository) Get(ctx context.Context, filter *model.StockTransferFilter, page *model.Page) (*[]model.StockTransfer, *model.PageInfo, error) {
+	ret := _m.Called(ctx, filter, page)
+
+	var r0 *[]model.StockTransfer
+	var r1 *model.PageInfo
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockTransferFilter, *model.Page) (*[]model.StockTransfer, *model.PageInfo, error)); ok {
+		return rf(ctx, filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockTransferFilter, *model.Page) *[]model.StockTransfer); ok {
+		r0 = rf(ctx, filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.StockTransfer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.StockTransferFilter, *model.Page) *model.PageInfo); ok {
+		r1 = rf(ctx, filter, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.PageInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *model.StockTransferFilter, *model.Page) error); ok {
+		r2 = rf(ctx, filter, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetById provides a mock function with given fields: ctx, id
+func (_m *StockTransferRepository) GetById(ctx context.Context, id *uuid.UUID) (*model.StockTransfer, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.StockTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*model.StockTransfer, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *model.StockTransfer); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.StockTransfer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInTransit provides a mock function with given fields: ctx, wardrobeId
+func (_m *StockTransferRepository) GetInTransit(ctx context.Context, wardrobeId *uuid.UUID) (int, error) {
+	ret := _m.Called(ctx, wardrobeId)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (int, error)); ok {
+		return rf(ctx, wardrobeId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) int); ok {
+		r0 = rf(ctx, wardrobeId)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, wardrobeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, transfer
+func (_m *StockTransferRepository) Insert(ctx context.Context, transfer *model.StockTransfer) error {
+	ret := _m.Called(ctx, transfer)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockTransfer) error); ok {
+		r0 = rf(ctx, transfer)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LockById provides a mock function with given fields: ctx, id
+func (_m *StockTransferRepository) LockById(ctx context.Context, id *uuid.UUID) (*model.StockTransfer, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.StockTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*model.StockTransfer, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *model.StockTransfer); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.StockTransfer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, transfer
+func (_m *StockTransferRepository) Update(ctx context.Context, transfer *model.StockTransfer) error {
+	ret := _m.Called(ctx, transfer)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockTransfer) error); ok {
+		r0 = rf(ctx, transfer)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewStockTransferRepository creates a new instance of StockTransferRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStockTransferRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StockTransferRepository {
+	mock := &StockTransferRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,177 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_repository
+
+import (
+	context "context"
+	model "sagara_backend_test/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// TagRepository is an autogenerated mock type for the TagRepository type
+type TagRepository struct {
+	mock.Mock
+}
+
+// Assign provides a mock function with given fields: ctx, wardrobeId, tagId
+func (_m *TagRepository) Assign(ctx context.Context, wardrobeId *uuid.UUID, tagId *uuid.UUID) error {
+	ret := _m.Called(ctx, wardrobeId, tagId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *uuid.UUID) error); ok {
+		r0 = rf(ctx, wardrobeId, tagId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *TagRepository) Delete(ctx context.Context, id *uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *TagRepository) GetAll(ctx context.Context) (*[]model.Tag, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *[]model.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*[]model.Tag, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *[]model.Tag); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetById provides a mock function with given fields: ctx, id
+func (_m *TagRepository) GetById(ctx context.Context, id *uuid.UUID) (*model.Tag, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*model.Tag, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *model.Tag); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByWardrobeId provides a mock function with given fields: ctx, wardrobeId
+func (_m *TagRepository) GetByWardrobeId(ctx context.Context, wardrobeId *uuid.UUID) (*[]model.Tag, error) {
+	ret := _m.Called(ctx, wardrobeId)
+
+	var r0 *[]model.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*[]model.Tag, error)); ok {
+		return rf(ctx, wardrobeId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *[]model.Tag); ok {
+		r0 = rf(ctx, wardrobeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, wardrobeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Unassign provides a mock function with given fields: ctx, wardrobeId, tagId
+func (_m *TagRepository) Unassign(ctx context.Context, wardrobeId *uuid.UUID, tagId *uuid.UUID) error {
+	ret := _m.Called(ctx, wardrobeId, tagId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *uuid.UUID) error); ok {
+		r0 = rf(ctx, wardrobeId, tagId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Upsert provides a mock function with given fields: ctx, tag
+func (_m *TagRepository) Upsert(ctx context.Context, tag *model.Tag) (*model.Tag, error) {
+	ret := _m.Called(ctx, tag)
+
+	var r0 *model.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Tag) (*model.Tag, error)); ok {
+		return rf(ctx, tag)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Tag) *model.Tag); ok {
+		r0 = rf(ctx, tag)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Tag) error); ok {
+		r1 = rf(ctx, tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewTagRepository creates a new instance of TagRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTagRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TagRepository {
+	mock := &TagRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
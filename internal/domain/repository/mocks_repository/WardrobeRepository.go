@@ -8,6 +8,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -17,12 +19,38 @@ type WardrobeRepository struct {
 }
 
 // AddStock provides a mock function with given fields: ctx, id, addition
-func (_m *WardrobeRepository) AddStock(ctx context.Context, id *uuid.UUID, addition int) error {
+func (_m *WardrobeRepository) AddStock(ctx context.Context, id *uuid.UUID, addition int) (*model.Wardrobe, error) {
 	ret := _m.Called(ctx, id, addition)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, int) error); ok {
+	var r0 *model.Wardrobe
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, int) (*model.Wardrobe, error)); ok {
+		return rf(ctx, id, addition)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, int) *model.Wardrobe); ok {
 		r0 = rf(ctx, id, addition)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Wardrobe)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, int) error); ok {
+		r1 = rf(ctx, id, addition)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BumpVersion provides a mock function with given fields: ctx, id
+func (_m *WardrobeRepository) BumpVersion(ctx context.Context, id *uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -44,56 +72,74 @@ func (_m *WardrobeRepository) Delete(ctx context.Context, id *uuid.UUID) error {
 	return r0
 }
 
-// GetAll provides a mock function with given fields: ctx
-func (_m *WardrobeRepository) GetAll(ctx context.Context) (*[]model.Wardrobe, error) {
-	ret := _m.Called(ctx)
+// GetAll provides a mock function with given fields: ctx, page
+func (_m *WardrobeRepository) GetAll(ctx context.Context, page *model.Page) (*[]model.Wardrobe, *model.PageInfo, error) {
+	ret := _m.Called(ctx, page)
 
 	var r0 *[]model.Wardrobe
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (*[]model.Wardrobe, error)); ok {
-		return rf(ctx)
+	var r1 *model.PageInfo
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Page) (*[]model.Wardrobe, *model.PageInfo, error)); ok {
+		return rf(ctx, page)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) *[]model.Wardrobe); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Page) *[]model.Wardrobe); ok {
+		r0 = rf(ctx, page)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*[]model.Wardrobe)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Page) *model.PageInfo); ok {
+		r1 = rf(ctx, page)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.PageInfo)
+		}
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, *model.Page) error); ok {
+		r2 = rf(ctx, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// GetAvailable provides a mock function with given fields: ctx
-func (_m *WardrobeRepository) GetAvailable(ctx context.Context) (*[]model.Wardrobe, error) {
-	ret := _m.Called(ctx)
+// GetAvailable provides a mock function with given fields: ctx, page
+func (_m *WardrobeRepository) GetAvailable(ctx context.Context, page *model.Page) (*[]model.Wardrobe, *model.PageInfo, error) {
+	ret := _m.Called(ctx, page)
 
 	var r0 *[]model.Wardrobe
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (*[]model.Wardrobe, error)); ok {
-		return rf(ctx)
+	var r1 *model.PageInfo
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Page) (*[]model.Wardrobe, *model.PageInfo, error)); ok {
+		return rf(ctx, page)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) *[]model.Wardrobe); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Page) *[]model.Wardrobe); ok {
+		r0 = rf(ctx, page)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*[]model.Wardrobe)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Page) *model.PageInfo); ok {
+		r1 = rf(ctx, page)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.PageInfo)
+		}
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, *model.Page) error); ok {
+		r2 = rf(ctx, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // GetById provides a mock function with given fields: ctx, id
@@ -122,25 +168,25 @@ func (_m *WardrobeRepository) GetById(ctx context.Context, id *uuid.UUID) (*mode
 	return r0, r1
 }
 
-// GetLessThan provides a mock function with given fields: ctx, amount
-func (_m *WardrobeRepository) GetLessThan(ctx context.Context, amount int) (*[]model.Wardrobe, error) {
-	ret := _m.Called(ctx, amount)
+// GetByProductId provides a mock function with given fields: ctx, productId
+func (_m *WardrobeRepository) GetByProductId(ctx context.Context, productId *uuid.UUID) (*[]model.Wardrobe, error) {
+	ret := _m.Called(ctx, productId)
 
 	var r0 *[]model.Wardrobe
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) (*[]model.Wardrobe, error)); ok {
-		return rf(ctx, amount)
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*[]model.Wardrobe, error)); ok {
+		return rf(ctx, productId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int) *[]model.Wardrobe); ok {
-		r0 = rf(ctx, amount)
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *[]model.Wardrobe); ok {
+		r0 = rf(ctx, productId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*[]model.Wardrobe)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = rf(ctx, amount)
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, productId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -148,25 +194,25 @@ func (_m *WardrobeRepository) GetLessThan(ctx context.Context, amount int) (*[]m
 	return r0, r1
 }
 
-// GetUnavailable provides a mock function with given fields: ctx
-func (_m *WardrobeRepository) GetUnavailable(ctx context.Context) (*[]model.Wardrobe, error) {
-	ret := _m.Called(ctx)
+// GetBySKUs provides a mock function with given fields: ctx, skus
+func (_m *WardrobeRepository) GetBySKUs(ctx context.Context, skus []string) (*[]model.Wardrobe, error) {
+	ret := _m.Called(ctx, skus)
 
 	var r0 *[]model.Wardrobe
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (*[]model.Wardrobe, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (*[]model.Wardrobe, error)); ok {
+		return rf(ctx, skus)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) *[]model.Wardrobe); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, []string) *[]model.Wardrobe); ok {
+		r0 = rf(ctx, skus)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*[]model.Wardrobe)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, skus)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -174,6 +220,111 @@ func (_m *WardrobeRepository) GetUnavailable(ctx context.Context) (*[]model.Ward
 	return r0, r1
 }
 
+// GetDeleted provides a mock function with given fields: ctx, page
+func (_m *WardrobeRepository) GetDeleted(ctx context.Context, page *model.Page) (*[]model.Wardrobe, *model.PageInfo, error) {
+	ret := _m.Called(ctx, page)
+
+	var r0 *[]model.Wardrobe
+	var r1 *model.PageInfo
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Page) (*[]model.Wardrobe, *model.PageInfo, error)); ok {
+		return rf(ctx, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Page) *[]model.Wardrobe); ok {
+		r0 = rf(ctx, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.Wardrobe)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Page) *model.PageInfo); ok {
+		r1 = rf(ctx, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.PageInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *model.Page) error); ok {
+		r2 = rf(ctx, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetLessThan provides a mock function with given fields: ctx, amount, page
+func (_m *WardrobeRepository) GetLessThan(ctx context.Context, amount int, page *model.Page) (*[]model.Wardrobe, *model.PageInfo, error) {
+	ret := _m.Called(ctx, amount, page)
+
+	var r0 *[]model.Wardrobe
+	var r1 *model.PageInfo
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *model.Page) (*[]model.Wardrobe, *model.PageInfo, error)); ok {
+		return rf(ctx, amount, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *model.Page) *[]model.Wardrobe); ok {
+		r0 = rf(ctx, amount, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.Wardrobe)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *model.Page) *model.PageInfo); ok {
+		r1 = rf(ctx, amount, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.PageInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int, *model.Page) error); ok {
+		r2 = rf(ctx, amount, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetUnavailable provides a mock function with given fields: ctx, page
+func (_m *WardrobeRepository) GetUnavailable(ctx context.Context, page *model.Page) (*[]model.Wardrobe, *model.PageInfo, error) {
+	ret := _m.Called(ctx, page)
+
+	var r0 *[]model.Wardrobe
+	var r1 *model.PageInfo
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Page) (*[]model.Wardrobe, *model.PageInfo, error)); ok {
+		return rf(ctx, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Page) *[]model.Wardrobe); ok {
+		r0 = rf(ctx, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.Wardrobe)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Page) *model.PageInfo); ok {
+		r1 = rf(ctx, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.PageInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *model.Page) error); ok {
+		r2 = rf(ctx, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Insert provides a mock function with given fields: ctx, wardrobe
 func (_m *WardrobeRepository) Insert(ctx context.Context, wardrobe *model.Wardrobe) error {
 	ret := _m.Called(ctx, wardrobe)
@@ -188,25 +339,124 @@ func (_m *WardrobeRepository) Insert(ctx context.Context, wardrobe *model.Wardro
 	return r0
 }
 
-// Search provides a mock function with given fields: ctx, color, size
-func (_m *WardrobeRepository) Search(ctx context.Context, color string, size string) (*[]model.Wardrobe, error) {
-	ret := _m.Called(ctx, color, size)
+// LockById provides a mock function with given fields: ctx, id
+func (_m *WardrobeRepository) LockById(ctx context.Context, id *uuid.UUID) error {
+	ret := _m.Called(ctx, id)
 
-	var r0 *[]model.Wardrobe
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Purge provides a mock function with given fields: ctx, deletedBefore
+func (_m *WardrobeRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	ret := _m.Called(ctx, deletedBefore)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, deletedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, deletedBefore)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, deletedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Restore provides a mock function with given fields: ctx, id
+func (_m *WardrobeRepository) Restore(ctx context.Context, id *uuid.UUID) (*model.Wardrobe, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.Wardrobe
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*[]model.Wardrobe, error)); ok {
-		return rf(ctx, color, size)
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*model.Wardrobe, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *model.Wardrobe); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Wardrobe)
+		}
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *[]model.Wardrobe); ok {
-		r0 = rf(ctx, color, size)
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Search provides a mock function with given fields: ctx, filter, page
+func (_m *WardrobeRepository) Search(ctx context.Context, filter *model.WardrobeFilter, page *model.Page) (*[]model.Wardrobe, *model.PageInfo, error) {
+	ret := _m.Called(ctx, filter, page)
+
+	var r0 *[]model.Wardrobe
+	var r1 *model.PageInfo
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.WardrobeFilter, *model.Page) (*[]model.Wardrobe, *model.PageInfo, error)); ok {
+		return rf(ctx, filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.WardrobeFilter, *model.Page) *[]model.Wardrobe); ok {
+		r0 = rf(ctx, filter, page)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*[]model.Wardrobe)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, color, size)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.WardrobeFilter, *model.Page) *model.PageInfo); ok {
+		r1 = rf(ctx, filter, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.PageInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *model.WardrobeFilter, *model.Page) error); ok {
+		r2 = rf(ctx, filter, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SetCategory provides a mock function with given fields: ctx, id, categoryId
+func (_m *WardrobeRepository) SetCategory(ctx context.Context, id *uuid.UUID, categoryId *uuid.UUID) (*model.Wardrobe, error) {
+	ret := _m.Called(ctx, id, categoryId)
+
+	var r0 *model.Wardrobe
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *uuid.UUID) (*model.Wardrobe, error)); ok {
+		return rf(ctx, id, categoryId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *uuid.UUID) *model.Wardrobe); ok {
+		r0 = rf(ctx, id, categoryId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Wardrobe)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id, categoryId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -214,13 +464,79 @@ func (_m *WardrobeRepository) Search(ctx context.Context, color string, size str
 	return r0, r1
 }
 
+// Stream provides a mock function with given fields: ctx, filter, fn
+func (_m *WardrobeRepository) Stream(ctx context.Context, filter *model.WardrobeFilter, fn func(*model.Wardrobe) error) error {
+	ret := _m.Called(ctx, filter, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.WardrobeFilter, func(*model.Wardrobe) error) error); ok {
+		r0 = rf(ctx, filter, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SubStock provides a mock function with given fields: ctx, id, def
-func (_m *WardrobeRepository) SubStock(ctx context.Context, id *uuid.UUID, def int) error {
+func (_m *WardrobeRepository) SubStock(ctx context.Context, id *uuid.UUID, def int) (*model.Wardrobe, error) {
 	ret := _m.Called(ctx, id, def)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, int) error); ok {
+	var r0 *model.Wardrobe
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, int) (*model.Wardrobe, error)); ok {
+		return rf(ctx, id, def)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, int) *model.Wardrobe); ok {
 		r0 = rf(ctx, id, def)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Wardrobe)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, int) error); ok {
+		r1 = rf(ctx, id, def)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Suggest provides a mock function with given fields: ctx, query
+func (_m *WardrobeRepository) Suggest(ctx context.Context, query string) (*[]model.Wardrobe, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 *[]model.Wardrobe
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*[]model.Wardrobe, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *[]model.Wardrobe); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.Wardrobe)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SyncProduct provides a mock function with given fields: ctx, product
+func (_m *WardrobeRepository) SyncProduct(ctx context.Context, product *model.Product) error {
+	ret := _m.Called(ctx, product)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Product) error); ok {
+		r0 = rf(ctx, product)
 	} else {
 		r0 = ret.Error(0)
 	}
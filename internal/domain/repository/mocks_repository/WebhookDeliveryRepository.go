@@ -0,0 +1,188 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_repository
+
+import (
+	context "context"
+	model "sagara_backend_test/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// WebhookDeliveryRepository is an autogenerated mock type for the WebhookDeliveryRepository type
+type WebhookDeliveryRepository struct {
+	mock.Mock
+}
+
+// ClaimPending provides a mock function with given fields: ctx, limit, lease
+func (_m *WebhookDeliveryRepository) ClaimPending(ctx context.Context, limit int, lease time.Duration) (*[]model.WebhookDelivery, error) {
+	ret := _m.Called(ctx, limit, lease)
+
+	var r0 *[]model.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Duration) (*[]model.WebhookDelivery, error)); ok {
+		return rf(ctx, limit, lease)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Duration) *[]model.WebhookDelivery); ok {
+		r0 = rf(ctx, limit, lease)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Duration) error); ok {
+		r1 = rf(ctx, limit, lease)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAttempts provides a mock function with given fields: ctx, deliveryId
+func (_m *WebhookDeliveryRepository) GetAttempts(ctx context.Context, deliveryId *uuid.UUID) (*[]model.WebhookDeliveryAttempt, error) {
+	ret := _m.Called(ctx, deliveryId)
+
+	var r0 *[]model.WebhookDeliveryAttempt
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*[]model.WebhookDeliveryAttempt, error)); ok {
+		return rf(ctx, deliveryId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *[]model.WebhookDeliveryAttempt); ok {
+		r0 = rf(ctx, deliveryId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.WebhookDeliveryAttempt)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, deliveryId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetById provides a mock function with given fields: ctx, id
+func (_m *WebhookDeliveryRepository) GetById(ctx context.Context, id *uuid.UUID) (*model.WebhookDelivery, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*model.WebhookDelivery, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *model.WebhookDelivery); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBySubscription provides a mock function with given fields: ctx, subscriptionId, page
+func (_m *WebhookDeliveryRepository) GetBySubscription(ctx context.Context, subscriptionId *uuid.UUID, page *model.Page) (*[]model.WebhookDelivery, *model.PageInfo, error) {
+	ret := _m.Called(ctx, subscriptionId, page)
+
+	var r0 *[]model.WebhookDelivery
+	var r1 *model.PageInfo
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *model.Page) (*[]model.WebhookDelivery, *model.PageInfo, error)); ok {
+		return rf(ctx, subscriptionId, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *model.Page) *[]model.WebhookDelivery); ok {
+		r0 = rf(ctx, subscriptionId, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *model.Page) *model.PageInfo); ok {
+		r1 = rf(ctx, subscriptionId, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.PageInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *uuid.UUID, *model.Page) error); ok {
+		r2 = rf(ctx, subscriptionId, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Insert provides a mock function with given fields: ctx, delivery
+func (_m *WebhookDeliveryRepository) Insert(ctx context.Context, delivery *model.WebhookDelivery) error {
+	ret := _m.Called(ctx, delivery)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.WebhookDelivery) error); ok {
+		r0 = rf(ctx, delivery)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InsertAttempt provides a mock function with given fields: ctx, attempt
+func (_m *WebhookDeliveryRepository) InsertAttempt(ctx context.Context, attempt *model.WebhookDeliveryAttempt) error {
+	ret := _m.Called(ctx, attempt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.WebhookDeliveryAttempt) error); ok {
+		r0 = rf(ctx, attempt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, delivery
+func (_m *WebhookDeliveryRepository) Update(ctx context.Context, delivery *model.WebhookDelivery) error {
+	ret := _m.Called(ctx, delivery)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.WebhookDelivery) error); ok {
+		r0 = rf(ctx, delivery)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewWebhookDeliveryRepository creates a new instance of WebhookDeliveryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookDeliveryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookDeliveryRepository {
+	mock := &WebhookDeliveryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
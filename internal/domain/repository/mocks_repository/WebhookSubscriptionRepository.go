@@ -0,0 +1,189 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_repository
+
+import (
+	context "context"
+	model "sagara_backend_test/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// WebhookSubscriptionRepository is an autogenerated mock type for the WebhookSubscriptionRepository type
+type WebhookSubscriptionRepository struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *WebhookSubscriptionRepository) Delete(ctx context.Context, id *uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetActive provides a mock function with given fields: ctx
+func (_m *WebhookSubscriptionRepository) GetActive(ctx context.Context) (*[]model.WebhookSubscription, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *[]model.WebhookSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*[]model.WebhookSubscription, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *[]model.WebhookSubscription); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.WebhookSubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *WebhookSubscriptionRepository) GetAll(ctx context.Context) (*[]model.WebhookSubscription, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *[]model.WebhookSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*[]model.WebhookSubscription, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *[]model.WebhookSubscription); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.WebhookSubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetById provides a mock function with given fields: ctx, id
+func (_m *WebhookSubscriptionRepository) GetById(ctx context.Context, id *uuid.UUID) (*model.WebhookSubscription, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.WebhookSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*model.WebhookSubscription, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *model.WebhookSubscription); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WebhookSubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, subscription
+func (_m *WebhookSubscriptionRepository) Insert(ctx context.Context, subscription *model.WebhookSubscription) error {
+	ret := _m.Called(ctx, subscription)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.WebhookSubscription) error); ok {
+		r0 = rf(ctx, subscription)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RecordFailure provides a mock function with given fields: ctx, id, disableAfter, reason
+func (_m *WebhookSubscriptionRepository) RecordFailure(ctx context.Context, id *uuid.UUID, disableAfter int, reason string) (bool, error) {
+	ret := _m.Called(ctx, id, disableAfter, reason)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, int, string) (bool, error)); ok {
+		return rf(ctx, id, disableAfter, reason)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, int, string) bool); ok {
+		r0 = rf(ctx, id, disableAfter, reason)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, int, string) error); ok {
+		r1 = rf(ctx, id, disableAfter, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetFailures provides a mock function with given fields: ctx, id
+func (_m *WebhookSubscriptionRepository) ResetFailures(ctx context.Context, id *uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, subscription
+func (_m *WebhookSubscriptionRepository) Update(ctx context.Context, subscription *model.WebhookSubscription) error {
+	ret := _m.Called(ctx, subscription)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.WebhookSubscription) error); ok {
+		r0 = rf(ctx, subscription)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewWebhookSubscriptionRepository creates a new instance of WebhookSubscriptionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookSubscriptionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookSubscriptionRepository {
+	mock := &WebhookSubscriptionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
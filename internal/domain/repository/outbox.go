@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"sagara_backend_test/internal/domain/model"
+	"time"
+)
+
+type OutboxRepository interface {
+	Insert(ctx context.Context, event *model.OutboxEvent) error
+	ClaimPending(ctx context.Context, limit int, lease time.Duration) (*[]model.OutboxEvent, error)
+	Update(ctx context.Context, event *model.OutboxEvent) error
+}
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+)
+
+type PriceChangeRepository interface {
+	Insert(ctx context.Context, change *model.PriceChange) error
+	GetByWardrobeId(ctx context.Context, wardrobeId *uuid.UUID) (*[]model.PriceChange, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"time"
+)
+
+type PriceScheduleRepository interface {
+	Insert(ctx context.Context, schedule *model.PriceSchedule) error
+	GetById(ctx context.Context, id *uuid.UUID) (*model.PriceSchedule, error)
+	GetByWardrobeId(ctx context.Context, wardrobeId *uuid.UUID, statuses ...string) (*[]model.PriceSchedule, error)
+	GetStarting(ctx context.Context, now time.Time) (*[]model.PriceSchedule, error)
+	GetEnding(ctx context.Context, now time.Time) (*[]model.PriceSchedule, error)
+	UpdateStatus(ctx context.Context, schedule *model.PriceSchedule, fromStatus string) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+)
+
+type ProductRepository interface {
+	Insert(ctx context.Context, product *model.Product) error
+	Update(ctx context.Context, product *model.Product) error
+	GetAll(ctx context.Context) (*[]model.Product, error)
+	GetById(ctx context.Context, id *uuid.UUID) (*model.Product, error)
+	GetByIds(ctx context.Context, ids []uuid.UUID) (*[]model.Product, error)
+	Delete(ctx context.Context, id *uuid.UUID) error
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+)
+
+type PurchaseOrderRepository interface {
+	Insert(ctx context.Context, order *model.PurchaseOrder) error
+	GetById(ctx context.Context, id *uuid.UUID) (*model.PurchaseOrder, error)
+	LockById(ctx context.Context, id *uuid.UUID) (*model.PurchaseOrder, error)
+	Get(ctx context.Context, filter *model.PurchaseOrderFilter, page *model.Page) (*[]model.PurchaseOrder, *model.PageInfo, error)
+	Update(ctx context.Context, order *model.PurchaseOrder) error
+	GetLines(ctx context.Context, orderId *uuid.UUID) (*[]model.PurchaseOrderLine, error)
+	InsertLines(ctx context.Context, lines []model.PurchaseOrderLine) error
+	DeleteLines(ctx context.Context, orderId *uuid.UUID) error
+	ReceiveLine(ctx context.Context, lineId *uuid.UUID, quantity int) error
+}
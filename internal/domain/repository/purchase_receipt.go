@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+)
+
+type PurchaseReceiptRepository interface {
+	Insert(ctx context.Context, receipt *model.PurchaseReceipt) error
+	GetByPurchaseOrder(ctx context.Context, orderId *uuid.UUID) (*[]model.PurchaseReceipt, error)
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"time"
+)
+
+type ReservationRepository interface {
+	Insert(ctx context.Context, reservation *model.Reservation) error
+	GetById(ctx context.Context, id *uuid.UUID) (*model.Reservation, error)
+	UpdateStatus(ctx context.Context, id *uuid.UUID, status string) (*model.Reservation, error)
+	ExpireStale(ctx context.Context, now time.Time) (int64, error)
+}
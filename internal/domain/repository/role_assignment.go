@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"sagara_backend_test/internal/domain/model"
+)
+
+type RoleAssignmentRepository interface {
+	GetAll(ctx context.Context) (*[]model.RoleAssignment, error)
+	GetBySubject(ctx context.Context, subject string) (*model.RoleAssignment, error)
+	Upsert(ctx context.Context, assignment *model.RoleAssignment) error
+	Delete(ctx context.Context, subject string) error
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+)
+
+type SalesOrderRepository interface {
+	Insert(ctx context.Context, order *model.SalesOrder) error
+	GetById(ctx context.Context, id *uuid.UUID) (*model.SalesOrder, error)
+	LockById(ctx context.Context, id *uuid.UUID) (*model.SalesOrder, error)
+	Get(ctx context.Context, filter *model.SalesOrderFilter, page *model.Page) (*[]model.SalesOrder, *model.PageInfo, error)
+	Update(ctx context.Context, order *model.SalesOrder) error
+	GetLines(ctx context.Context, orderId *uuid.UUID) (*[]model.SalesOrderLine, error)
+	InsertLines(ctx context.Context, lines []model.SalesOrderLine) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+)
+
+type StockAlertRepository interface {
+	Insert(ctx context.Context, alert *model.StockAlert) error
+	GetById(ctx context.Context, id *uuid.UUID) (*model.StockAlert, error)
+	GetUnresolved(ctx context.Context, wardrobeId *uuid.UUID) (*model.StockAlert, error)
+	GetByStatus(ctx context.Context, status string, page *model.Page) (*[]model.StockAlert, *model.PageInfo, error)
+	LockUnnotified(ctx context.Context, limit, maxAttempts int) (*[]model.StockAlert, error)
+	Update(ctx context.Context, alert *model.StockAlert) error
+}
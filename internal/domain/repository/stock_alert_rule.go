@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+)
+
+type StockAlertRuleRepository interface {
+	GetAll(ctx context.Context) (*[]model.StockAlertRule, error)
+	Upsert(ctx context.Context, rule *model.StockAlertRule) (*model.StockAlertRule, error)
+	Delete(ctx context.Context, id *uuid.UUID) error
+	GetForWardrobe(ctx context.Context, wardrobe *model.Wardrobe) (*model.StockAlertRule, error)
+}
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+)
+
+type StockMovementRepository interface {
+	Insert(ctx context.Context, movement *model.StockMovement) error
+	GetByWardrobeId(ctx context.Context, wardrobeId *uuid.UUID) (*[]model.StockMovement, error)
+}
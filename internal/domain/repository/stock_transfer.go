@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+)
+
+type StockTransferRepository interface {
+	Insert(ctx context.Context, transfer *model.StockTransfer) error
+	GetById(ctx context.Context, id *uuid.UUID) (*model.StockTransfer, error)
+	LockById(ctx context.Context, id *uuid.UUID) (*model.StockTransfer, error)
+	Get(ctx context.Context, filter *model.StockTransferFilter, page *model.Page) (*[]model.StockTransfer, *model.PageInfo, error)
+	Update(ctx context.Context, transfer *model.StockTransfer) error
+	GetInTransit(ctx context.Context, wardrobeId *uuid.UUID) (int, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+)
+
+type TagRepository interface {
+	Upsert(ctx context.Context, tag *model.Tag) (*model.Tag, error)
+	GetAll(ctx context.Context) (*[]model.Tag, error)
+	GetById(ctx context.Context, id *uuid.UUID) (*model.Tag, error)
+	Delete(ctx context.Context, id *uuid.UUID) error
+	GetByWardrobeId(ctx context.Context, wardrobeId *uuid.UUID) (*[]model.Tag, error)
+	Assign(ctx context.Context, wardrobeId, tagId *uuid.UUID) error
+	Unassign(ctx context.Context, wardrobeId, tagId *uuid.UUID) error
+}
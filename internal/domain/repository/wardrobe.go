@@ -4,18 +4,30 @@ import (
 	"context"
 	"github.com/google/uuid"
 	"sagara_backend_test/internal/domain/model"
+	"time"
 )
 
 type WardrobeRepository interface {
 	Insert(ctx context.Context, wardrobe *model.Wardrobe) error
 	Update(ctx context.Context, wardrobe *model.Wardrobe) error
-	GetAll(ctx context.Context) (*[]model.Wardrobe, error)
+	GetAll(ctx context.Context, page *model.Page) (*[]model.Wardrobe, *model.PageInfo, error)
 	GetById(ctx context.Context, id *uuid.UUID) (*model.Wardrobe, error)
+	LockById(ctx context.Context, id *uuid.UUID) error
+	BumpVersion(ctx context.Context, id *uuid.UUID) error
 	Delete(ctx context.Context, id *uuid.UUID) error
-	Search(ctx context.Context, color, size string) (*[]model.Wardrobe, error)
-	AddStock(ctx context.Context, id *uuid.UUID, addition int) error
-	SubStock(ctx context.Context, id *uuid.UUID, def int) error
-	GetAvailable(ctx context.Context) (*[]model.Wardrobe, error)
-	GetUnavailable(ctx context.Context) (*[]model.Wardrobe, error)
-	GetLessThan(ctx context.Context, amount int) (*[]model.Wardrobe, error)
+	GetDeleted(ctx context.Context, page *model.Page) (*[]model.Wardrobe, *model.PageInfo, error)
+	Restore(ctx context.Context, id *uuid.UUID) (*model.Wardrobe, error)
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
+	Search(ctx context.Context, filter *model.WardrobeFilter, page *model.Page) (*[]model.Wardrobe, *model.PageInfo, error)
+	AddStock(ctx context.Context, id *uuid.UUID, addition int) (*model.Wardrobe, error)
+	SubStock(ctx context.Context, id *uuid.UUID, def int) (*model.Wardrobe, error)
+	GetAvailable(ctx context.Context, page *model.Page) (*[]model.Wardrobe, *model.PageInfo, error)
+	GetUnavailable(ctx context.Context, page *model.Page) (*[]model.Wardrobe, *model.PageInfo, error)
+	GetLessThan(ctx context.Context, amount int, page *model.Page) (*[]model.Wardrobe, *model.PageInfo, error)
+	GetByProductId(ctx context.Context, productId *uuid.UUID) (*[]model.Wardrobe, error)
+	GetBySKUs(ctx context.Context, skus []string) (*[]model.Wardrobe, error)
+	Stream(ctx context.Context, filter *model.WardrobeFilter, fn func(wardrobe *model.Wardrobe) error) error
+	SyncProduct(ctx context.Context, product *model.Product) error
+	SetCategory(ctx context.Context, id, categoryId *uuid.UUID) (*model.Wardrobe, error)
+	Suggest(ctx context.Context, query string) (*[]model.Wardrobe, error)
 }
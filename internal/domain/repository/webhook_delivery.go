@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"time"
+)
+
+type WebhookDeliveryRepository interface {
+	Insert(ctx context.Context, delivery *model.WebhookDelivery) error
+	GetById(ctx context.Context, id *uuid.UUID) (*model.WebhookDelivery, error)
+	GetBySubscription(ctx context.Context, subscriptionId *uuid.UUID, page *model.Page) (*[]model.WebhookDelivery, *model.PageInfo, error)
+	ClaimPending(ctx context.Context, limit int, lease time.Duration) (*[]model.WebhookDelivery, error)
+	Update(ctx context.Context, delivery *model.WebhookDelivery) error
+	InsertAttempt(ctx context.Context, attempt *model.WebhookDeliveryAttempt) error
+	GetAttempts(ctx context.Context, deliveryId *uuid.UUID) (*[]model.WebhookDeliveryAttempt, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+)
+
+type WebhookSubscriptionRepository interface {
+	GetAll(ctx context.Context) (*[]model.WebhookSubscription, error)
+	GetActive(ctx context.Context) (*[]model.WebhookSubscription, error)
+	GetById(ctx context.Context, id *uuid.UUID) (*model.WebhookSubscription, error)
+	Insert(ctx context.Context, subscription *model.WebhookSubscription) error
+	Update(ctx context.Context, subscription *model.WebhookSubscription) error
+	RecordFailure(ctx context.Context, id *uuid.UUID, disableAfter int, reason string) (bool, error)
+	ResetFailures(ctx context.Context, id *uuid.UUID) error
+	Delete(ctx context.Context, id *uuid.UUID) error
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"context"
+	"sagara_backend_test/config"
+	"sagara_backend_test/internal/usecases"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/router"
+)
+
+// APIKeyHeader carries the API key of the machines calling the API
+const APIKeyHeader = "X-API-Key"
+
+type (
+	apiKeyAuthenticator struct {
+		apiKeyUc usecases.APIKeyUseCases
+	}
+
+	// roleAuthenticator gives the claims of the next authenticator the permissions of the role of their subject
+	roleAuthenticator struct {
+		next   router.Authenticator
+		roleUc usecases.RoleUseCases
+	}
+)
+
+// newAuthenticator accepts API keys and the bearer tokens as configured, there is no way to leave the routes public
+// by omission
+func newAuthenticator(cfg *config.AuthConfig, apiKeyUc usecases.APIKeyUseCases, roleUc usecases.RoleUseCases) (router.Authenticator, error) {
+	apiKeys := &apiKeyAuthenticator{apiKeyUc: apiKeyUc}
+
+	if cfg.Disabled {
+		log.Warn("Authentication is disabled, every route is public")
+		return &roleAuthenticator{next: router.Chain(apiKeys, router.AllowAnonymous), roleUc: roleUc}, nil
+	}
+
+	tokens, err := router.NewJWTAuthenticator(&router.JWTConfig{
+		Secret:      cfg.Secret,
+		JWKSFile:    cfg.JWKSFile,
+		JWKSURL:     cfg.JWKSURL,
+		JWKSRefresh: cfg.JWKSRefresh,
+		Issuer:      cfg.Issuer,
+		Audience:    cfg.Audience,
+		Leeway:      cfg.Leeway,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &roleAuthenticator{next: router.Chain(apiKeys, tokens), roleUc: roleUc}, nil
+}
+
+// Authenticate verifies the API key header, the subject of the claims is the id of the key
+func (a *apiKeyAuthenticator) Authenticate(ctx context.Context, req *router.Request) (*router.Claims, error) {
+	key := req.Header(APIKeyHeader)
+	if key == "" {
+		return nil, router.ErrNoCredentials
+	}
+
+	apiKey, err := a.apiKeyUc.Authenticate(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &router.Claims{
+		Subject: apiKey.Subject,
+		Scopes:  apiKey.Scopes,
+		Raw: map[string]any{
+			"api_key_id":   apiKey.ID,
+			"api_key_name": apiKey.Name,
+		},
+	}, nil
+}
+
+// Authenticate resolves the role of the subject once the credentials are verified, the unrestricted claims of a
+// disabled authentication keep every permission
+func (a *roleAuthenticator) Authenticate(ctx context.Context, req *router.Request) (*router.Claims, error) {
+	claims, err := a.next.Authenticate(ctx, req)
+	if err != nil || claims.Unrestricted {
+		return claims, err
+	}
+
+	role, err := a.roleUc.ResolveRole(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	claims.Role = role.Name
+	claims.Permissions = role.Permissions
+	return claims, nil
+}
@@ -0,0 +1,16 @@
+package controller
+
+import (
+	"context"
+	"sagara_backend_test/internal/infrastructures/actor"
+	"sagara_backend_test/lib/router"
+	"sagara_backend_test/pkg/constants"
+)
+
+// withActor tells the use cases who makes the request: the subject of the verified token, else the actor header
+func withActor(ctx context.Context, req *router.Request) context.Context {
+	if claims, ok := router.ClaimsFromContext(ctx); ok && claims.Subject != constants.EmptyString {
+		return actor.NewContext(ctx, claims.Subject)
+	}
+	return actor.NewContext(ctx, req.Header(actor.Header))
+}
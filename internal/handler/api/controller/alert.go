@@ -0,0 +1,167 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/infrastructures/custresp"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/lib/response/rest"
+	"sagara_backend_test/lib/router"
+	"sagara_backend_test/lib/tracing"
+)
+
+// GetAlertRules godoc
+// @Summary 	Get Alert Rules
+// @Description	Get the low stock thresholds set on wardrobes and categories
+// @Tags		alerts
+// @Accept		json
+// @Produce		json
+// @Success		200	{object}	jsonResponse{data=[]response.StockAlertRuleResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/alerts/rules	[get]
+func (api *API) GetAlertRules(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetAlertRules")
+	defer span.End()
+
+	res, err := api.alertUc.GetRules(ctx)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// SetAlertRule godoc
+// @Summary 	Set Alert Rule
+// @Description	Set the low stock threshold of a wardrobe, or of a category and its descendants, replacing the one already set. A wardrobe rule wins over the closest category rule, the configured default threshold applies without any
+// @Tags		alerts
+// @Accept		json
+// @Param		rule 		body 	request.StockAlertRuleRequest true "Rule Payload"
+// @Produce		json
+// @Success		200	{object}	jsonResponse{data=response.StockAlertRuleResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/alerts/rules	[post]
+func (api *API) SetAlertRule(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.SetAlertRule")
+	defer span.End()
+
+	var ruleReq request.StockAlertRuleRequest
+	err := json.Unmarshal(req.RawBody(), &ruleReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	err = ruleReq.ValidateRule()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.alertUc.SetRule(ctx, &ruleReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// DeleteAlertRule godoc
+// @Summary 	Delete Alert Rule
+// @Description	Delete an alert rule, its wardrobes fall back to the next rule or the default threshold
+// @Tags		alerts
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"rule id"
+// @Success		200	{object}	jsonResponse{}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/alerts/rules/{id}	[delete]
+func (api *API) DeleteAlertRule(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.DeleteAlertRule")
+	defer span.End()
+
+	ruleID, err := uuid.Parse(req.Params("id"))
+	if err != nil {
+		return custresp.CustomErrorResponse(errors.New("invalid id"))
+	}
+
+	err = api.alertUc.DeleteRule(ctx, &ruleID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData("success"), nil
+}
+
+// GetAlerts godoc
+// @Summary 	Get Stock Alerts
+// @Description	Get the low stock alerts in a status, the open ones by default, the most recent first by default
+// @Tags		alerts
+// @Accept		json
+// @Produce		json
+// @Param 		status	query		string	false	"open, acknowledged or resolved"
+// @Param 		limit	query		int		false	"page size, 20 by default and 100 at most"
+// @Param 		cursor	query		string	false	"next_cursor of the previous page"
+// @Param 		sort	query		string	false	"created_at, prefix with - to sort descending"
+// @Param 		total	query		bool	false	"count every alert in the status"
+// @Success		200	{object}	jsonResponse{data=[]response.StockAlertResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/alerts	[get]
+func (api *API) GetAlerts(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetAlerts")
+	defer span.End()
+
+	status := req.Query("status")
+	if status != "" {
+		err := request.ValidateAlertStatus(status)
+		if err != nil {
+			return custresp.CustomErrorResponse(err)
+		}
+	}
+
+	pageReq, err := parsePageRequest(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, pagination, err := api.alertUc.GetAlerts(ctx, status, pageReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res).SetPagination(pagination), nil
+}
+
+// AcknowledgeAlert godoc
+// @Summary 	Acknowledge Stock Alert
+// @Description	Mark an open alert as seen, it is resolved once the stock is back over the threshold
+// @Tags		alerts
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"alert id"
+// @Param 		X-Actor		header		string	false	"who acknowledges the alert"
+// @Success		200	{object}	jsonResponse{data=response.StockAlertResponse}
+// @Failure		409	{object}	jsonResponse{}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/alerts/{id}/ack	[post]
+func (api *API) AcknowledgeAlert(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.AcknowledgeAlert")
+	defer span.End()
+
+	alertID, err := uuid.Parse(req.Params("id"))
+	if err != nil {
+		return custresp.CustomErrorResponse(errors.New("invalid id"))
+	}
+
+	res, err := api.alertUc.AcknowledgeAlert(withActor(ctx, req), &alertID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
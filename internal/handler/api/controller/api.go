@@ -2,78 +2,273 @@ package controller
 
 import (
 	"github.com/gofiber/swagger"
+	"net/http"
 	_ "sagara_backend_test/docs"
 	"sagara_backend_test/internal/usecases"
 	"sagara_backend_test/lib/router"
+	"sagara_backend_test/pkg/constants"
 	"time"
 )
 
 type API struct {
-	prefix         string
-	port           uint
-	readTimeout    time.Duration
-	writeTimeout   time.Duration
-	requestTimeout time.Duration
-	enableSwagger  bool
-	wardrobeUc     usecases.WardrobeUseCases
+	prefix           string
+	port             uint
+	readTimeout      time.Duration
+	writeTimeout     time.Duration
+	requestTimeout   time.Duration
+	enableSwagger    bool
+	proxyHeader      string
+	authenticator    router.Authenticator
+	rateLimit        *router.RateLimit
+	ipRateLimit      *router.RateLimit
+	idempotencyStore router.IdempotencyStore
+	wardrobeUc       usecases.WardrobeUseCases
+	reservationUc    usecases.ReservationUseCases
+	productUc        usecases.ProductUseCases
+	categoryUc       usecases.CategoryUseCases
+	tagUc            usecases.TagUseCases
+	importUc         usecases.ImportUseCases
+	auditUc          usecases.AuditUseCases
+	alertUc          usecases.AlertUseCases
+	webhookUc        usecases.WebhookUseCases
+	locationUc       usecases.LocationUseCases
+	purchaseOrderUc  usecases.PurchaseOrderUseCases
+	salesOrderUc     usecases.SalesOrderUseCases
+	apiKeyUc         usecases.APIKeyUseCases
+	roleUc           usecases.RoleUseCases
 }
 
 type Options struct {
-	Prefix         string
-	Port           uint
-	ReadTimeout    time.Duration
-	WriteTimeout   time.Duration
-	RequestTimeout time.Duration
-	EnableSwagger  bool
-	WardrobeUc     usecases.WardrobeUseCases
+	Prefix           string
+	Port             uint
+	ReadTimeout      time.Duration
+	WriteTimeout     time.Duration
+	RequestTimeout   time.Duration
+	EnableSwagger    bool
+	ProxyHeader      string
+	Authenticator    router.Authenticator
+	RateLimit        *router.RateLimit
+	IPRateLimit      *router.RateLimit
+	IdempotencyStore router.IdempotencyStore
+	WardrobeUc       usecases.WardrobeUseCases
+	ReservationUc    usecases.ReservationUseCases
+	ProductUc        usecases.ProductUseCases
+	CategoryUc       usecases.CategoryUseCases
+	TagUc            usecases.TagUseCases
+	ImportUc         usecases.ImportUseCases
+	AuditUc          usecases.AuditUseCases
+	AlertUc          usecases.AlertUseCases
+	WebhookUc        usecases.WebhookUseCases
+	LocationUc       usecases.LocationUseCases
+	PurchaseOrderUc  usecases.PurchaseOrderUseCases
+	SalesOrderUc     usecases.SalesOrderUseCases
+	APIKeyUc         usecases.APIKeyUseCases
+	RoleUc           usecases.RoleUseCases
 }
 
 func New(opts *Options) *API {
 	return &API{
-		prefix:         opts.Prefix,
-		port:           opts.Port,
-		readTimeout:    opts.ReadTimeout,
-		writeTimeout:   opts.WriteTimeout,
-		requestTimeout: opts.RequestTimeout,
-		enableSwagger:  opts.EnableSwagger,
-		wardrobeUc:     opts.WardrobeUc,
+		prefix:           opts.Prefix,
+		port:             opts.Port,
+		readTimeout:      opts.ReadTimeout,
+		writeTimeout:     opts.WriteTimeout,
+		requestTimeout:   opts.RequestTimeout,
+		enableSwagger:    opts.EnableSwagger,
+		proxyHeader:      opts.ProxyHeader,
+		authenticator:    opts.Authenticator,
+		rateLimit:        opts.RateLimit,
+		ipRateLimit:      opts.IPRateLimit,
+		idempotencyStore: opts.IdempotencyStore,
+		wardrobeUc:       opts.WardrobeUc,
+		reservationUc:    opts.ReservationUc,
+		productUc:        opts.ProductUc,
+		categoryUc:       opts.CategoryUc,
+		tagUc:            opts.TagUc,
+		importUc:         opts.ImportUc,
+		auditUc:          opts.AuditUc,
+		alertUc:          opts.AlertUc,
+		webhookUc:        opts.WebhookUc,
+		locationUc:       opts.LocationUc,
+		purchaseOrderUc:  opts.PurchaseOrderUc,
+		salesOrderUc:     opts.SalesOrderUc,
+		apiKeyUc:         opts.APIKeyUc,
+		roleUc:           opts.RoleUc,
 	}
 }
 
 func (api *API) RegisterRoute() *router.FastRouter {
 	myRouter := router.New(&router.Options{
-		Prefix:         api.prefix,
-		Port:           api.port,
-		ReadTimeout:    api.readTimeout,
-		WriteTimeout:   api.writeTimeout,
-		RequestTimeout: api.requestTimeout,
+		Prefix:           api.prefix,
+		Port:             api.port,
+		ReadTimeout:      api.readTimeout,
+		WriteTimeout:     api.writeTimeout,
+		RequestTimeout:   api.requestTimeout,
+		Authenticator:    api.authenticator,
+		RateLimit:        api.rateLimit,
+		IPRateLimit:      api.ipRateLimit,
+		ProxyHeader:      api.proxyHeader,
+		IdempotencyStore: api.idempotencyStore,
 	})
 
 	if api.enableSwagger {
 		myRouter.CustomHandler("GET", "/docs/*", swagger.HandlerDefault, router.MustAuthorized(false))
 	}
 
-	myRouter.GET("/health", api.Ping, router.MustAuthorized(false))
+	// probes must not run out of tokens
+	myRouter.GET("/health", api.Ping, router.MustAuthorized(false), router.WithRateLimit(router.RateLimit{}))
 
 	myRouter.Group("/v1", func(v1 *router.FastRouter) {
+		// the scopes narrow what a key or a token may call, the permissions what its role is trusted with
+		canRead := router.RequireScope(constants.ScopeWardrobeRead)
+		canWrite := router.RequireScope(constants.ScopeWardrobeWrite)
+		canAdjustStock := router.RequireScope(constants.ScopeStockAdjust)
+		canManageSettings := router.RequireScope(constants.ScopeSettingsManage)
+		mayRead := router.RequirePermission(constants.PermissionWardrobeRead)
+		mayManageCatalog := router.RequirePermission(constants.PermissionCatalogManage)
+		mayAdjustStock := router.RequirePermission(constants.PermissionStockAdjust)
+		mayManageOrders := router.RequirePermission(constants.PermissionOrdersManage)
+		mayManageSettings := router.RequirePermission(constants.PermissionSettingsManage)
+		// retries of the requests that create or move stock must not apply twice
+		idempotent := router.Idempotent()
+
 		v1.Group("/wardrobe", func(wardrobe *router.FastRouter) {
-			wardrobe.GET("/search", api.Search, router.MustAuthorized(false))
-			wardrobe.GET("/ready", api.GetAvailable, router.MustAuthorized(false))
-			wardrobe.GET("/out", api.GetUnavailable, router.MustAuthorized(false))
-			wardrobe.GET("/less", api.GetLessThan, router.MustAuthorized(false))
-			wardrobe.PUT("/:id", api.Update, router.MustAuthorized(false))
-			wardrobe.GET("/:id", api.GetById, router.MustAuthorized(false))
-			wardrobe.DELETE("/:id", api.Delete, router.MustAuthorized(false))
-			wardrobe.PUT("/:id/addStock", api.AddStock, router.MustAuthorized(false))
-			wardrobe.PUT("/:id/subStock", api.SubStock, router.MustAuthorized(false))
-			wardrobe.GET("", api.GetAll, router.MustAuthorized(false))
-			wardrobe.POST("", api.Insert, router.MustAuthorized(false))
+			mayCreate := router.RequirePermission(constants.PermissionWardrobeCreate)
+			mayUpdate := router.RequirePermission(constants.PermissionWardrobeUpdate)
+			mayPrice := router.RequirePermission(constants.PermissionWardrobePrice)
+			mayDelete := router.RequirePermission(constants.PermissionWardrobeDelete)
+			// the files and the batches are heavy on the database, they are limited apart from the rest
+			bulkLimit := router.WithRateLimit(router.RateLimit{Limit: 10, Period: time.Minute})
+
+			wardrobe.GET("/search", api.Search, canRead, mayRead)
+			wardrobe.GET("/suggest", api.Suggest, canRead, mayRead)
+			wardrobe.GET("/ready", api.GetAvailable, canRead, mayRead)
+			wardrobe.GET("/out", api.GetUnavailable, canRead, mayRead)
+			wardrobe.GET("/less", api.GetLessThan, canRead, mayRead)
+			wardrobe.GET("/trash", api.GetTrash, canRead, mayRead)
+			wardrobe.HandleAttachment(http.MethodGet, "/export", api.Export, canRead, mayRead, bulkLimit)
+			// every operation of a batch is checked against the permission of its own route
+			wardrobe.POST("/batch", api.Batch, canWrite, bulkLimit, idempotent)
+			wardrobe.POST("/import", api.ImportWardrobe, canWrite, mayCreate, mayUpdate, bulkLimit)
+			wardrobe.GET("/import/:jobId", api.GetImportJob, canRead, mayRead)
+			wardrobe.POST("/trash/purge", api.PurgeTrash, canWrite, mayDelete)
+			// the price in the payload is checked by the use case, see constants.PermissionWardrobePrice
+			wardrobe.PUT("/:id", api.Update, canWrite, mayUpdate)
+			wardrobe.GET("/:id", api.GetById, canRead, mayRead)
+			wardrobe.DELETE("/:id", api.Delete, canWrite, mayDelete)
+			wardrobe.POST("/:id/restore", api.Restore, canWrite, mayDelete)
+			wardrobe.PUT("/:id/addStock", api.AddStock, canAdjustStock, mayAdjustStock, idempotent)
+			wardrobe.PUT("/:id/subStock", api.SubStock, canAdjustStock, mayAdjustStock, idempotent)
+			wardrobe.GET("/:id/movements", api.GetStockMovements, canRead, mayRead)
+			wardrobe.GET("/:id/stock", api.GetStockLevels, canRead, mayRead)
+			wardrobe.POST("/:id/transfers", api.TransferStock, canAdjustStock, mayAdjustStock, idempotent)
+			wardrobe.POST("/:id/reservations", api.Reserve, canAdjustStock, mayAdjustStock, idempotent)
+			wardrobe.GET("/:id/prices", api.GetPriceHistory, canRead, mayRead)
+			wardrobe.GET("/:id/prices/schedules", api.GetPriceSchedules, canRead, mayRead)
+			wardrobe.POST("/:id/prices/schedules", api.SchedulePrice, canWrite, mayPrice)
+			wardrobe.DELETE("/:id/prices/schedules/:scheduleId", api.CancelPriceSchedule, canWrite, mayPrice)
+			wardrobe.PUT("/:id/category", api.SetCategory, canWrite, mayUpdate)
+			wardrobe.GET("/:id/tags", api.GetWardrobeTags, canRead, mayRead)
+			wardrobe.POST("/:id/tags", api.AddWardrobeTags, canWrite, mayUpdate)
+			wardrobe.DELETE("/:id/tags/:tagId", api.RemoveWardrobeTag, canWrite, mayUpdate)
+			wardrobe.GET("", api.GetAll, canRead, mayRead)
+			wardrobe.POST("", api.Insert, canWrite, mayCreate, idempotent)
+		})
+		v1.Group("/reservations", func(reservation *router.FastRouter) {
+			reservation.GET("/:id", api.GetReservation, canRead, mayRead)
+			reservation.POST("/:id/commit", api.CommitReservation, canAdjustStock, mayAdjustStock)
+			reservation.POST("/:id/release", api.ReleaseReservation, canAdjustStock, mayAdjustStock)
+		})
+		v1.Group("/products", func(product *router.FastRouter) {
+			product.GET("/search", api.SearchProduct, canRead, mayRead)
+			product.GET("/:id/variants", api.GetVariants, canRead, mayRead)
+			product.POST("/:id/variants", api.AddVariant, canWrite, mayManageCatalog)
+			product.PUT("/:id", api.UpdateProduct, canWrite, mayManageCatalog)
+			product.GET("/:id", api.GetProduct, canRead, mayRead)
+			product.DELETE("/:id", api.DeleteProduct, canWrite, mayManageCatalog)
+			product.GET("", api.GetAllProduct, canRead, mayRead)
+			product.POST("", api.InsertProduct, canWrite, mayManageCatalog)
+		})
+		v1.Group("/categories", func(category *router.FastRouter) {
+			category.PUT("/:id", api.UpdateCategory, canWrite, mayManageCatalog)
+			category.GET("/:id", api.GetCategory, canRead, mayRead)
+			category.DELETE("/:id", api.DeleteCategory, canWrite, mayManageCatalog)
+			category.GET("", api.GetCategoryTree, canRead, mayRead)
+			category.POST("", api.InsertCategory, canWrite, mayManageCatalog)
+		})
+		v1.Group("/tags", func(tag *router.FastRouter) {
+			tag.DELETE("/:id", api.DeleteTag, canWrite, mayManageCatalog)
+			tag.GET("", api.GetAllTag, canRead, mayRead)
+			tag.POST("", api.InsertTag, canWrite, mayManageCatalog)
+		})
+		v1.Group("/locations", func(location *router.FastRouter) {
+			location.PUT("/:id", api.UpdateLocation, canWrite, mayManageCatalog)
+			location.GET("/:id", api.GetLocation, canRead, mayRead)
+			location.DELETE("/:id", api.DeleteLocation, canWrite, mayManageCatalog)
+			location.GET("", api.GetAllLocation, canRead, mayRead)
+			location.POST("", api.InsertLocation, canWrite, mayManageCatalog)
+		})
+		v1.Group("/transfers", func(transfer *router.FastRouter) {
+			transfer.GET("/:id", api.GetTransfer, canRead, mayRead)
+			transfer.POST("/:id/receive", api.ReceiveTransfer, canAdjustStock, mayAdjustStock)
+			transfer.POST("/:id/cancel", api.CancelTransfer, canAdjustStock, mayAdjustStock)
+			transfer.GET("", api.GetTransfers, canRead, mayRead)
+		})
+		v1.Group("/purchase-orders", func(order *router.FastRouter) {
+			order.PUT("/:id", api.UpdatePurchaseOrder, canWrite, mayManageOrders)
+			order.GET("/:id", api.GetPurchaseOrder, canRead, mayRead)
+			order.POST("/:id/send", api.SendPurchaseOrder, canWrite, mayManageOrders)
+			order.POST("/:id/receive", api.ReceivePurchaseOrder, canAdjustStock, mayManageOrders, idempotent)
+			order.POST("/:id/cancel", api.CancelPurchaseOrder, canWrite, mayManageOrders)
+			order.GET("", api.GetPurchaseOrders, canRead, mayRead)
+			order.POST("", api.CreatePurchaseOrder, canWrite, mayManageOrders)
+		})
+		v1.Group("/orders", func(order *router.FastRouter) {
+			order.GET("/:id", api.GetSalesOrder, canRead, mayRead)
+			order.POST("/:id/fulfill", api.FulfillSalesOrder, canAdjustStock, mayManageOrders)
+			order.POST("/:id/cancel", api.CancelSalesOrder, canAdjustStock, mayManageOrders)
+			order.GET("", api.GetSalesOrders, canRead, mayRead)
+			order.POST("", api.PlaceSalesOrder, canAdjustStock, mayManageOrders, idempotent)
+		})
+		v1.Group("/api-keys", func(apiKey *router.FastRouter) {
+			canManage := router.RequireScope(constants.ScopeAPIKeysManage)
+			mayManage := router.RequirePermission(constants.PermissionAPIKeysManage)
+
+			apiKey.DELETE("/:id", api.RevokeAPIKey, canManage, mayManage)
+			apiKey.GET("", api.GetAllAPIKey, canManage, mayManage)
+			apiKey.POST("", api.CreateAPIKey, canManage, mayManage)
+		})
+		v1.Group("/roles", func(role *router.FastRouter) {
+			canManage := router.RequireScope(constants.ScopeRolesManage)
+			mayManage := router.RequirePermission(constants.PermissionRolesManage)
+
+			role.GET("/assignments", api.GetAllRoleAssignment, canManage, mayManage)
+			role.PUT("/assignments", api.AssignRole, canManage, mayManage)
+			role.DELETE("/assignments", api.UnassignRole, canManage, mayManage)
+			role.GET("", api.GetRoles, canManage, mayManage)
+		})
+		v1.GET("/audit", api.GetAuditLogs, canRead, router.RequirePermission(constants.PermissionAuditRead))
+		v1.Group("/alerts", func(alert *router.FastRouter) {
+			alert.GET("/rules", api.GetAlertRules, canRead, mayRead)
+			alert.POST("/rules", api.SetAlertRule, canManageSettings, mayManageSettings)
+			alert.DELETE("/rules/:id", api.DeleteAlertRule, canManageSettings, mayManageSettings)
+			alert.POST("/:id/ack", api.AcknowledgeAlert, canAdjustStock, mayAdjustStock)
+			alert.GET("", api.GetAlerts, canRead, mayRead)
+		})
+		v1.Group("/webhooks", func(webhook *router.FastRouter) {
+			webhook.GET("/:id/deliveries", api.GetWebhookDeliveries, canManageSettings, mayManageSettings)
+			webhook.GET("/:id/deliveries/:deliveryId", api.GetWebhookDelivery, canManageSettings, mayManageSettings)
+			webhook.POST("/:id/deliveries/:deliveryId/redeliver", api.RedeliverWebhook, canManageSettings, mayManageSettings)
+			webhook.PUT("/:id", api.UpdateWebhook, canManageSettings, mayManageSettings)
+			webhook.GET("/:id", api.GetWebhook, canManageSettings, mayManageSettings)
+			webhook.DELETE("/:id", api.DeleteWebhook, canManageSettings, mayManageSettings)
+			webhook.GET("", api.GetWebhooks, canManageSettings, mayManageSettings)
+			webhook.POST("", api.InsertWebhook, canManageSettings, mayManageSettings)
 		})
 	})
 
 	//myRouter.Group("/v1", func(v1 *router.FastRouter) {
 	//	v1.Group("/accounts", func(account *router.FastRouter) {
-	//		account.POST("/register", api.RegisterAccount, router.MustAuthorized(false))
+	//		account.POST("/register", api.RegisterAccount)
 	//		account.GET("/me", api.GetAccount)
 	//		account.PATCH("/update", api.UpdateAccount)
 	//	})
@@ -81,7 +276,7 @@ func (api *API) RegisterRoute() *router.FastRouter {
 	//		user.GET("/me", api.GetUser)
 	//		user.PATCH("/update", api.UpdateUser)
 	//		user.PATCH("/password", api.ChangePassword)
-	//		user.POST("/login", api.LoginUser, router.MustAuthorized(false))
+	//		user.POST("/login", api.LoginUser)
 	//	})
 	//
 	//})
@@ -0,0 +1,112 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/infrastructures/custresp"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/lib/response/rest"
+	"sagara_backend_test/lib/router"
+	"sagara_backend_test/lib/tracing"
+)
+
+// GetAllAPIKey godoc
+// @Summary 	Get All API Keys
+// @Description	Get every API key with its scopes and when it was last used, the secrets are never returned
+// @Tags		api-keys
+// @Accept		json
+// @Produce		json
+// @Success		200	{object}	jsonResponse{data=[]response.APIKeyResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/api-keys	[get]
+func (api *API) GetAllAPIKey(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetAllAPIKey")
+	defer span.End()
+
+	res, err := api.apiKeyUc.GetAllAPIKey(ctx)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// CreateAPIKey godoc
+// @Summary 	Create API Key
+// @Description	Create an API key for a machine caller, the key is only returned by this call and must be sent in the X-API-Key header
+// @Tags		api-keys
+// @Accept		json
+// @Param		apiKey 		body 	request.APIKeyRequest true "API Key Payload"
+// @Produce		json
+// @Param 		X-Actor		header		string	false	"who creates the key"
+// @Success		200	{object}	jsonResponse{data=response.APIKeyResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/api-keys	[post]
+func (api *API) CreateAPIKey(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.CreateAPIKey")
+	defer span.End()
+
+	var apiKeyReq request.APIKeyRequest
+	err := json.Unmarshal(req.RawBody(), &apiKeyReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	err = apiKeyReq.ValidateAPIKey()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.apiKeyUc.CreateAPIKey(withActor(ctx, req), &apiKeyReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// RevokeAPIKey godoc
+// @Summary 	Revoke API Key
+// @Description	Revoke an API key, it is refused from then on
+// @Tags		api-keys
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"api key id"
+// @Success		200	{object}	jsonResponse{data=response.APIKeyResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/api-keys/{id}	[delete]
+func (api *API) RevokeAPIKey(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.RevokeAPIKey")
+	defer span.End()
+
+	apiKeyID, err := parseAPIKeyID(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.apiKeyUc.RevokeAPIKey(ctx, &apiKeyID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+func parseAPIKeyID(req *router.Request) (uuid.UUID, error) {
+	apiKeyIDStr := req.Params("id")
+	if apiKeyIDStr == "" {
+		return uuid.Nil, errors.New("missing id")
+	}
+
+	apiKeyID, err := uuid.Parse(apiKeyIDStr)
+	if err != nil {
+		return uuid.Nil, errors.New("invalid id")
+	}
+
+	return apiKeyID, nil
+}
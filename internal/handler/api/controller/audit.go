@@ -0,0 +1,99 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/infrastructures/custresp"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/response"
+	"sagara_backend_test/lib/response/rest"
+	"sagara_backend_test/lib/router"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/pkg/constants/errorcode"
+	"time"
+)
+
+// GetAuditLogs godoc
+// @Summary 	Get Audit Logs
+// @Description	Get the recorded changes with who made them and the before and after value of every changed field, the most recent first by default
+// @Tags		audit
+// @Accept		json
+// @Produce		json
+// @Param 		entity	query		string	false	"entity type, only wardrobe is audited"
+// @Param 		id		query		string	false	"id of the entity"
+// @Param 		actor	query		string	false	"who made the changes"
+// @Param 		from	query		string	false	"RFC 3339 time of the earliest change"
+// @Param 		to		query		string	false	"RFC 3339 time of the latest change"
+// @Param 		limit	query		int		false	"page size, 20 by default and 100 at most"
+// @Param 		cursor	query		string	false	"next_cursor of the previous page"
+// @Param 		sort	query		string	false	"created_at, prefix with - to sort descending"
+// @Param 		total	query		bool	false	"count every matching audit log"
+// @Success		200	{object}	jsonResponse{data=[]response.AuditLogResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/audit	[get]
+func (api *API) GetAuditLogs(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetAuditLogs")
+	defer span.End()
+
+	auditReq, err := parseAuditRequest(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	pageReq, err := parsePageRequest(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, pagination, err := api.auditUc.GetAuditLogs(ctx, auditReq, pageReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res).SetPagination(pagination), nil
+}
+
+func parseAuditRequest(req *router.Request) (*request.AuditRequest, error) {
+	auditReq := &request.AuditRequest{
+		Entity: req.Query("entity"),
+		Actor:  req.Query("actor"),
+	}
+
+	if idStr := req.Query("id"); idStr != "" {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, errors.New("invalid id")
+		}
+		auditReq.ID = &id
+	}
+
+	errRange := &custerr.ErrChain{
+		Message: errorcode.AuditRangeInvalid.Message,
+		Code:    errorcode.AuditRangeInvalid.Code,
+		Type:    response.ErrBadRequest,
+	}
+	if fromStr := req.Query("from"); fromStr != "" {
+		from, err := time.Parse(time.RFC3339, fromStr)
+		if err != nil {
+			return nil, errRange
+		}
+		auditReq.From = &from
+	}
+	if toStr := req.Query("to"); toStr != "" {
+		to, err := time.Parse(time.RFC3339, toStr)
+		if err != nil {
+			return nil, errRange
+		}
+		auditReq.To = &to
+	}
+
+	err := auditReq.ValidateAudit()
+	if err != nil {
+		return nil, err
+	}
+
+	return auditReq, nil
+}
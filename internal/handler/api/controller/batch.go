@@ -0,0 +1,49 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"sagara_backend_test/internal/infrastructures/custresp"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/lib/response/rest"
+	"sagara_backend_test/lib/router"
+	"sagara_backend_test/lib/tracing"
+)
+
+// Batch godoc
+// @Summary 	Batch Wardrobe Operations
+// @Description	Run up to 500 create, update, delete and adjust_stock operations in order inside one transaction.
+// @Description	In atomic mode (the default) a failed operation rolls every operation back, in best_effort mode the operations that succeed are kept.
+// @Description	Each operation is reported with its result or the error code it would get from its own endpoint.
+// @Tags		wardrobes
+// @Accept		json
+// @Param		batch 		body 	request.WardrobeBatchRequest true "Batch Payload"
+// @Param 		X-Actor		header		string	false	"who makes the changes"
+// @Produce		json
+// @Param 		Idempotency-Key	header		string	false	"retries with the same key get the first response back instead of applying twice"
+// @Success		200	{object}	jsonResponse{data=response.WardrobeBatchResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/wardrobe/batch	[post]
+func (api *API) Batch(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.Batch")
+	defer span.End()
+
+	var batchReq request.WardrobeBatchRequest
+	err := json.Unmarshal(req.RawBody(), &batchReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	err = batchReq.ValidateBatch()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.wardrobeUc.Batch(withActor(ctx, req), &batchReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
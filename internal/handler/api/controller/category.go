@@ -0,0 +1,180 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/infrastructures/custresp"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/lib/response/rest"
+	"sagara_backend_test/lib/router"
+	"sagara_backend_test/lib/tracing"
+)
+
+// GetCategoryTree godoc
+// @Summary 	Get Category Tree
+// @Description	Get the root categories with their subcategories nested as children
+// @Tags		categories
+// @Accept		json
+// @Produce		json
+// @Success		200	{object}	jsonResponse{data=[]response.CategoryResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/categories	[get]
+func (api *API) GetCategoryTree(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetCategoryTree")
+	defer span.End()
+
+	res, err := api.categoryUc.GetCategoryTree(ctx)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// InsertCategory godoc
+// @Summary 	Insert Category
+// @Description	Insert Category, an empty parent_id creates a root category
+// @Tags		categories
+// @Accept		json
+// @Param		category 		body 	request.CategoryRequest true "Insert Payload"
+// @Produce		json
+// @Success		200	{object}	jsonResponse{data=response.CategoryResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/categories	[post]
+func (api *API) InsertCategory(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.InsertCategory")
+	defer span.End()
+
+	var categoryReq request.CategoryRequest
+	err := json.Unmarshal(req.RawBody(), &categoryReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	err = categoryReq.ValidateCategory()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.categoryUc.InsertCategory(ctx, &categoryReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// GetCategory godoc
+// @Summary 	Get Category By ID
+// @Description	Get Category
+// @Tags		categories
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"category id"
+// @Success		200	{object}	jsonResponse{data=response.CategoryResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/categories/{id}	[get]
+func (api *API) GetCategory(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetCategory")
+	defer span.End()
+
+	categoryID, err := parseCategoryID(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.categoryUc.GetCategory(ctx, &categoryID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// UpdateCategory godoc
+// @Summary 	Update Category
+// @Description	Rename or move a category
+// @Tags		categories
+// @Accept		json
+// @Param		category 		body 	request.CategoryRequest true "Update Payload"
+// @Produce		json
+// @Param 		id		path 		string 	false 	"category id"
+// @Success		200	{object}	jsonResponse{data=response.CategoryResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/categories/{id}	[put]
+func (api *API) UpdateCategory(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.UpdateCategory")
+	defer span.End()
+
+	categoryID, err := parseCategoryID(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	var categoryReq request.CategoryRequest
+	err = json.Unmarshal(req.RawBody(), &categoryReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	err = categoryReq.ValidateCategory()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.categoryUc.UpdateCategory(ctx, &categoryID, &categoryReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// DeleteCategory godoc
+// @Summary 	Delete Category By ID
+// @Description	Delete Category, a category with subcategories or wardrobes can not be deleted
+// @Tags		categories
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"category id"
+// @Success		200	{object}	jsonResponse{}
+// @Failure		409	{object}	jsonResponse{}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/categories/{id}	[delete]
+func (api *API) DeleteCategory(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.DeleteCategory")
+	defer span.End()
+
+	categoryID, err := parseCategoryID(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	err = api.categoryUc.DeleteCategory(ctx, &categoryID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData("success"), nil
+}
+
+func parseCategoryID(req *router.Request) (uuid.UUID, error) {
+	categoryIDStr := req.Params("id")
+	if categoryIDStr == "" {
+		return uuid.Nil, errors.New("missing id")
+	}
+
+	categoryID, err := uuid.Parse(categoryIDStr)
+	if err != nil {
+		return uuid.Nil, errors.New("invalid id")
+	}
+
+	return categoryID, nil
+}
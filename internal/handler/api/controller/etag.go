@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// formatETag builds a strong entity tag out of the wardrobe version
+func formatETag(version int) string {
+	return fmt.Sprintf(`"%d"`, version)
+}
+
+// parseETag reads the version back from an entity tag, weak tags are accepted since only the version is compared
+func parseETag(tag string) (int, bool) {
+	tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+	if len(tag) < 2 || !strings.HasPrefix(tag, `"`) || !strings.HasSuffix(tag, `"`) {
+		return 0, false
+	}
+
+	version, err := strconv.Atoi(tag[1 : len(tag)-1])
+	if err != nil {
+		return 0, false
+	}
+
+	return version, true
+}
+
+// matchETag reports whether one of the comma separated tags in header matches the version
+func matchETag(header string, version int) bool {
+	if strings.TrimSpace(header) == "*" {
+		return true
+	}
+
+	for _, tag := range strings.Split(header, ",") {
+		if v, ok := parseETag(tag); ok && v == version {
+			return true
+		}
+	}
+
+	return false
+}
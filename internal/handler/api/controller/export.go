@@ -0,0 +1,57 @@
+package controller
+
+import (
+	"context"
+	"sagara_backend_test/internal/infrastructures/spreadsheet"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/lib/response/rest"
+	"sagara_backend_test/lib/router"
+	"sagara_backend_test/lib/tracing"
+	"strings"
+)
+
+// Export godoc
+// @Summary 	Export Wardrobe
+// @Description	Download the wardrobes matching the search filters by name, as csv, xlsx or a json array.
+// @Description	The csv and xlsx columns start with those of the import so the file can be edited and imported back.
+// @Tags		wardrobes
+// @Accept		json
+// @Produce		octet-stream
+// @Param 		format		query		string	false	"csv (default), xlsx or json"
+// @Param 		q			query		string	false	"Words matched as prefixes of the name, color and sku, ignoring case and accents"
+// @Param 		color		query		string	false	"Color of the wardrobe"
+// @Param 		size		query		string	false	"Size of the wardrobe"
+// @Param 		category_id	query		string	false	"Category of the wardrobe, its subcategories included"
+// @Param 		tags		query		string	false	"Comma separated tags"
+// @Param 		tag_match	query		string	false	"any (default) or all of the tags"
+// @Success		200	{file}		file
+// @Failure		400	{object}	jsonResponse{}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/wardrobe/export	[get]
+func (api *API) Export(ctx context.Context, req *router.Request) (*rest.AttachmentResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.Export")
+	defer span.End()
+
+	searchReq, err := parseSearchRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	exportReq := request.WardrobeExportRequest{
+		Format: strings.ToLower(req.Query("format", spreadsheet.FormatCSV)),
+		Search: *searchReq,
+	}
+
+	err = exportReq.ValidateExport()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := api.wardrobeUc.Export(ctx, &exportReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return rest.NewAttachmentResponse().SetFile(res.File).SetFileName(res.FileName).SetContentType(res.ContentType), nil
+}
@@ -0,0 +1,117 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"io"
+	"net/http"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/infrastructures/custresp"
+	"sagara_backend_test/internal/infrastructures/spreadsheet"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/response"
+	"sagara_backend_test/lib/response/rest"
+	"sagara_backend_test/lib/router"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/pkg/constants/errorcode"
+	"strings"
+)
+
+// ImportWardrobe godoc
+// @Summary 	Import Wardrobe
+// @Description	Import a csv or xlsx file with the columns sku, name, color, size, price, currency, stock and product_id.
+// @Description	Rows with a new or no sku are created and need a color, a size and a name unless product_id gives one, rows with a known sku update that wardrobe and their empty cells keep the current value.
+// @Description	Nothing is written unless every row is valid. Large files are imported in the background, they answer 202 with the pending job.
+// @Tags		wardrobes
+// @Accept		multipart/form-data
+// @Produce		json
+// @Param		file		formData	file	true	"csv or xlsx file, the first sheet of a workbook is read"
+// @Param 		format		query		string	false	"csv or xlsx, taken from the file name when empty"
+// @Param 		dry_run		query		bool	false	"only validate the rows and report what would be created and updated"
+// @Param 		X-Actor		header		string	false	"who makes the import"
+// @Success		200	{object}	jsonResponse{data=response.ImportJobResponse}
+// @Success		202	{object}	jsonResponse{data=response.ImportJobResponse}
+// @Failure		413	{object}	jsonResponse{}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/wardrobe/import	[post]
+func (api *API) ImportWardrobe(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.ImportWardrobe")
+	defer span.End()
+
+	fileHeader, err := req.FormFile("file")
+	if err != nil {
+		return custresp.CustomErrorResponse(&custerr.ErrChain{
+			Message: errorcode.ImportFileMissing.Message,
+			Cause:   err,
+			Code:    errorcode.ImportFileMissing.Code,
+			Type:    response.ErrBadRequest,
+		})
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	importReq := request.WardrobeImportRequest{
+		FileName: fileHeader.Filename,
+		Format:   strings.ToLower(req.Query("format")),
+		DryRun:   req.Query("dry_run") == "true",
+		Data:     data,
+	}
+	if importReq.Format == "" {
+		importReq.Format = spreadsheet.FormatFromFileName(fileHeader.Filename)
+	}
+
+	err = importReq.ValidateImport()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.importUc.ImportWardrobe(withActor(ctx, req), &importReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	if res.Status == model.ImportJobPending {
+		return rest.NewJSONResponse().SetData(res).SetCode(http.StatusAccepted), nil
+	}
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// GetImportJob godoc
+// @Summary 	Get Import Job
+// @Description	Get the status of an import, with the report of every row once it is done
+// @Tags		wardrobes
+// @Accept		json
+// @Produce		json
+// @Param 		jobId	path 		string 	false 	"import job id"
+// @Success		200	{object}	jsonResponse{data=response.ImportJobResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/wardrobe/import/{jobId}	[get]
+func (api *API) GetImportJob(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetImportJob")
+	defer span.End()
+
+	jobID, err := uuid.Parse(req.Params("jobId"))
+	if err != nil {
+		return custresp.CustomErrorResponse(errors.New("invalid job id"))
+	}
+
+	res, err := api.importUc.GetImportJob(ctx, &jobID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
@@ -0,0 +1,182 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/infrastructures/custresp"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/lib/response/rest"
+	"sagara_backend_test/lib/router"
+	"sagara_backend_test/lib/tracing"
+)
+
+// GetAllLocation godoc
+// @Summary 	Get All Location
+// @Description	Get the stores and warehouses holding stock
+// @Tags		locations
+// @Accept		json
+// @Produce		json
+// @Success		200	{object}	jsonResponse{data=[]response.LocationResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/locations	[get]
+func (api *API) GetAllLocation(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetAllLocation")
+	defer span.End()
+
+	res, err := api.locationUc.GetAllLocation(ctx)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// InsertLocation godoc
+// @Summary 	Insert Location
+// @Description	Insert a store or a warehouse
+// @Tags		locations
+// @Accept		json
+// @Param		location 		body 	request.LocationRequest true "Insert Payload"
+// @Produce		json
+// @Success		200	{object}	jsonResponse{data=response.LocationResponse}
+// @Failure		409	{object}	jsonResponse{}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/locations	[post]
+func (api *API) InsertLocation(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.InsertLocation")
+	defer span.End()
+
+	var locationReq request.LocationRequest
+	err := json.Unmarshal(req.RawBody(), &locationReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	err = locationReq.ValidateLocation()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.locationUc.InsertLocation(ctx, &locationReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// GetLocation godoc
+// @Summary 	Get Location By ID
+// @Description	Get Location
+// @Tags		locations
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"location id"
+// @Success		200	{object}	jsonResponse{data=response.LocationResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/locations/{id}	[get]
+func (api *API) GetLocation(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetLocation")
+	defer span.End()
+
+	locationID, err := parseLocationID(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.locationUc.GetLocation(ctx, &locationID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// UpdateLocation godoc
+// @Summary 	Update Location
+// @Description	Change the code, name or kind of a location
+// @Tags		locations
+// @Accept		json
+// @Param		location 		body 	request.LocationRequest true "Update Payload"
+// @Produce		json
+// @Param 		id		path 		string 	false 	"location id"
+// @Success		200	{object}	jsonResponse{data=response.LocationResponse}
+// @Failure		409	{object}	jsonResponse{}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/locations/{id}	[put]
+func (api *API) UpdateLocation(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.UpdateLocation")
+	defer span.End()
+
+	locationID, err := parseLocationID(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	var locationReq request.LocationRequest
+	err = json.Unmarshal(req.RawBody(), &locationReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	err = locationReq.ValidateLocation()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.locationUc.UpdateLocation(ctx, &locationID, &locationReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// DeleteLocation godoc
+// @Summary 	Delete Location
+// @Description	Delete a location without stock or transfers, the default location can not be deleted
+// @Tags		locations
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"location id"
+// @Success		200	{object}	jsonResponse{}
+// @Failure		409	{object}	jsonResponse{}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/locations/{id}	[delete]
+func (api *API) DeleteLocation(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.DeleteLocation")
+	defer span.End()
+
+	locationID, err := parseLocationID(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	err = api.locationUc.DeleteLocation(ctx, &locationID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData("success"), nil
+}
+
+func parseLocationID(req *router.Request) (uuid.UUID, error) {
+	locationIDStr := req.Params("id")
+	if locationIDStr == "" {
+		return uuid.Nil, errors.New("missing id")
+	}
+
+	locationID, err := uuid.Parse(locationIDStr)
+	if err != nil {
+		return uuid.Nil, errors.New("invalid id")
+	}
+
+	return locationID, nil
+}
@@ -0,0 +1,32 @@
+package controller
+
+import (
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/response"
+	"sagara_backend_test/lib/router"
+	"sagara_backend_test/pkg/constants/errorcode"
+	"strconv"
+)
+
+func parsePageRequest(req *router.Request) (*request.PageRequest, error) {
+	pageReq := &request.PageRequest{
+		Cursor:    req.Query("cursor"),
+		Sort:      req.Query("sort"),
+		WithTotal: req.Query("total") == "true",
+	}
+
+	if limit := req.Query("limit"); limit != "" {
+		limitInt, err := strconv.Atoi(limit)
+		if err != nil {
+			return nil, &custerr.ErrChain{
+				Message: errorcode.LimitInvalid.Message,
+				Code:    errorcode.LimitInvalid.Code,
+				Type:    response.ErrBadRequest,
+			}
+		}
+		pageReq.Limit = limitInt
+	}
+
+	return pageReq, nil
+}
@@ -0,0 +1,146 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/infrastructures/custresp"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/lib/response/rest"
+	"sagara_backend_test/lib/router"
+	"sagara_backend_test/lib/tracing"
+)
+
+// GetPriceHistory godoc
+// @Summary 	Get Price History
+// @Description	Get every price change of a wardrobe with who made it and why, newest first
+// @Tags		wardrobes
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"wardrobe id"
+// @Success		200	{object}	jsonResponse{data=[]response.PriceChangeResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/wardrobe/{id}/prices	[get]
+func (api *API) GetPriceHistory(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetPriceHistory")
+	defer span.End()
+
+	wardrobeID, err := uuid.Parse(req.Params("id"))
+	if err != nil {
+		return custresp.CustomErrorResponse(errors.New("invalid id"))
+	}
+
+	res, err := api.wardrobeUc.GetPriceHistory(ctx, &wardrobeID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// GetPriceSchedules godoc
+// @Summary 	Get Price Schedules
+// @Description	Get every price schedule of a wardrobe, whatever their status, by their start
+// @Tags		wardrobes
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"wardrobe id"
+// @Success		200	{object}	jsonResponse{data=[]response.PriceScheduleResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/wardrobe/{id}/prices/schedules	[get]
+func (api *API) GetPriceSchedules(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetPriceSchedules")
+	defer span.End()
+
+	wardrobeID, err := uuid.Parse(req.Params("id"))
+	if err != nil {
+		return custresp.CustomErrorResponse(errors.New("invalid id"))
+	}
+
+	res, err := api.wardrobeUc.GetPriceSchedules(ctx, &wardrobeID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// SchedulePrice godoc
+// @Summary 	Schedule Price
+// @Description	Schedule a future price, it is applied at effective_from and the previous price comes back at effective_until
+// @Tags		wardrobes
+// @Accept		json
+// @Param		schedule 		body 	request.PriceScheduleRequest true "Schedule Payload"
+// @Produce		json
+// @Param 		id		path 		string 	false 	"wardrobe id"
+// @Param 		X-Actor		header		string	false	"who schedules the price, recorded in the price history"
+// @Success		200	{object}	jsonResponse{data=response.PriceScheduleResponse}
+// @Failure		409	{object}	jsonResponse{}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/wardrobe/{id}/prices/schedules	[post]
+func (api *API) SchedulePrice(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.SchedulePrice")
+	defer span.End()
+
+	wardrobeID, err := uuid.Parse(req.Params("id"))
+	if err != nil {
+		return custresp.CustomErrorResponse(errors.New("invalid id"))
+	}
+
+	var scheduleReq request.PriceScheduleRequest
+	err = json.Unmarshal(req.RawBody(), &scheduleReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	err = scheduleReq.ValidatePriceSchedule()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.wardrobeUc.SchedulePrice(withActor(ctx, req), &wardrobeID, &scheduleReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// CancelPriceSchedule godoc
+// @Summary 	Cancel Price Schedule
+// @Description	Cancel a price schedule that has not started yet
+// @Tags		wardrobes
+// @Accept		json
+// @Produce		json
+// @Param 		id			path 		string 	false 	"wardrobe id"
+// @Param 		scheduleId	path 		string 	false 	"price schedule id"
+// @Success		200	{object}	jsonResponse{}
+// @Failure		409	{object}	jsonResponse{}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/wardrobe/{id}/prices/schedules/{scheduleId}	[delete]
+func (api *API) CancelPriceSchedule(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.CancelPriceSchedule")
+	defer span.End()
+
+	wardrobeID, err := uuid.Parse(req.Params("id"))
+	if err != nil {
+		return custresp.CustomErrorResponse(errors.New("invalid id"))
+	}
+
+	scheduleID, err := uuid.Parse(req.Params("scheduleId"))
+	if err != nil {
+		return custresp.CustomErrorResponse(errors.New("invalid schedule id"))
+	}
+
+	err = api.wardrobeUc.CancelPriceSchedule(ctx, &wardrobeID, &scheduleID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData("success"), nil
+}
@@ -0,0 +1,292 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/infrastructures/custresp"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/lib/response/rest"
+	"sagara_backend_test/lib/router"
+	"sagara_backend_test/lib/tracing"
+)
+
+// GetAllProduct godoc
+// @Summary 	Get All Product
+// @Description	Get All Product
+// @Tags		products
+// @Accept		json
+// @Produce		json
+// @Success		200	{object}	jsonResponse{data=[]response.ProductResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/products	[get]
+func (api *API) GetAllProduct(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetAllProduct")
+	defer span.End()
+
+	res, err := api.productUc.GetAllProduct(ctx)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// InsertProduct godoc
+// @Summary 	Insert Product
+// @Description	Insert Product
+// @Tags		products
+// @Accept		json
+// @Param		product 		body 	request.ProductRequest true "Insert Payload"
+// @Produce		json
+// @Success		200	{object}	jsonResponse{data=response.ProductResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/products	[post]
+func (api *API) InsertProduct(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.InsertProduct")
+	defer span.End()
+
+	var productReq request.ProductRequest
+	err := json.Unmarshal(req.RawBody(), &productReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	err = productReq.ValidateProduct()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.productUc.InsertProduct(ctx, &productReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// GetProduct godoc
+// @Summary 	Get Product By ID
+// @Description	Get Product
+// @Tags		products
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"product id"
+// @Success		200	{object}	jsonResponse{data=response.ProductResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/products/{id}	[get]
+func (api *API) GetProduct(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetProduct")
+	defer span.End()
+
+	productID, err := parseProductID(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.productUc.GetProduct(ctx, &productID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// UpdateProduct godoc
+// @Summary 	Update Product
+// @Description	Update Product, the name and price are carried over to its variants except overridden prices
+// @Tags		products
+// @Accept		json
+// @Param		product 		body 	request.ProductRequest true "Update Payload"
+// @Produce		json
+// @Param 		id		path 		string 	false 	"product id"
+// @Success		200	{object}	jsonResponse{data=response.ProductResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/products/{id}	[put]
+func (api *API) UpdateProduct(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.UpdateProduct")
+	defer span.End()
+
+	productID, err := parseProductID(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	var productReq request.ProductRequest
+	err = json.Unmarshal(req.RawBody(), &productReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	err = productReq.ValidateProduct()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.productUc.UpdateProduct(ctx, &productID, &productReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// DeleteProduct godoc
+// @Summary 	Delete Product By ID
+// @Description	Delete Product, a product with variants can not be deleted
+// @Tags		products
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"product id"
+// @Success		200	{object}	jsonResponse{}
+// @Failure		409	{object}	jsonResponse{}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/products/{id}	[delete]
+func (api *API) DeleteProduct(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.DeleteProduct")
+	defer span.End()
+
+	productID, err := parseProductID(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	err = api.productUc.DeleteProduct(ctx, &productID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData("success"), nil
+}
+
+// GetVariants godoc
+// @Summary 	Get Product Variants
+// @Description	Get a product with all of its color and size variants
+// @Tags		products
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"product id"
+// @Success		200	{object}	jsonResponse{data=response.ProductVariantsResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/products/{id}/variants	[get]
+func (api *API) GetVariants(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetVariants")
+	defer span.End()
+
+	productID, err := parseProductID(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.productUc.GetVariants(ctx, &productID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// AddVariant godoc
+// @Summary 	Add Product Variant
+// @Description	Add a color and size variant to a product, an empty price inherits the product price
+// @Tags		products
+// @Accept		json
+// @Param		variant 		body 	request.VariantRequest true "Variant Payload"
+// @Produce		json
+// @Param 		id		path 		string 	false 	"product id"
+// @Param 		X-Actor		header		string	false	"who makes the change, recorded in the audit trail"
+// @Success		200	{object}	jsonResponse{data=response.WardrobeResponse}
+// @Failure		409	{object}	jsonResponse{}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/products/{id}/variants	[post]
+func (api *API) AddVariant(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.AddVariant")
+	defer span.End()
+
+	productID, err := parseProductID(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	var variantReq request.VariantRequest
+	err = json.Unmarshal(req.RawBody(), &variantReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	err = variantReq.ValidateVariant()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.productUc.AddVariant(withActor(ctx, req), &productID, &variantReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// SearchProduct godoc
+// @Summary 	Search Product Variants
+// @Description	Search variants like the wardrobe search does, grouped by their product
+// @Tags		products
+// @Accept		json
+// @Produce		json
+// @Param 		q			query		string	false	"Words matched as prefixes of the name, color and sku, ignoring case and accents"
+// @Param 		color		query		string	false	"Color of the variant"
+// @Param 		size		query		string	false	"Size of the variant"
+// @Param 		category_id	query		string	false	"Category of the variant"
+// @Param 		tags		query		string	false	"Comma separated tags"
+// @Param 		tag_match	query		string	false	"any (default) or all of the tags"
+// @Param 		limit	query		int		false	"page size, 20 by default and 100 at most"
+// @Param 		cursor	query		string	false	"next_cursor of the previous page"
+// @Param 		sort	query		string	false	"comma separated fields among name, price, stock, created_at, updated_at and relevance (with q, the default), prefix with - to sort descending"
+// @Param 		total	query		bool	false	"count every matching wardrobe"
+// @Success		200		{object}	jsonResponse{data=[]response.ProductVariantsResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/products/search	[get]
+func (api *API) SearchProduct(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.SearchProduct")
+	defer span.End()
+
+	searchReq, err := parseSearchRequest(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	pageReq, err := parsePageRequest(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, pagination, err := api.productUc.Search(ctx, searchReq, pageReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res).SetPagination(pagination), nil
+}
+
+func parseProductID(req *router.Request) (uuid.UUID, error) {
+	productIDStr := req.Params("id")
+	if productIDStr == "" {
+		return uuid.Nil, errors.New("missing id")
+	}
+
+	productID, err := uuid.Parse(productIDStr)
+	if err != nil {
+		return uuid.Nil, errors.New("invalid id")
+	}
+
+	return productID, nil
+}
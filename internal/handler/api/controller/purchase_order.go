@@ -0,0 +1,274 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/infrastructures/custresp"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/lib/response/rest"
+	"sagara_backend_test/lib/router"
+	"sagara_backend_test/lib/tracing"
+)
+
+// GetPurchaseOrders godoc
+// @Summary 	Get Purchase Orders
+// @Description	Get the purchase orders without their lines, the most recent first by default
+// @Tags		purchase-orders
+// @Accept		json
+// @Produce		json
+// @Param 		status		query		string	false	"draft, sent, partially_received, received or cancelled"
+// @Param 		supplier	query		string	false	"part of the supplier name"
+// @Param 		limit	query		int		false	"page size, 20 by default and 100 at most"
+// @Param 		cursor	query		string	false	"next_cursor of the previous page"
+// @Param 		sort	query		string	false	"created_at, prefix with - to sort descending"
+// @Param 		total	query		bool	false	"count every matching purchase order"
+// @Success		200	{object}	jsonResponse{data=[]response.PurchaseOrderResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/purchase-orders	[get]
+func (api *API) GetPurchaseOrders(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetPurchaseOrders")
+	defer span.End()
+
+	filterReq := &request.PurchaseOrderFilterRequest{
+		Status:   req.Query("status"),
+		Supplier: req.Query("supplier"),
+	}
+	err := filterReq.ValidateFilter()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	pageReq, err := parsePageRequest(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, pagination, err := api.purchaseOrderUc.GetPurchaseOrders(ctx, filterReq, pageReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res).SetPagination(pagination), nil
+}
+
+// CreatePurchaseOrder godoc
+// @Summary 	Create Purchase Order
+// @Description	Create a draft purchase order, it can be edited until it is sent
+// @Tags		purchase-orders
+// @Accept		json
+// @Param		order 		body 	request.PurchaseOrderRequest true "Purchase Order Payload"
+// @Produce		json
+// @Param 		X-Actor		header		string	false	"who creates the order"
+// @Success		200	{object}	jsonResponse{data=response.PurchaseOrderResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/purchase-orders	[post]
+func (api *API) CreatePurchaseOrder(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.CreatePurchaseOrder")
+	defer span.End()
+
+	var orderReq request.PurchaseOrderRequest
+	err := json.Unmarshal(req.RawBody(), &orderReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	err = orderReq.ValidatePurchaseOrder()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.purchaseOrderUc.CreatePurchaseOrder(withActor(ctx, req), &orderReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// GetPurchaseOrder godoc
+// @Summary 	Get Purchase Order By ID
+// @Description	Get a purchase order with its lines, the quantities outstanding and the receipts so far
+// @Tags		purchase-orders
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"purchase order id"
+// @Success		200	{object}	jsonResponse{data=response.PurchaseOrderResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/purchase-orders/{id}	[get]
+func (api *API) GetPurchaseOrder(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetPurchaseOrder")
+	defer span.End()
+
+	orderID, err := parsePurchaseOrderID(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.purchaseOrderUc.GetPurchaseOrder(ctx, &orderID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// UpdatePurchaseOrder godoc
+// @Summary 	Update Purchase Order
+// @Description	Replace the supplier, location and lines of a draft purchase order
+// @Tags		purchase-orders
+// @Accept		json
+// @Param		order 		body 	request.PurchaseOrderRequest true "Purchase Order Payload"
+// @Produce		json
+// @Param 		id		path 		string 	false 	"purchase order id"
+// @Success		200	{object}	jsonResponse{data=response.PurchaseOrderResponse}
+// @Failure		409	{object}	jsonResponse{}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/purchase-orders/{id}	[put]
+func (api *API) UpdatePurchaseOrder(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.UpdatePurchaseOrder")
+	defer span.End()
+
+	orderID, err := parsePurchaseOrderID(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	var orderReq request.PurchaseOrderRequest
+	err = json.Unmarshal(req.RawBody(), &orderReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	err = orderReq.ValidatePurchaseOrder()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.purchaseOrderUc.UpdatePurchaseOrder(ctx, &orderID, &orderReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// SendPurchaseOrder godoc
+// @Summary 	Send Purchase Order
+// @Description	Mark a draft purchase order as sent to the supplier, goods can be received for it from then on
+// @Tags		purchase-orders
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"purchase order id"
+// @Success		200	{object}	jsonResponse{data=response.PurchaseOrderResponse}
+// @Failure		409	{object}	jsonResponse{}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/purchase-orders/{id}/send	[post]
+func (api *API) SendPurchaseOrder(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.SendPurchaseOrder")
+	defer span.End()
+
+	orderID, err := parsePurchaseOrderID(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.purchaseOrderUc.SendPurchaseOrder(ctx, &orderID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// ReceivePurchaseOrder godoc
+// @Summary 	Receive Goods
+// @Description	Record the quantities delivered for lines of a sent purchase order and add them to the stock
+// @Tags		purchase-orders
+// @Accept		json
+// @Param		receipt 		body 	request.PurchaseReceiveRequest true "Receipt Payload"
+// @Produce		json
+// @Param 		id		path 		string 	false 	"purchase order id"
+// @Param 		X-Actor		header		string	false	"who receives the goods, recorded in the audit trail"
+// @Param 		Idempotency-Key	header		string	false	"retries with the same key get the first response back instead of applying twice"
+// @Success		200	{object}	jsonResponse{data=response.PurchaseOrderResponse}
+// @Failure		409	{object}	jsonResponse{}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/purchase-orders/{id}/receive	[post]
+func (api *API) ReceivePurchaseOrder(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.ReceivePurchaseOrder")
+	defer span.End()
+
+	orderID, err := parsePurchaseOrderID(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	var receiveReq request.PurchaseReceiveRequest
+	err = json.Unmarshal(req.RawBody(), &receiveReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	err = receiveReq.ValidateReceive()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.purchaseOrderUc.ReceivePurchaseOrder(withActor(ctx, req), &orderID, &receiveReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// CancelPurchaseOrder godoc
+// @Summary 	Cancel Purchase Order
+// @Description	Close a purchase order that is not fully received, the goods already received stay in stock
+// @Tags		purchase-orders
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"purchase order id"
+// @Success		200	{object}	jsonResponse{data=response.PurchaseOrderResponse}
+// @Failure		409	{object}	jsonResponse{}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/purchase-orders/{id}/cancel	[post]
+func (api *API) CancelPurchaseOrder(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.CancelPurchaseOrder")
+	defer span.End()
+
+	orderID, err := parsePurchaseOrderID(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.purchaseOrderUc.CancelPurchaseOrder(ctx, &orderID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+func parsePurchaseOrderID(req *router.Request) (uuid.UUID, error) {
+	orderIDStr := req.Params("id")
+	if orderIDStr == "" {
+		return uuid.Nil, errors.New("missing id")
+	}
+
+	orderID, err := uuid.Parse(orderIDStr)
+	if err != nil {
+		return uuid.Nil, errors.New("invalid id")
+	}
+
+	return orderID, nil
+}
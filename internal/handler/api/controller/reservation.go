@@ -0,0 +1,158 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/infrastructures/custresp"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/lib/response/rest"
+	"sagara_backend_test/lib/router"
+	"sagara_backend_test/lib/tracing"
+)
+
+// Reserve godoc
+// @Summary 	Reserve Stock
+// @Description	Hold stock of a wardrobe for a while without deducting it, default TTL is used when ttl_seconds is empty
+// @Tags		reservations
+// @Accept		json
+// @Param		reservation 		body 	request.ReservationRequest true "Reservation Payload"
+// @Produce		json
+// @Param 		id		path 		string 	false 	"wardrobe id"
+// @Param 		Idempotency-Key	header		string	false	"retries with the same key get the first response back instead of applying twice"
+// @Success		200	{object}	jsonResponse{data=response.ReservationResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/wardrobe/{id}/reservations	[post]
+func (api *API) Reserve(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.Reserve")
+	defer span.End()
+
+	wardrobeIDStr := req.Params("id")
+	if wardrobeIDStr == "" {
+		return custresp.CustomErrorResponse(errors.New("missing id"))
+	}
+
+	wardrobeID, err := uuid.Parse(wardrobeIDStr)
+	if err != nil {
+		return custresp.CustomErrorResponse(errors.New("invalid id"))
+	}
+
+	var reservationReq request.ReservationRequest
+	err = json.Unmarshal(req.RawBody(), &reservationReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	err = reservationReq.ValidateReservation()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.reservationUc.Reserve(ctx, &wardrobeID, &reservationReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// GetReservation godoc
+// @Summary 	Get Reservation By ID
+// @Description	Get Reservation
+// @Tags		reservations
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"reservation id"
+// @Success		200	{object}	jsonResponse{data=response.ReservationResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/reservations/{id}	[get]
+func (api *API) GetReservation(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetReservation")
+	defer span.End()
+
+	reservationID, err := parseReservationID(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.reservationUc.GetReservation(ctx, &reservationID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// CommitReservation godoc
+// @Summary 	Commit Reservation
+// @Description	Deduct the reserved stock from the wardrobe
+// @Tags		reservations
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"reservation id"
+// @Param 		X-Actor		header		string	false	"who makes the change, recorded in the audit trail"
+// @Success		200	{object}	jsonResponse{data=response.ReservationResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/reservations/{id}/commit	[post]
+func (api *API) CommitReservation(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.CommitReservation")
+	defer span.End()
+
+	reservationID, err := parseReservationID(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.reservationUc.Commit(withActor(ctx, req), &reservationID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// ReleaseReservation godoc
+// @Summary 	Release Reservation
+// @Description	Give the reserved stock back to the wardrobe
+// @Tags		reservations
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"reservation id"
+// @Success		200	{object}	jsonResponse{data=response.ReservationResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/reservations/{id}/release	[post]
+func (api *API) ReleaseReservation(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.ReleaseReservation")
+	defer span.End()
+
+	reservationID, err := parseReservationID(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.reservationUc.Release(ctx, &reservationID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+func parseReservationID(req *router.Request) (uuid.UUID, error) {
+	reservationIDStr := req.Params("id")
+	if reservationIDStr == "" {
+		return uuid.Nil, errors.New("missing id")
+	}
+
+	reservationID, err := uuid.Parse(reservationIDStr)
+	if err != nil {
+		return uuid.Nil, errors.New("invalid id")
+	}
+
+	return reservationID, nil
+}
@@ -0,0 +1,120 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sagara_backend_test/internal/infrastructures/custresp"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/lib/response/rest"
+	"sagara_backend_test/lib/router"
+	"sagara_backend_test/lib/tracing"
+)
+
+// GetRoles godoc
+// @Summary 	Get Roles
+// @Description	Get every role with the permissions it gives
+// @Tags		roles
+// @Accept		json
+// @Produce		json
+// @Success		200	{object}	jsonResponse{data=[]response.RoleResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/roles	[get]
+func (api *API) GetRoles(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetRoles")
+	defer span.End()
+
+	res, err := api.roleUc.GetRoles(ctx)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// GetAllRoleAssignment godoc
+// @Summary 	Get All Role Assignments
+// @Description	Get every subject that was assigned a role, the others act with the default role
+// @Tags		roles
+// @Accept		json
+// @Produce		json
+// @Success		200	{object}	jsonResponse{data=[]response.RoleAssignmentResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/roles/assignments	[get]
+func (api *API) GetAllRoleAssignment(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetAllRoleAssignment")
+	defer span.End()
+
+	res, err := api.roleUc.GetAllRoleAssignment(ctx)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// AssignRole godoc
+// @Summary 	Assign Role
+// @Description	Assign a role to the subject of a user or to an API key as api-key:<id>, it replaces the role the subject had
+// @Tags		roles
+// @Accept		json
+// @Param		assignment 	body 	request.RoleAssignmentRequest true "Role Assignment Payload"
+// @Produce		json
+// @Param 		X-Actor		header		string	false	"who assigns the role"
+// @Success		200	{object}	jsonResponse{data=response.RoleAssignmentResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/roles/assignments	[put]
+func (api *API) AssignRole(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.AssignRole")
+	defer span.End()
+
+	var assignmentReq request.RoleAssignmentRequest
+	err := json.Unmarshal(req.RawBody(), &assignmentReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	err = assignmentReq.ValidateRoleAssignment()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.roleUc.AssignRole(withActor(ctx, req), &assignmentReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// UnassignRole godoc
+// @Summary 	Unassign Role
+// @Description	Take the role of a subject away, it acts with the default role from then on
+// @Tags		roles
+// @Accept		json
+// @Produce		json
+// @Param 		subject		query 		string 	true 	"subject of the assignment"
+// @Success		200	{object}	jsonResponse{}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/roles/assignments	[delete]
+func (api *API) UnassignRole(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.UnassignRole")
+	defer span.End()
+
+	// subjects may hold characters that do not fit a path, such as the | of some identity providers
+	subject := req.Query("subject")
+	if subject == "" {
+		return custresp.CustomErrorResponse(errors.New("missing subject"))
+	}
+
+	err := api.roleUc.UnassignRole(ctx, subject)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData("success"), nil
+}
@@ -0,0 +1,226 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/infrastructures/custresp"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/response"
+	"sagara_backend_test/lib/response/rest"
+	"sagara_backend_test/lib/router"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/pkg/constants/errorcode"
+	"time"
+)
+
+// GetSalesOrders godoc
+// @Summary 	Get Sales Orders
+// @Description	Get the sales orders without their lines, the most recent first by default
+// @Tags		orders
+// @Accept		json
+// @Produce		json
+// @Param 		status	query		string	false	"placed, fulfilled or cancelled"
+// @Param 		from	query		string	false	"RFC 3339 time of the earliest order"
+// @Param 		to		query		string	false	"RFC 3339 time of the latest order"
+// @Param 		limit	query		int		false	"page size, 20 by default and 100 at most"
+// @Param 		cursor	query		string	false	"next_cursor of the previous page"
+// @Param 		sort	query		string	false	"created_at, prefix with - to sort descending"
+// @Param 		total	query		bool	false	"count every matching sales order"
+// @Success		200	{object}	jsonResponse{data=[]response.SalesOrderResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/orders	[get]
+func (api *API) GetSalesOrders(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetSalesOrders")
+	defer span.End()
+
+	filterReq, err := parseSalesOrderFilter(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	pageReq, err := parsePageRequest(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, pagination, err := api.salesOrderUc.GetSalesOrders(ctx, filterReq, pageReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res).SetPagination(pagination), nil
+}
+
+// PlaceSalesOrder godoc
+// @Summary 	Place Sales Order
+// @Description	Price the lines with their wardrobes and take their stock, nothing is taken when any line is short
+// @Tags		orders
+// @Accept		json
+// @Param		order 		body 	request.SalesOrderRequest true "Sales Order Payload"
+// @Produce		json
+// @Param 		X-Actor		header		string	false	"who places the order, recorded in the audit trail"
+// @Param 		Idempotency-Key	header		string	false	"retries with the same key get the first response back instead of applying twice"
+// @Success		200	{object}	jsonResponse{data=response.SalesOrderResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/orders	[post]
+func (api *API) PlaceSalesOrder(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.PlaceSalesOrder")
+	defer span.End()
+
+	var orderReq request.SalesOrderRequest
+	err := json.Unmarshal(req.RawBody(), &orderReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	err = orderReq.ValidateSalesOrder()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.salesOrderUc.PlaceSalesOrder(withActor(ctx, req), &orderReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// GetSalesOrder godoc
+// @Summary 	Get Sales Order By ID
+// @Description	Get a sales order with its lines and the prices they were sold at
+// @Tags		orders
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"sales order id"
+// @Success		200	{object}	jsonResponse{data=response.SalesOrderResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/orders/{id}	[get]
+func (api *API) GetSalesOrder(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetSalesOrder")
+	defer span.End()
+
+	orderID, err := parseSalesOrderID(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.salesOrderUc.GetSalesOrder(ctx, &orderID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// FulfillSalesOrder godoc
+// @Summary 	Fulfill Sales Order
+// @Description	Mark a placed sales order as handed over to the customer, it can not be cancelled anymore
+// @Tags		orders
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"sales order id"
+// @Success		200	{object}	jsonResponse{data=response.SalesOrderResponse}
+// @Failure		409	{object}	jsonResponse{}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/orders/{id}/fulfill	[post]
+func (api *API) FulfillSalesOrder(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.FulfillSalesOrder")
+	defer span.End()
+
+	orderID, err := parseSalesOrderID(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.salesOrderUc.FulfillSalesOrder(ctx, &orderID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// CancelSalesOrder godoc
+// @Summary 	Cancel Sales Order
+// @Description	Cancel a placed sales order and return the stock of its lines to the location it was taken from
+// @Tags		orders
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"sales order id"
+// @Param 		X-Actor		header		string	false	"who cancels the order, recorded in the audit trail"
+// @Success		200	{object}	jsonResponse{data=response.SalesOrderResponse}
+// @Failure		409	{object}	jsonResponse{}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/orders/{id}/cancel	[post]
+func (api *API) CancelSalesOrder(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.CancelSalesOrder")
+	defer span.End()
+
+	orderID, err := parseSalesOrderID(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.salesOrderUc.CancelSalesOrder(withActor(ctx, req), &orderID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+func parseSalesOrderFilter(req *router.Request) (*request.SalesOrderFilterRequest, error) {
+	filterReq := &request.SalesOrderFilterRequest{
+		Status: req.Query("status"),
+	}
+
+	errRange := &custerr.ErrChain{
+		Message: errorcode.SalesOrderRangeInvalid.Message,
+		Code:    errorcode.SalesOrderRangeInvalid.Code,
+		Type:    response.ErrBadRequest,
+	}
+	if fromStr := req.Query("from"); fromStr != "" {
+		from, err := time.Parse(time.RFC3339, fromStr)
+		if err != nil {
+			return nil, errRange
+		}
+		filterReq.From = &from
+	}
+	if toStr := req.Query("to"); toStr != "" {
+		to, err := time.Parse(time.RFC3339, toStr)
+		if err != nil {
+			return nil, errRange
+		}
+		filterReq.To = &to
+	}
+
+	err := filterReq.ValidateFilter()
+	if err != nil {
+		return nil, err
+	}
+
+	return filterReq, nil
+}
+
+func parseSalesOrderID(req *router.Request) (uuid.UUID, error) {
+	orderIDStr := req.Params("id")
+	if orderIDStr == "" {
+		return uuid.Nil, errors.New("missing id")
+	}
+
+	orderID, err := uuid.Parse(orderIDStr)
+	if err != nil {
+		return uuid.Nil, errors.New("invalid id")
+	}
+
+	return orderID, nil
+}
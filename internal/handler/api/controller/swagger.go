@@ -1,10 +1,17 @@
 package controller
 
 type jsonResponse struct {
-	Data    any            `json:"data,omitempty"`
-	Code    int            `json:"code,omitempty"`
-	Message string         `json:"message,omitempty"`
-	Error   *errorResponse `json:"error,omitempty"`
+	Data       any                 `json:"data,omitempty"`
+	Pagination *paginationResponse `json:"pagination,omitempty"`
+	Code       int                 `json:"code,omitempty"`
+	Message    string              `json:"message,omitempty"`
+	Error      *errorResponse      `json:"error,omitempty"`
+}
+
+type paginationResponse struct {
+	Limit      int    `json:"limit"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	Total      int64  `json:"total,omitempty"`
 }
 
 type errorResponse struct {
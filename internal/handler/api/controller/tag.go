@@ -0,0 +1,214 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/infrastructures/custresp"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/lib/response/rest"
+	"sagara_backend_test/lib/router"
+	"sagara_backend_test/lib/tracing"
+)
+
+// GetAllTag godoc
+// @Summary 	Get All Tag
+// @Description	Get All Tag
+// @Tags		tags
+// @Accept		json
+// @Produce		json
+// @Success		200	{object}	jsonResponse{data=[]response.TagResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/tags	[get]
+func (api *API) GetAllTag(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetAllTag")
+	defer span.End()
+
+	res, err := api.tagUc.GetAllTag(ctx)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// InsertTag godoc
+// @Summary 	Insert Tag
+// @Description	Insert Tag, names are lowercased and an existing tag is returned as is
+// @Tags		tags
+// @Accept		json
+// @Param		tag 		body 	request.TagRequest true "Insert Payload"
+// @Produce		json
+// @Success		200	{object}	jsonResponse{data=response.TagResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/tags	[post]
+func (api *API) InsertTag(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.InsertTag")
+	defer span.End()
+
+	var tagReq request.TagRequest
+	err := json.Unmarshal(req.RawBody(), &tagReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	err = tagReq.ValidateTag()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.tagUc.InsertTag(ctx, &tagReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// DeleteTag godoc
+// @Summary 	Delete Tag By ID
+// @Description	Delete Tag, it is removed from every wardrobe as well
+// @Tags		tags
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"tag id"
+// @Success		200	{object}	jsonResponse{}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/tags/{id}	[delete]
+func (api *API) DeleteTag(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.DeleteTag")
+	defer span.End()
+
+	tagIDStr := req.Params("id")
+	if tagIDStr == "" {
+		return custresp.CustomErrorResponse(errors.New("missing id"))
+	}
+
+	tagID, err := uuid.Parse(tagIDStr)
+	if err != nil {
+		return custresp.CustomErrorResponse(errors.New("invalid id"))
+	}
+
+	err = api.tagUc.DeleteTag(ctx, &tagID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData("success"), nil
+}
+
+// GetWardrobeTags godoc
+// @Summary 	Get Wardrobe Tags
+// @Description	Get the tags of a wardrobe
+// @Tags		wardrobes
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"wardrobe id"
+// @Success		200	{object}	jsonResponse{data=[]response.TagResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/wardrobe/{id}/tags	[get]
+func (api *API) GetWardrobeTags(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetWardrobeTags")
+	defer span.End()
+
+	wardrobeIDStr := req.Params("id")
+	if wardrobeIDStr == "" {
+		return custresp.CustomErrorResponse(errors.New("missing id"))
+	}
+
+	wardrobeID, err := uuid.Parse(wardrobeIDStr)
+	if err != nil {
+		return custresp.CustomErrorResponse(errors.New("invalid id"))
+	}
+
+	res, err := api.tagUc.GetWardrobeTags(ctx, &wardrobeID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// AddWardrobeTags godoc
+// @Summary 	Add Wardrobe Tags
+// @Description	Attach tags to a wardrobe, unknown tags are created
+// @Tags		wardrobes
+// @Accept		json
+// @Param		tags 		body 	request.WardrobeTagsRequest true "Tags Payload"
+// @Produce		json
+// @Param 		id		path 		string 	false 	"wardrobe id"
+// @Success		200	{object}	jsonResponse{data=[]response.TagResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/wardrobe/{id}/tags	[post]
+func (api *API) AddWardrobeTags(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.AddWardrobeTags")
+	defer span.End()
+
+	wardrobeIDStr := req.Params("id")
+	if wardrobeIDStr == "" {
+		return custresp.CustomErrorResponse(errors.New("missing id"))
+	}
+
+	wardrobeID, err := uuid.Parse(wardrobeIDStr)
+	if err != nil {
+		return custresp.CustomErrorResponse(errors.New("invalid id"))
+	}
+
+	var tagsReq request.WardrobeTagsRequest
+	err = json.Unmarshal(req.RawBody(), &tagsReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	err = tagsReq.ValidateTags()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.tagUc.AddWardrobeTags(ctx, &wardrobeID, &tagsReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// RemoveWardrobeTag godoc
+// @Summary 	Remove Wardrobe Tag
+// @Description	Detach a tag from a wardrobe
+// @Tags		wardrobes
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"wardrobe id"
+// @Param 		tagId	path 		string 	false 	"tag id"
+// @Success		200	{object}	jsonResponse{}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/wardrobe/{id}/tags/{tagId}	[delete]
+func (api *API) RemoveWardrobeTag(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.RemoveWardrobeTag")
+	defer span.End()
+
+	wardrobeID, err := uuid.Parse(req.Params("id"))
+	if err != nil {
+		return custresp.CustomErrorResponse(errors.New("invalid id"))
+	}
+
+	tagID, err := uuid.Parse(req.Params("tagId"))
+	if err != nil {
+		return custresp.CustomErrorResponse(errors.New("invalid tag id"))
+	}
+
+	err = api.tagUc.RemoveWardrobeTag(ctx, &wardrobeID, &tagID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData("success"), nil
+}
@@ -0,0 +1,252 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/infrastructures/custresp"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/lib/response/rest"
+	"sagara_backend_test/lib/router"
+	"sagara_backend_test/lib/tracing"
+)
+
+// GetStockLevels godoc
+// @Summary 	Get Stock Levels
+// @Description	Get the stock of a wardrobe at every location and the stock in transit between them
+// @Tags		wardrobes
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"wardrobe id"
+// @Success		200	{object}	jsonResponse{data=response.StockLevelResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/wardrobe/{id}/stock	[get]
+func (api *API) GetStockLevels(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetStockLevels")
+	defer span.End()
+
+	wardrobeIDStr := req.Params("id")
+	if wardrobeIDStr == "" {
+		return custresp.CustomErrorResponse(errors.New("missing id"))
+	}
+
+	wardrobeID, err := uuid.Parse(wardrobeIDStr)
+	if err != nil {
+		return custresp.CustomErrorResponse(errors.New("invalid id"))
+	}
+
+	res, err := api.wardrobeUc.GetStockLevels(ctx, &wardrobeID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// TransferStock godoc
+// @Summary 	Transfer Stock
+// @Description	Take stock of a wardrobe from a location, it stays in transit until it is received at the destination or cancelled
+// @Tags		transfers
+// @Accept		json
+// @Param		transfer 		body 	request.StockTransferRequest true "Transfer Payload"
+// @Produce		json
+// @Param 		id		path 		string 	false 	"wardrobe id"
+// @Param 		X-Actor		header		string	false	"who makes the change, recorded in the audit trail"
+// @Param 		Idempotency-Key	header		string	false	"retries with the same key get the first response back instead of applying twice"
+// @Success		200	{object}	jsonResponse{data=response.StockTransferResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/wardrobe/{id}/transfers	[post]
+func (api *API) TransferStock(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.TransferStock")
+	defer span.End()
+
+	wardrobeIDStr := req.Params("id")
+	if wardrobeIDStr == "" {
+		return custresp.CustomErrorResponse(errors.New("missing id"))
+	}
+
+	wardrobeID, err := uuid.Parse(wardrobeIDStr)
+	if err != nil {
+		return custresp.CustomErrorResponse(errors.New("invalid id"))
+	}
+
+	var transferReq request.StockTransferRequest
+	err = json.Unmarshal(req.RawBody(), &transferReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	err = transferReq.ValidateTransfer()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.wardrobeUc.TransferStock(withActor(ctx, req), &wardrobeID, &transferReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// GetTransfers godoc
+// @Summary 	Get Stock Transfers
+// @Description	Get the stock transfers, the most recent first by default
+// @Tags		transfers
+// @Accept		json
+// @Produce		json
+// @Param 		status		query		string	false	"in_transit, received or cancelled"
+// @Param 		wardrobe_id	query		string	false	"wardrobe id"
+// @Param 		location_id	query		string	false	"location the transfers leave from or arrive at"
+// @Param 		limit	query		int		false	"page size, 20 by default and 100 at most"
+// @Param 		cursor	query		string	false	"next_cursor of the previous page"
+// @Param 		sort	query		string	false	"created_at, prefix with - to sort descending"
+// @Param 		total	query		bool	false	"count every matching transfer"
+// @Success		200	{object}	jsonResponse{data=[]response.StockTransferResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/transfers	[get]
+func (api *API) GetTransfers(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetTransfers")
+	defer span.End()
+
+	filterReq := &request.StockTransferFilterRequest{
+		Status: req.Query("status"),
+	}
+	if idStr := req.Query("wardrobe_id"); idStr != "" {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return custresp.CustomErrorResponse(errors.New("invalid wardrobe_id"))
+		}
+		filterReq.WardrobeID = &id
+	}
+	if idStr := req.Query("location_id"); idStr != "" {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return custresp.CustomErrorResponse(errors.New("invalid location_id"))
+		}
+		filterReq.LocationID = &id
+	}
+
+	err := filterReq.ValidateFilter()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	pageReq, err := parsePageRequest(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, pagination, err := api.wardrobeUc.GetTransfers(ctx, filterReq, pageReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res).SetPagination(pagination), nil
+}
+
+// GetTransfer godoc
+// @Summary 	Get Stock Transfer By ID
+// @Description	Get Stock Transfer
+// @Tags		transfers
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"transfer id"
+// @Success		200	{object}	jsonResponse{data=response.StockTransferResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/transfers/{id}	[get]
+func (api *API) GetTransfer(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetTransfer")
+	defer span.End()
+
+	transferID, err := parseTransferID(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.wardrobeUc.GetTransfer(ctx, &transferID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// ReceiveTransfer godoc
+// @Summary 	Receive Stock Transfer
+// @Description	Add the stock in transit to the destination of the transfer
+// @Tags		transfers
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"transfer id"
+// @Param 		X-Actor		header		string	false	"who makes the change, recorded in the audit trail"
+// @Success		200	{object}	jsonResponse{data=response.StockTransferResponse}
+// @Failure		409	{object}	jsonResponse{}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/transfers/{id}/receive	[post]
+func (api *API) ReceiveTransfer(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.ReceiveTransfer")
+	defer span.End()
+
+	transferID, err := parseTransferID(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.wardrobeUc.ReceiveTransfer(withActor(ctx, req), &transferID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// CancelTransfer godoc
+// @Summary 	Cancel Stock Transfer
+// @Description	Return the stock in transit to the origin of the transfer
+// @Tags		transfers
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"transfer id"
+// @Param 		X-Actor		header		string	false	"who makes the change, recorded in the audit trail"
+// @Success		200	{object}	jsonResponse{data=response.StockTransferResponse}
+// @Failure		409	{object}	jsonResponse{}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/transfers/{id}/cancel	[post]
+func (api *API) CancelTransfer(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.CancelTransfer")
+	defer span.End()
+
+	transferID, err := parseTransferID(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.wardrobeUc.CancelTransfer(withActor(ctx, req), &transferID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+func parseTransferID(req *router.Request) (uuid.UUID, error) {
+	transferIDStr := req.Params("id")
+	if transferIDStr == "" {
+		return uuid.Nil, errors.New("missing id")
+	}
+
+	transferID, err := uuid.Parse(transferIDStr)
+	if err != nil {
+		return uuid.Nil, errors.New("invalid id")
+	}
+
+	return transferID, nil
+}
@@ -0,0 +1,94 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/infrastructures/custresp"
+	"sagara_backend_test/lib/response/rest"
+	"sagara_backend_test/lib/router"
+	"sagara_backend_test/lib/tracing"
+)
+
+// GetTrash godoc
+// @Summary 	Get Deleted Wardrobe
+// @Description	Get the wardrobes in the trash, the most recently deleted first by default
+// @Tags		wardrobes
+// @Accept		json
+// @Produce		json
+// @Param 		limit	query		int		false	"page size, 20 by default and 100 at most"
+// @Param 		cursor	query		string	false	"next_cursor of the previous page"
+// @Param 		sort	query		string	false	"comma separated fields among name, price, stock, created_at, updated_at, deleted_at, prefix with - to sort descending"
+// @Param 		total	query		bool	false	"count every deleted wardrobe"
+// @Success		200	{object}	jsonResponse{data=[]response.WardrobeResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/wardrobe/trash	[get]
+func (api *API) GetTrash(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetTrash")
+	defer span.End()
+
+	pageReq, err := parsePageRequest(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, pagination, err := api.wardrobeUc.GetTrash(ctx, pageReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res).SetPagination(pagination), nil
+}
+
+// PurgeTrash godoc
+// @Summary 	Purge Deleted Wardrobe
+// @Description	Permanently remove the wardrobes deleted longer ago than the configured retention, with their history
+// @Tags		wardrobes
+// @Accept		json
+// @Produce		json
+// @Success		200	{object}	jsonResponse{data=response.PurgeResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/wardrobe/trash/purge	[post]
+func (api *API) PurgeTrash(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.PurgeTrash")
+	defer span.End()
+
+	res, err := api.wardrobeUc.PurgeTrash(ctx)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// Restore godoc
+// @Summary 	Restore Wardrobe
+// @Description	Take a wardrobe out of the trash, it fails when another wardrobe took its sku meanwhile
+// @Tags		wardrobes
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"wardrobe id"
+// @Param 		X-Actor		header		string	false	"who makes the change, recorded in the audit trail"
+// @Success		200	{object}	jsonResponse{data=response.WardrobeResponse}
+// @Failure		409	{object}	jsonResponse{}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/wardrobe/{id}/restore	[post]
+func (api *API) Restore(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.Restore")
+	defer span.End()
+
+	wardrobeID, err := uuid.Parse(req.Params("id"))
+	if err != nil {
+		return custresp.CustomErrorResponse(errors.New("invalid id"))
+	}
+
+	res, err := api.wardrobeUc.RestoreWardrobe(withActor(ctx, req), &wardrobeID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
@@ -5,12 +5,17 @@ import (
 	"encoding/json"
 	"errors"
 	"github.com/google/uuid"
+	"net/http"
 	"sagara_backend_test/internal/infrastructures/custresp"
 	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/response"
 	"sagara_backend_test/lib/response/rest"
 	"sagara_backend_test/lib/router"
 	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/pkg/constants/errorcode"
 	"strconv"
+	"strings"
 )
 
 // GetAll godoc
@@ -19,18 +24,29 @@ import (
 // @Tags		wardrobes
 // @Accept		json
 // @Produce		json
+// @Param 		limit	query		int		false	"page size, 20 by default and 100 at most"
+// @Param 		cursor	query		string	false	"next_cursor of the previous page"
+// @Param 		sort	query		string	false	"comma separated fields among name, price, stock, created_at, updated_at, prefix with - to sort descending"
+// @Param 		total	query		bool	false	"count every matching wardrobe"
 // @Success		200	{object}	jsonResponse{data=response.WardrobeResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
 // @Router		/v1/wardrobe	[get]
 func (api *API) GetAll(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetAll")
 	defer span.End()
 
-	res, err := api.wardrobeUc.GetAllWardrobe(ctx)
+	pageReq, err := parsePageRequest(req)
 	if err != nil {
 		return custresp.CustomErrorResponse(err)
 	}
 
-	return rest.NewJSONResponse().SetData(res), nil
+	res, pagination, err := api.wardrobeUc.GetAllWardrobe(ctx, pageReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res).SetPagination(pagination), nil
 }
 
 // Insert godoc
@@ -40,7 +56,11 @@ func (api *API) GetAll(ctx context.Context, req *router.Request) (*rest.JSONResp
 // @Accept		json
 // @Param		wardrobes 		body 	request.WardrobeInsertRequest true "Insert Payload"
 // @Produce		json
+// @Param 		X-Actor		header		string	false	"who makes the change, recorded in the audit trail"
+// @Param 		Idempotency-Key	header		string	false	"retries with the same key get the first response back instead of applying twice"
 // @Success		200	{object}	jsonResponse{data=response.WardrobeResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
 // @Router		/v1/wardrobe	[post]
 func (api *API) Insert(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.Insert")
@@ -52,7 +72,12 @@ func (api *API) Insert(ctx context.Context, req *router.Request) (*rest.JSONResp
 		return custresp.CustomErrorResponse(err)
 	}
 
-	res, err := api.wardrobeUc.InsertWardrobe(ctx, &insertReq)
+	err = insertReq.ValidateInsertWardrobe()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.wardrobeUc.InsertWardrobe(withActor(ctx, req), &insertReq)
 	if err != nil {
 		return custresp.CustomErrorResponse(err)
 	}
@@ -68,7 +93,14 @@ func (api *API) Insert(ctx context.Context, req *router.Request) (*rest.JSONResp
 // @Param		wardrobes 		body 	request.WardrobeUpdateRequest true "Update Payload"
 // @Produce		json
 // @Param 		id		path 		string 	false 	"wardrobe id"
+// @Param 		If-Match	header		string	true	"ETag of the wardrobe being updated"
+// @Param 		X-Actor		header		string	false	"who makes the change, recorded in the audit trail and price history"
 // @Success		200	{object}	jsonResponse{data=response.WardrobeResponse}
+// @Header		200	{string}	ETag	"version of the updated wardrobe"
+// @Failure		412	{object}	jsonResponse{}
+// @Failure		428	{object}	jsonResponse{}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
 // @Router		/v1/wardrobe/{id}	[put]
 func (api *API) Update(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.Update")
@@ -94,12 +126,31 @@ func (api *API) Update(ctx context.Context, req *router.Request) (*rest.JSONResp
 	if err != nil {
 		return custresp.CustomErrorResponse(err)
 	}
-	res, err := api.wardrobeUc.UpdateWardrobe(ctx, &wardrobeID, &updateReq)
+
+	ifMatch := req.Header("If-Match")
+	if ifMatch == "" {
+		return custresp.CustomErrorResponse(&custerr.ErrChain{
+			Message: errorcode.VersionRequired.Message,
+			Code:    errorcode.VersionRequired.Code,
+			Type:    response.ErrPreconditionRequired,
+		})
+	}
+
+	version, ok := parseETag(ifMatch)
+	if !ok {
+		return custresp.CustomErrorResponse(&custerr.ErrChain{
+			Message: errorcode.VersionMismatch.Message,
+			Code:    errorcode.VersionMismatch.Code,
+			Type:    response.ErrPreconditionFailed,
+		})
+	}
+
+	res, err := api.wardrobeUc.UpdateWardrobe(withActor(ctx, req), &wardrobeID, version, &updateReq)
 	if err != nil {
 		return custresp.CustomErrorResponse(err)
 	}
 
-	return rest.NewJSONResponse().SetData(res), nil
+	return rest.NewJSONResponse().SetData(res).SetHeader("ETag", formatETag(res.Version)), nil
 }
 
 // GetById godoc
@@ -109,7 +160,12 @@ func (api *API) Update(ctx context.Context, req *router.Request) (*rest.JSONResp
 // @Accept		json
 // @Produce		json
 // @Param 		id		path 		string 	false 	"wardrobe id"
+// @Param 		If-None-Match	header		string	false	"ETag previously received"
 // @Success		200	{object}	jsonResponse{data=response.WardrobeResponse}
+// @Header		200	{string}	ETag	"version of the wardrobe"
+// @Success		304
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
 // @Router		/v1/wardrobe/{id}	[get]
 func (api *API) GetById(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetById")
@@ -130,17 +186,25 @@ func (api *API) GetById(ctx context.Context, req *router.Request) (*rest.JSONRes
 		return custresp.CustomErrorResponse(err)
 	}
 
-	return rest.NewJSONResponse().SetData(res), nil
+	etag := formatETag(res.Version)
+	if ifNoneMatch := req.Header("If-None-Match"); ifNoneMatch != "" && matchETag(ifNoneMatch, res.Version) {
+		return rest.NewJSONResponse().SetCode(http.StatusNotModified).SetHeader("ETag", etag), nil
+	}
+
+	return rest.NewJSONResponse().SetData(res).SetHeader("ETag", etag), nil
 }
 
 // Delete godoc
 // @Summary 	Delete Wardrobe By ID
-// @Description	Delete Wardrobe
+// @Description	Move a wardrobe to the trash, it can be restored until it is purged
 // @Tags		wardrobes
 // @Accept		json
 // @Produce		json
 // @Param 		id		path 		string 	false 	"wardrobe id"
+// @Param 		X-Actor		header		string	false	"who makes the change, recorded in the audit trail"
 // @Success		200	{object}	jsonResponse{}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
 // @Router		/v1/wardrobe/{id}	[delete]
 func (api *API) Delete(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.Delete")
@@ -156,7 +220,7 @@ func (api *API) Delete(ctx context.Context, req *router.Request) (*rest.JSONResp
 		return custresp.CustomErrorResponse(errors.New("invalid id"))
 	}
 
-	err = api.wardrobeUc.DeleteWardrobe(ctx, &wardrobeID)
+	err = api.wardrobeUc.DeleteWardrobe(withActor(ctx, req), &wardrobeID)
 	if err != nil {
 		return custresp.CustomErrorResponse(err)
 	}
@@ -164,30 +228,110 @@ func (api *API) Delete(ctx context.Context, req *router.Request) (*rest.JSONResp
 	return rest.NewJSONResponse().SetData("success"), nil
 }
 
+// Suggest godoc
+// @Summary 	Suggest Wardrobe
+// @Description	Autocomplete a partially typed query with the best matching wardrobes
+// @Tags		wardrobes
+// @Accept		json
+// @Produce		json
+// @Param 		q		query		string	true	"Query typed so far"
+// @Success		200		{object}	jsonResponse{data=[]response.WardrobeSuggestionResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/wardrobe/suggest	[get]
+func (api *API) Suggest(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.Suggest")
+	defer span.End()
+
+	res, err := api.wardrobeUc.Suggest(ctx, req.Query("q"))
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
 // Search godoc
 // @Summary 	Search Wardrobe
-// @Description	Search Wardrobe by color and/or size
+// @Description	Search Wardrobe by text, color, size, category (including its descendants) and tags
 // @Tags		wardrobes
 // @Accept		json
 // @Produce		json
-// @Param 		color	query		string	false	"Color of the wardrobe"
-// @Param 		size	query		string	false	"Size of the wardrobe"
+// @Param 		q			query		string	false	"Words matched as prefixes of the name, color and sku, ignoring case and accents"
+// @Param 		color		query		string	false	"Color of the wardrobe"
+// @Param 		size		query		string	false	"Size of the wardrobe"
+// @Param 		category_id	query		string	false	"Category of the wardrobe"
+// @Param 		tags		query		string	false	"Comma separated tags"
+// @Param 		tag_match	query		string	false	"any (default) or all of the tags"
+// @Param 		limit	query		int		false	"page size, 20 by default and 100 at most"
+// @Param 		cursor	query		string	false	"next_cursor of the previous page"
+// @Param 		sort	query		string	false	"comma separated fields among name, price, stock, created_at, updated_at and relevance (with q, the default), prefix with - to sort descending"
+// @Param 		total	query		bool	false	"count every matching wardrobe"
 // @Success		200		{object}	jsonResponse{data=[]response.WardrobeResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
 // @Router		/v1/wardrobe/search	[get]
 func (api *API) Search(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.Search")
 	defer span.End()
 
-	color := req.Query("color")
+	searchReq, err := parseSearchRequest(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
 
-	size := req.Query("size")
+	pageReq, err := parsePageRequest(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
 
-	wardrobes, err := api.wardrobeUc.Search(ctx, color, size)
+	wardrobes, pagination, err := api.wardrobeUc.Search(ctx, searchReq, pageReq)
 	if err != nil {
 		return custresp.CustomErrorResponse(err)
 	}
 
-	return rest.NewJSONResponse().SetData(wardrobes), nil
+	return rest.NewJSONResponse().SetData(wardrobes).SetPagination(pagination), nil
+}
+
+// SetCategory godoc
+// @Summary 	Set Wardrobe Category
+// @Description	Move the wardrobe into a category, an empty category_id removes it from its category
+// @Tags		wardrobes
+// @Accept		json
+// @Param		category 		body 	request.WardrobeCategoryRequest true "Category Payload"
+// @Produce		json
+// @Param 		id		path 		string 	false 	"wardrobe id"
+// @Param 		X-Actor		header		string	false	"who makes the change, recorded in the audit trail"
+// @Success		200	{object}	jsonResponse{data=response.WardrobeResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/wardrobe/{id}/category	[put]
+func (api *API) SetCategory(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.SetCategory")
+	defer span.End()
+
+	wardrobeIDStr := req.Params("id")
+	if wardrobeIDStr == "" {
+		return custresp.CustomErrorResponse(errors.New("missing id"))
+	}
+
+	wardrobeID, err := uuid.Parse(wardrobeIDStr)
+	if err != nil {
+		return custresp.CustomErrorResponse(errors.New("invalid id"))
+	}
+
+	var categoryReq request.WardrobeCategoryRequest
+	err = json.Unmarshal(req.RawBody(), &categoryReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.wardrobeUc.SetCategory(withActor(ctx, req), &wardrobeID, &categoryReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
 }
 
 // AddStock godoc
@@ -198,7 +342,11 @@ func (api *API) Search(ctx context.Context, req *router.Request) (*rest.JSONResp
 // @Param		wardrobes 		body 	request.WardrobeAddSubRequest true "WardrobeAddSubRequest Payload"
 // @Produce		json
 // @Param 		id		path 		string 	false 	"wardrobe id"
+// @Param 		X-Actor		header		string	false	"who makes the change, recorded in the audit trail"
+// @Param 		Idempotency-Key	header		string	false	"retries with the same key get the first response back instead of applying twice"
 // @Success		200	{object}	jsonResponse{data=response.WardrobeResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
 // @Router		/v1/wardrobe/{id}/addStock	[put]
 func (api *API) AddStock(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.AddStock")
@@ -220,7 +368,12 @@ func (api *API) AddStock(ctx context.Context, req *router.Request) (*rest.JSONRe
 		return custresp.CustomErrorResponse(err)
 	}
 
-	res, err := api.wardrobeUc.AddStock(ctx, &wardrobeID, updateReq.Amount)
+	err = updateReq.ValidateAddSubStock()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.wardrobeUc.AddStock(withActor(ctx, req), &wardrobeID, &updateReq)
 	if err != nil {
 		return custresp.CustomErrorResponse(err)
 	}
@@ -236,7 +389,11 @@ func (api *API) AddStock(ctx context.Context, req *router.Request) (*rest.JSONRe
 // @Param		wardrobes 		body 	request.WardrobeAddSubRequest true "WardrobeAddSubRequest Payload"
 // @Produce		json
 // @Param 		id		path 		string 	false 	"wardrobe id"
+// @Param 		X-Actor		header		string	false	"who makes the change, recorded in the audit trail"
+// @Param 		Idempotency-Key	header		string	false	"retries with the same key get the first response back instead of applying twice"
 // @Success		200	{object}	jsonResponse{data=response.WardrobeResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
 // @Router		/v1/wardrobe/{id}/subStock	[put]
 func (api *API) SubStock(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.AddStock")
@@ -258,7 +415,45 @@ func (api *API) SubStock(ctx context.Context, req *router.Request) (*rest.JSONRe
 		return custresp.CustomErrorResponse(err)
 	}
 
-	res, err := api.wardrobeUc.SubStock(ctx, &wardrobeID, updateReq.Amount)
+	err = updateReq.ValidateAddSubStock()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.wardrobeUc.SubStock(withActor(ctx, req), &wardrobeID, &updateReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// GetStockMovements godoc
+// @Summary 	Get Stock Movements
+// @Description	Get stock movement history of a wardrobe, newest first
+// @Tags		wardrobes
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"wardrobe id"
+// @Success		200	{object}	jsonResponse{data=[]response.StockMovementResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/wardrobe/{id}/movements	[get]
+func (api *API) GetStockMovements(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetStockMovements")
+	defer span.End()
+
+	wardrobeIDStr := req.Params("id")
+	if wardrobeIDStr == "" {
+		return custresp.CustomErrorResponse(errors.New("missing id"))
+	}
+
+	wardrobeID, err := uuid.Parse(wardrobeIDStr)
+	if err != nil {
+		return custresp.CustomErrorResponse(errors.New("invalid id"))
+	}
+
+	res, err := api.wardrobeUc.GetStockMovements(ctx, &wardrobeID)
 	if err != nil {
 		return custresp.CustomErrorResponse(err)
 	}
@@ -272,18 +467,29 @@ func (api *API) SubStock(ctx context.Context, req *router.Request) (*rest.JSONRe
 // @Tags		wardrobes
 // @Accept		json
 // @Produce		json
+// @Param 		limit	query		int		false	"page size, 20 by default and 100 at most"
+// @Param 		cursor	query		string	false	"next_cursor of the previous page"
+// @Param 		sort	query		string	false	"comma separated fields among name, price, stock, created_at, updated_at, prefix with - to sort descending"
+// @Param 		total	query		bool	false	"count every matching wardrobe"
 // @Success		200	{object}	jsonResponse{data=response.WardrobeResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
 // @Router		/v1/wardrobe/ready	[get]
 func (api *API) GetAvailable(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetAvailable")
 	defer span.End()
 
-	res, err := api.wardrobeUc.GetAvailable(ctx)
+	pageReq, err := parsePageRequest(req)
 	if err != nil {
 		return custresp.CustomErrorResponse(err)
 	}
 
-	return rest.NewJSONResponse().SetData(res), nil
+	res, pagination, err := api.wardrobeUc.GetAvailable(ctx, pageReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res).SetPagination(pagination), nil
 }
 
 // GetUnavailable godoc
@@ -292,18 +498,29 @@ func (api *API) GetAvailable(ctx context.Context, req *router.Request) (*rest.JS
 // @Tags		wardrobes
 // @Accept		json
 // @Produce		json
+// @Param 		limit	query		int		false	"page size, 20 by default and 100 at most"
+// @Param 		cursor	query		string	false	"next_cursor of the previous page"
+// @Param 		sort	query		string	false	"comma separated fields among name, price, stock, created_at, updated_at, prefix with - to sort descending"
+// @Param 		total	query		bool	false	"count every matching wardrobe"
 // @Success		200	{object}	jsonResponse{data=response.WardrobeResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
 // @Router		/v1/wardrobe/out	[get]
 func (api *API) GetUnavailable(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetAvailable")
 	defer span.End()
 
-	res, err := api.wardrobeUc.GetUnavailable(ctx)
+	pageReq, err := parsePageRequest(req)
 	if err != nil {
 		return custresp.CustomErrorResponse(err)
 	}
 
-	return rest.NewJSONResponse().SetData(res), nil
+	res, pagination, err := api.wardrobeUc.GetUnavailable(ctx, pageReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res).SetPagination(pagination), nil
 }
 
 // GetLessThan godoc
@@ -312,8 +529,14 @@ func (api *API) GetUnavailable(ctx context.Context, req *router.Request) (*rest.
 // @Tags		wardrobes
 // @Accept		json
 // @Produce		json
-// @Param 		amount	query		string	false	"amount of the wardrobe"
+// @Param 		amount	query		string	false	"available stock to compare to, the configured default threshold when empty"
+// @Param 		limit	query		int		false	"page size, 20 by default and 100 at most"
+// @Param 		cursor	query		string	false	"next_cursor of the previous page"
+// @Param 		sort	query		string	false	"comma separated fields among name, price, stock, created_at, updated_at, prefix with - to sort descending"
+// @Param 		total	query		bool	false	"count every matching wardrobe"
 // @Success		200	{object}	jsonResponse{data=response.WardrobeResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
 // @Router		/v1/wardrobe/less	[get]
 func (api *API) GetLessThan(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetAvailable")
@@ -322,10 +545,43 @@ func (api *API) GetLessThan(ctx context.Context, req *router.Request) (*rest.JSO
 	amount := req.Query("amount")
 	amountInt, err := strconv.Atoi(amount)
 
-	res, err := api.wardrobeUc.GetLessThan(ctx, amountInt)
+	pageReq, err := parsePageRequest(req)
 	if err != nil {
 		return custresp.CustomErrorResponse(err)
 	}
 
-	return rest.NewJSONResponse().SetData(res), nil
+	res, pagination, err := api.wardrobeUc.GetLessThan(ctx, amountInt, pageReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res).SetPagination(pagination), nil
+}
+
+func parseSearchRequest(req *router.Request) (*request.WardrobeSearchRequest, error) {
+	searchReq := &request.WardrobeSearchRequest{
+		Query:    strings.TrimSpace(req.Query("q")),
+		Color:    req.Query("color"),
+		Size:     req.Query("size"),
+		TagMatch: req.Query("tag_match"),
+	}
+
+	if categoryIDStr := req.Query("category_id"); categoryIDStr != "" {
+		categoryID, err := uuid.Parse(categoryIDStr)
+		if err != nil {
+			return nil, errors.New("invalid category_id")
+		}
+		searchReq.CategoryID = &categoryID
+	}
+
+	if tags := req.Query("tags"); tags != "" {
+		searchReq.Tags = strings.Split(tags, ",")
+	}
+
+	err := searchReq.ValidateSearch()
+	if err != nil {
+		return nil, err
+	}
+
+	return searchReq, nil
 }
@@ -0,0 +1,274 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/infrastructures/custresp"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/lib/response/rest"
+	"sagara_backend_test/lib/router"
+	"sagara_backend_test/lib/tracing"
+)
+
+// GetWebhooks godoc
+// @Summary 	Get All Webhook
+// @Description	Get the webhook subscriptions, their secret is not returned
+// @Tags		webhooks
+// @Accept		json
+// @Produce		json
+// @Success		200	{object}	jsonResponse{data=[]response.WebhookResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/webhooks	[get]
+func (api *API) GetWebhooks(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetWebhooks")
+	defer span.End()
+
+	res, err := api.webhookUc.GetWebhooks(ctx)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// InsertWebhook godoc
+// @Summary 	Insert Webhook
+// @Description	Subscribe a url to the wardrobe events. Every delivery is posted with the X-Webhook-Event, X-Webhook-Delivery and X-Webhook-Timestamp headers, and X-Webhook-Signature holding sha256= and the hex HMAC-SHA256 of the timestamp, a dot and the body keyed by the secret. The secret is only returned here.
+// @Tags		webhooks
+// @Accept		json
+// @Param		webhook 		body 	request.WebhookRequest true "Insert Payload"
+// @Produce		json
+// @Success		200	{object}	jsonResponse{data=response.WebhookResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/webhooks	[post]
+func (api *API) InsertWebhook(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.InsertWebhook")
+	defer span.End()
+
+	var webhookReq request.WebhookRequest
+	err := json.Unmarshal(req.RawBody(), &webhookReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	err = webhookReq.ValidateWebhook()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.webhookUc.InsertWebhook(ctx, &webhookReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// GetWebhook godoc
+// @Summary 	Get Webhook By ID
+// @Description	Get a webhook subscription, with the reason it was disabled
+// @Tags		webhooks
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"webhook id"
+// @Success		200	{object}	jsonResponse{data=response.WebhookResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/webhooks/{id}	[get]
+func (api *API) GetWebhook(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetWebhook")
+	defer span.End()
+
+	webhookID, err := uuid.Parse(req.Params("id"))
+	if err != nil {
+		return custresp.CustomErrorResponse(errors.New("invalid id"))
+	}
+
+	res, err := api.webhookUc.GetWebhook(ctx, &webhookID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// UpdateWebhook godoc
+// @Summary 	Update Webhook
+// @Description	Replace the url and the event types of a webhook, the secret is kept when it is empty. Set active to true to enable a disabled webhook again, its pending deliveries resume.
+// @Tags		webhooks
+// @Accept		json
+// @Param		webhook 		body 	request.WebhookRequest true "Update Payload"
+// @Produce		json
+// @Param 		id		path 		string 	false 	"webhook id"
+// @Success		200	{object}	jsonResponse{data=response.WebhookResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/webhooks/{id}	[put]
+func (api *API) UpdateWebhook(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.UpdateWebhook")
+	defer span.End()
+
+	webhookID, err := uuid.Parse(req.Params("id"))
+	if err != nil {
+		return custresp.CustomErrorResponse(errors.New("invalid id"))
+	}
+
+	var webhookReq request.WebhookRequest
+	err = json.Unmarshal(req.RawBody(), &webhookReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	err = webhookReq.ValidateWebhook()
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.webhookUc.UpdateWebhook(ctx, &webhookID, &webhookReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// DeleteWebhook godoc
+// @Summary 	Delete Webhook
+// @Description	Delete a webhook subscription with its deliveries
+// @Tags		webhooks
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"webhook id"
+// @Success		200	{object}	jsonResponse{}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/webhooks/{id}	[delete]
+func (api *API) DeleteWebhook(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.DeleteWebhook")
+	defer span.End()
+
+	webhookID, err := uuid.Parse(req.Params("id"))
+	if err != nil {
+		return custresp.CustomErrorResponse(errors.New("invalid id"))
+	}
+
+	err = api.webhookUc.DeleteWebhook(ctx, &webhookID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData("success"), nil
+}
+
+// GetWebhookDeliveries godoc
+// @Summary 	Get Webhook Deliveries
+// @Description	Get the deliveries of a webhook, the most recent first by default
+// @Tags		webhooks
+// @Accept		json
+// @Produce		json
+// @Param 		id		path 		string 	false 	"webhook id"
+// @Param 		limit	query		int		false	"page size, 20 by default and 100 at most"
+// @Param 		cursor	query		string	false	"next_cursor of the previous page"
+// @Param 		sort	query		string	false	"created_at, prefix with - to sort descending"
+// @Param 		total	query		bool	false	"count every delivery of the webhook"
+// @Success		200	{object}	jsonResponse{data=[]response.WebhookDeliveryResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/webhooks/{id}/deliveries	[get]
+func (api *API) GetWebhookDeliveries(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetWebhookDeliveries")
+	defer span.End()
+
+	webhookID, err := uuid.Parse(req.Params("id"))
+	if err != nil {
+		return custresp.CustomErrorResponse(errors.New("invalid id"))
+	}
+
+	pageReq, err := parsePageRequest(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, pagination, err := api.webhookUc.GetDeliveries(ctx, &webhookID, pageReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res).SetPagination(pagination), nil
+}
+
+// GetWebhookDelivery godoc
+// @Summary 	Get Webhook Delivery
+// @Description	Get a delivery with its payload and the log of every attempt
+// @Tags		webhooks
+// @Accept		json
+// @Produce		json
+// @Param 		id			path 		string 	false 	"webhook id"
+// @Param 		deliveryId	path 		string 	false 	"delivery id"
+// @Success		200	{object}	jsonResponse{data=response.WebhookDeliveryResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/webhooks/{id}/deliveries/{deliveryId}	[get]
+func (api *API) GetWebhookDelivery(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.GetWebhookDelivery")
+	defer span.End()
+
+	webhookID, deliveryID, err := parseDeliveryID(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.webhookUc.GetDelivery(ctx, &webhookID, &deliveryID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// RedeliverWebhook godoc
+// @Summary 	Redeliver Webhook
+// @Description	Queue a delivery again with a fresh set of attempts, it is sent on the next run of the delivery job
+// @Tags		webhooks
+// @Accept		json
+// @Produce		json
+// @Param 		id			path 		string 	false 	"webhook id"
+// @Param 		deliveryId	path 		string 	false 	"delivery id"
+// @Success		200	{object}	jsonResponse{data=response.WebhookDeliveryResponse}
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Router		/v1/webhooks/{id}/deliveries/{deliveryId}/redeliver	[post]
+func (api *API) RedeliverWebhook(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.RedeliverWebhook")
+	defer span.End()
+
+	webhookID, deliveryID, err := parseDeliveryID(req)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	res, err := api.webhookUc.Redeliver(ctx, &webhookID, &deliveryID)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+func parseDeliveryID(req *router.Request) (uuid.UUID, uuid.UUID, error) {
+	webhookID, err := uuid.Parse(req.Params("id"))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errors.New("invalid id")
+	}
+
+	deliveryID, err := uuid.Parse(req.Params("deliveryId"))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errors.New("invalid delivery id")
+	}
+
+	return webhookID, deliveryID, nil
+}
@@ -9,8 +9,22 @@ import (
 )
 
 type Options struct {
-	Cfg        config.MainConfig
-	WardrobeUc usecases.WardrobeUseCases
+	Cfg             config.MainConfig
+	WardrobeUc      usecases.WardrobeUseCases
+	ReservationUc   usecases.ReservationUseCases
+	ProductUc       usecases.ProductUseCases
+	CategoryUc      usecases.CategoryUseCases
+	TagUc           usecases.TagUseCases
+	ImportUc        usecases.ImportUseCases
+	AuditUc         usecases.AuditUseCases
+	AlertUc         usecases.AlertUseCases
+	WebhookUc       usecases.WebhookUseCases
+	LocationUc      usecases.LocationUseCases
+	PurchaseOrderUc usecases.PurchaseOrderUseCases
+	SalesOrderUc    usecases.SalesOrderUseCases
+	APIKeyUc        usecases.APIKeyUseCases
+	RoleUc          usecases.RoleUseCases
+	IdempotencyUc   usecases.IdempotencyUseCases
 }
 
 type Handler struct {
@@ -20,6 +34,11 @@ type Handler struct {
 }
 
 func New(opts *Options) *Handler {
+	authenticator, err := newAuthenticator(&opts.Cfg.Auth, opts.APIKeyUc, opts.RoleUc)
+	if err != nil {
+		log.Fatal("Could not initiate authentication: " + err.Error())
+	}
+
 	handler := &Handler{opts: opts}
 	handler.myRouter = controller.New(&controller.Options{
 		Prefix:         opts.Cfg.API.BasePath,
@@ -28,7 +47,32 @@ func New(opts *Options) *Handler {
 		WriteTimeout:   opts.Cfg.Server.WriteTimeout,
 		RequestTimeout: opts.Cfg.API.APITimeout,
 		EnableSwagger:  opts.Cfg.API.EnableSwagger,
-		WardrobeUc:     opts.WardrobeUc,
+		ProxyHeader:    opts.Cfg.API.ProxyHeader,
+		Authenticator:  authenticator,
+		RateLimit: &router.RateLimit{
+			Limit:  opts.Cfg.RateLimit.Limit,
+			Period: opts.Cfg.RateLimit.Period,
+			Burst:  opts.Cfg.RateLimit.Burst,
+		},
+		IPRateLimit: &router.RateLimit{
+			Limit:  opts.Cfg.RateLimit.IPLimit,
+			Period: opts.Cfg.RateLimit.Period,
+		},
+		IdempotencyStore: &idempotencyStore{idempotencyUc: opts.IdempotencyUc},
+		WardrobeUc:       opts.WardrobeUc,
+		ReservationUc:    opts.ReservationUc,
+		ProductUc:        opts.ProductUc,
+		CategoryUc:       opts.CategoryUc,
+		TagUc:            opts.TagUc,
+		ImportUc:         opts.ImportUc,
+		AuditUc:          opts.AuditUc,
+		AlertUc:          opts.AlertUc,
+		WebhookUc:        opts.WebhookUc,
+		LocationUc:       opts.LocationUc,
+		PurchaseOrderUc:  opts.PurchaseOrderUc,
+		SalesOrderUc:     opts.SalesOrderUc,
+		APIKeyUc:         opts.APIKeyUc,
+		RoleUc:           opts.RoleUc,
 	}).RegisterRoute()
 
 	return handler
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"context"
+	"sagara_backend_test/internal/usecases"
+	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/router"
+)
+
+// idempotencyStore keeps the idempotency keys of the router in the database, every instance sees the same keys
+type idempotencyStore struct {
+	idempotencyUc usecases.IdempotencyUseCases
+}
+
+func (s *idempotencyStore) Begin(ctx context.Context, key, fingerprint string) (*router.StoredResponse, error) {
+	res, err := s.idempotencyUc.Begin(ctx, key, fingerprint)
+	if err != nil || res == nil {
+		return nil, err
+	}
+
+	return &router.StoredResponse{
+		StatusCode:  res.StatusCode,
+		ContentType: res.ContentType,
+		Body:        res.Body,
+	}, nil
+}
+
+func (s *idempotencyStore) Complete(ctx context.Context, key string, res *router.StoredResponse) error {
+	return s.idempotencyUc.Complete(ctx, key, &response.IdempotentResponse{
+		StatusCode:  res.StatusCode,
+		ContentType: res.ContentType,
+		Body:        res.Body,
+	})
+}
+
+func (s *idempotencyStore) Release(ctx context.Context, key string) error {
+	return s.idempotencyUc.Release(ctx, key)
+}
@@ -0,0 +1,18 @@
+package scheduler
+
+import (
+	"context"
+	"sagara_backend_test/lib/log"
+)
+
+func (h *Handler) notifyStockAlerts(ctx context.Context) error {
+	notified, err := h.opts.AlertUc.NotifyAlerts(ctx)
+	if err != nil {
+		return err
+	}
+
+	if notified > 0 {
+		log.Infof("[scheduler.notifyStockAlerts] %d stock alerts notified", notified)
+	}
+	return nil
+}
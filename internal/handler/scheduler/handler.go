@@ -0,0 +1,94 @@
+package scheduler
+
+import (
+	"context"
+	"sagara_backend_test/config"
+	"sagara_backend_test/internal/usecases"
+	"sagara_backend_test/lib/log"
+	"sync"
+	"time"
+)
+
+type Options struct {
+	Cfg           config.MainConfig
+	ReservationUc usecases.ReservationUseCases
+	WardrobeUc    usecases.WardrobeUseCases
+	AlertUc       usecases.AlertUseCases
+	OutboxUc      usecases.OutboxUseCases
+	WebhookUc     usecases.WebhookUseCases
+	IdempotencyUc usecases.IdempotencyUseCases
+}
+
+type job struct {
+	name     string
+	interval time.Duration
+	fn       func(ctx context.Context) error
+}
+
+// Handler runs the background jobs of the service, every job is run on its own ticker
+type Handler struct {
+	opts   *Options
+	jobs   []job
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+func New(opts *Options) *Handler {
+	handler := &Handler{
+		opts:   opts,
+		stopCh: make(chan struct{}),
+	}
+
+	handler.jobs = []job{
+		{name: "ExpireReservations", interval: opts.Cfg.Reservation.SweepInterval, fn: handler.expireReservations},
+		{name: "ApplyScheduledPrices", interval: opts.Cfg.Pricing.ScheduleInterval, fn: handler.applyScheduledPrices},
+		{name: "PurgeTrash", interval: opts.Cfg.Trash.PurgeInterval, fn: handler.purgeTrash},
+		{name: "NotifyStockAlerts", interval: opts.Cfg.Alert.NotifyInterval, fn: handler.notifyStockAlerts},
+		{name: "RelayOutbox", interval: opts.Cfg.Outbox.RelayInterval, fn: handler.relayOutbox},
+		{name: "DeliverWebhooks", interval: opts.Cfg.Webhook.DeliverInterval, fn: handler.deliverWebhooks},
+		{name: "PurgeIdempotencyKeys", interval: opts.Cfg.Idempotency.PurgeInterval, fn: handler.purgeIdempotencyKeys},
+	}
+
+	return handler
+}
+
+func (h *Handler) Start() {
+	for _, j := range h.jobs {
+		if j.interval <= 0 {
+			log.Infof("[scheduler] job %s is disabled", j.name)
+			continue
+		}
+
+		h.wg.Add(1)
+		go h.run(j)
+	}
+}
+
+// Stop waits for the running jobs to finish their current execution
+func (h *Handler) Stop() {
+	close(h.stopCh)
+	h.wg.Wait()
+}
+
+func (h *Handler) run(j job) {
+	defer h.wg.Done()
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.stopCh:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), j.interval)
+			if err := j.fn(ctx); err != nil {
+				log.WithFields(log.Fields{
+					"error": err,
+					"job":   j.name,
+				}).Error("[scheduler.run] Job failed")
+			}
+			cancel()
+		}
+	}
+}
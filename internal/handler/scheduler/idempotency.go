@@ -0,0 +1,18 @@
+package scheduler
+
+import (
+	"context"
+	"sagara_backend_test/lib/log"
+)
+
+func (h *Handler) purgeIdempotencyKeys(ctx context.Context) error {
+	purged, err := h.opts.IdempotencyUc.PurgeExpired(ctx)
+	if err != nil {
+		return err
+	}
+
+	if purged > 0 {
+		log.Infof("[scheduler.purgeIdempotencyKeys] %d expired idempotency keys purged", purged)
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+package scheduler
+
+import (
+	"context"
+	"sagara_backend_test/lib/log"
+)
+
+func (h *Handler) relayOutbox(ctx context.Context) error {
+	published, err := h.opts.OutboxUc.RelayEvents(ctx)
+	if err != nil {
+		return err
+	}
+
+	if published > 0 {
+		log.Infof("[scheduler.relayOutbox] %d events published", published)
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package scheduler
+
+import (
+	"context"
+	"sagara_backend_test/internal/infrastructures/actor"
+	"sagara_backend_test/lib/log"
+)
+
+func (h *Handler) applyScheduledPrices(ctx context.Context) error {
+	applied, err := h.opts.WardrobeUc.ApplyScheduledPrices(actor.NewContext(ctx, actor.System))
+	if err != nil {
+		return err
+	}
+
+	if applied > 0 {
+		log.Infof("[scheduler.applyScheduledPrices] %d price schedules applied", applied)
+	}
+	return nil
+}
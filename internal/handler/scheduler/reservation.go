@@ -0,0 +1,18 @@
+package scheduler
+
+import (
+	"context"
+	"sagara_backend_test/lib/log"
+)
+
+func (h *Handler) expireReservations(ctx context.Context) error {
+	expired, err := h.opts.ReservationUc.ExpireReservations(ctx)
+	if err != nil {
+		return err
+	}
+
+	if expired > 0 {
+		log.Infof("[scheduler.expireReservations] %d reservations expired", expired)
+	}
+	return nil
+}
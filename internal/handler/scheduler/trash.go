@@ -0,0 +1,18 @@
+package scheduler
+
+import (
+	"context"
+	"sagara_backend_test/lib/log"
+)
+
+func (h *Handler) purgeTrash(ctx context.Context) error {
+	res, err := h.opts.WardrobeUc.PurgeTrash(ctx)
+	if err != nil {
+		return err
+	}
+
+	if res.Purged > 0 {
+		log.Infof("[scheduler.purgeTrash] %d deleted wardrobes purged", res.Purged)
+	}
+	return nil
+}
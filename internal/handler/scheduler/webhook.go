@@ -0,0 +1,18 @@
+package scheduler
+
+import (
+	"context"
+	"sagara_backend_test/lib/log"
+)
+
+func (h *Handler) deliverWebhooks(ctx context.Context) error {
+	delivered, err := h.opts.WebhookUc.DeliverWebhooks(ctx)
+	if err != nil {
+		return err
+	}
+
+	if delivered > 0 {
+		log.Infof("[scheduler.deliverWebhooks] %d webhook deliveries succeeded", delivered)
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package access
+
+import (
+	"context"
+	"sagara_backend_test/lib/router"
+)
+
+// Can tells whether the caller of the request has the permission. The use cases check it for what a route option
+// can not see, such as a single field of a payload. Work without a caller, like the background jobs, may do anything.
+func Can(ctx context.Context, permission string) bool {
+	claims, ok := router.ClaimsFromContext(ctx)
+	return !ok || claims.HasPermission(permission)
+}
+
+// HasScope tells whether the credentials of the request carry the scope, for the same cases as Can
+func HasScope(ctx context.Context, scope string) bool {
+	claims, ok := router.ClaimsFromContext(ctx)
+	return !ok || claims.HasScope(scope)
+}
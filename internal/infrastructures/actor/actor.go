@@ -0,0 +1,30 @@
+package actor
+
+import (
+	"context"
+	"sagara_backend_test/pkg/constants"
+)
+
+const (
+	// Header carries the name of whoever makes the request
+	Header = "X-Actor"
+	// System is the actor of the changes made by background jobs
+	System = "system"
+	// Anonymous is the actor of requests that did not tell who they are
+	Anonymous = "anonymous"
+)
+
+type contextKey struct{}
+
+func NewContext(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, contextKey{}, actor)
+}
+
+// FromContext returns the actor the context was made for, Anonymous when there is none
+func FromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(contextKey{}).(string)
+	if actor == constants.EmptyString {
+		return Anonymous
+	}
+	return actor
+}
@@ -1,9 +1,13 @@
 package custresp
 
-import "errors"
+import (
+	"errors"
+	"sagara_backend_test/lib/response"
+)
 
 var (
-	ErrTooManyRequest  = errors.New("too many request")
+	// ErrTooManyRequest is the one the rate limits of the router answer with
+	ErrTooManyRequest  = response.ErrTooManyRequest
 	ErrRequestTooEarly = errors.New("request too early")
 	ErrInvalidRequest  = errors.New("invalid request")
 )
@@ -0,0 +1,26 @@
+package notifier
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	ChannelWebhook = "webhook"
+	ChannelEmail   = "email"
+)
+
+// Notification is one message sent to every channel, the webhook posts it as json and the email uses Subject and Text
+type Notification struct {
+	Event   string    `json:"event"`
+	Subject string    `json:"subject"`
+	Text    string    `json:"text"`
+	Data    any       `json:"data"`
+	SentAt  time.Time `json:"sent_at"`
+}
+
+// Notifier delivers notifications to one channel, Channel names it so a delivery can be tracked per channel
+type Notifier interface {
+	Channel() string
+	Notify(ctx context.Context, notification *Notification) error
+}
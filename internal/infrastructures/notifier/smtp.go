@@ -0,0 +1,69 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type SMTPOptions struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	To       []string
+}
+
+type smtpMailer struct {
+	opts *SMTPOptions
+}
+
+// NewSMTP mails the notifications as plain text, it only authenticates when a username is given
+func NewSMTP(opts *SMTPOptions) Notifier {
+	return &smtpMailer{opts: opts}
+}
+
+func (s *smtpMailer) Channel() string {
+	return ChannelEmail
+}
+
+func (s *smtpMailer) Notify(ctx context.Context, notification *Notification) error {
+	if len(s.opts.To) == 0 {
+		return fmt.Errorf("no recipient to mail %s to", notification.Event)
+	}
+
+	var auth smtp.Auth
+	if s.opts.Username != "" {
+		auth = smtp.PlainAuth("", s.opts.Username, s.opts.Password, s.opts.Host)
+	}
+
+	var msg strings.Builder
+	msg.WriteString("From: " + s.opts.From + "\r\n")
+	msg.WriteString("To: " + strings.Join(s.opts.To, ", ") + "\r\n")
+	msg.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", notification.Subject) + "\r\n")
+	msg.WriteString("Date: " + notification.SentAt.Format(time.RFC1123Z) + "\r\n")
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(strings.ReplaceAll(notification.Text, "\n", "\r\n"))
+
+	// smtp.SendMail has no context, send in the background so a stuck server does not outlive the ctx
+	errCh := make(chan error, 1)
+	go func() {
+		addr := net.JoinHostPort(s.opts.Host, strconv.Itoa(s.opts.Port))
+		errCh <- smtp.SendMail(addr, auth, s.opts.From, s.opts.To, []byte(msg.String()))
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
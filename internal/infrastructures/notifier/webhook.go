@@ -0,0 +1,47 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	libHttp "sagara_backend_test/lib/http"
+	"time"
+)
+
+type WebhookOptions struct {
+	URL     string
+	Timeout time.Duration
+}
+
+type webhook struct {
+	client libHttp.Client
+}
+
+// NewWebhook posts the notifications to the url, any answer but a 2xx is an error
+func NewWebhook(opts *WebhookOptions) Notifier {
+	return &webhook{
+		client: libHttp.NewHttpClient(&libHttp.Options{
+			BaseUrl: opts.URL,
+			Timeout: opts.Timeout,
+		}),
+	}
+}
+
+func (w *webhook) Channel() string {
+	return ChannelWebhook
+}
+
+func (w *webhook) Notify(ctx context.Context, notification *Notification) error {
+	res, err := libHttp.NewPOSTRequest[any]("", nil, w.client).
+		WithContext(ctx).
+		WithBody(notification).
+		IsRawResponse(true).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	if res.HttpCode < 200 || res.HttpCode > 299 {
+		return fmt.Errorf("webhook answered with status %d", res.HttpCode)
+	}
+	return nil
+}
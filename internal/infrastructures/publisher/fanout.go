@@ -0,0 +1,27 @@
+package publisher
+
+import (
+	"context"
+	"errors"
+)
+
+type fanout struct {
+	publishers []Publisher
+}
+
+// NewFanout publishes every event to all the publishers, the event fails when any of them fails so it is published
+// again to all of them
+func NewFanout(publishers ...Publisher) Publisher {
+	return &fanout{publishers: publishers}
+}
+
+func (f *fanout) Publish(ctx context.Context, event *Event) error {
+	var errs []error
+	for _, p := range f.publishers {
+		err := p.Publish(ctx, event)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
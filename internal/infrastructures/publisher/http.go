@@ -0,0 +1,47 @@
+package publisher
+
+import (
+	"context"
+	"fmt"
+	libHttp "sagara_backend_test/lib/http"
+	"time"
+)
+
+// EventIDHeader carries the event id so the receiver can drop the events delivered twice
+const EventIDHeader = "X-Event-ID"
+
+type HTTPOptions struct {
+	URL     string
+	Timeout time.Duration
+}
+
+type httpPublisher struct {
+	client libHttp.Client
+}
+
+// NewHTTP posts the events as json to the url one at a time, any answer but a 2xx is an error
+func NewHTTP(opts *HTTPOptions) Publisher {
+	return &httpPublisher{
+		client: libHttp.NewHttpClient(&libHttp.Options{
+			BaseUrl: opts.URL,
+			Timeout: opts.Timeout,
+		}),
+	}
+}
+
+func (h *httpPublisher) Publish(ctx context.Context, event *Event) error {
+	res, err := libHttp.NewPOSTRequest[any]("", nil, h.client).
+		WithContext(ctx).
+		AddHeader(EventIDHeader, event.ID).
+		WithBody(event).
+		IsRawResponse(true).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	if res.HttpCode < 200 || res.HttpCode > 299 {
+		return fmt.Errorf("event receiver answered with status %d", res.HttpCode)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+const (
+	KindLog  = "log"
+	KindHTTP = "http"
+)
+
+// Event is a domain event as it is handed to the consumers. Delivery is at least once, consumers deduplicate on ID.
+type Event struct {
+	ID            string          `json:"id"`
+	Sequence      int64           `json:"sequence"`
+	Type          string          `json:"type"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   string          `json:"aggregate_id"`
+	Actor         string          `json:"actor"`
+	RequestID     string          `json:"request_id,omitempty"`
+	Data          json.RawMessage `json:"data"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+}
+
+// Publisher hands the events over to the other services, an error makes the event be retried later
+type Publisher interface {
+	Publish(ctx context.Context, event *Event) error
+}
+
+// Func lets an ordinary function be used as a Publisher
+type Func func(ctx context.Context, event *Event) error
+
+func (f Func) Publish(ctx context.Context, event *Event) error {
+	return f(ctx, event)
+}
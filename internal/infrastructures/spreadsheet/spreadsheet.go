@@ -0,0 +1,170 @@
+package spreadsheet
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"github.com/xuri/excelize/v2"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+)
+
+var (
+	ErrFormatUnsupported = errors.New("spreadsheet format must be csv or xlsx")
+	ErrNoSheet           = errors.New("workbook has no sheet")
+)
+
+// FormatFromFileName guesses the format from the file extension, an empty string when it is not supported
+func FormatFromFileName(fileName string) string {
+	format := strings.ToLower(strings.TrimPrefix(filepath.Ext(fileName), "."))
+	if !IsSupported(format) {
+		return ""
+	}
+	return format
+}
+
+func IsSupported(format string) bool {
+	return format == FormatCSV || format == FormatXLSX
+}
+
+// Read returns every row of the file including the header, only the first sheet of a workbook is read.
+// Rows are returned as they are in the file, a row may be shorter than the header.
+func Read(format string, data []byte) ([][]string, error) {
+	switch format {
+	case FormatCSV:
+		return readCSV(data)
+	case FormatXLSX:
+		return readXLSX(data)
+	default:
+		return nil, ErrFormatUnsupported
+	}
+}
+
+func readCSV(data []byte) ([][]string, error) {
+	// spreadsheet programs like to start their csv with a byte order mark
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var rows [][]string
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+}
+
+func readXLSX(data []byte) ([][]string, error) {
+	file, err := excelize.OpenReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	sheets := file.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, ErrNoSheet
+	}
+
+	return file.GetRows(sheets[0])
+}
+
+// Writer writes a file row by row, the file is complete once it is closed
+type Writer interface {
+	WriteRow(row []string) error
+	Close() error
+}
+
+// ContentType is the media type of the format, for a download
+func ContentType(format string) string {
+	switch format {
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+// NewWriter writes a csv straight to w, a workbook is kept in a temporary file by excelize until it is closed
+func NewWriter(format string, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return &csvWriter{writer: csv.NewWriter(w)}, nil
+	case FormatXLSX:
+		return newXLSXWriter(w)
+	default:
+		return nil, ErrFormatUnsupported
+	}
+}
+
+type csvWriter struct {
+	writer *csv.Writer
+}
+
+func (c *csvWriter) WriteRow(row []string) error {
+	return c.writer.Write(row)
+}
+
+func (c *csvWriter) Close() error {
+	c.writer.Flush()
+	return c.writer.Error()
+}
+
+type xlsxWriter struct {
+	out    io.Writer
+	file   *excelize.File
+	stream *excelize.StreamWriter
+	rows   int
+}
+
+func newXLSXWriter(w io.Writer) (*xlsxWriter, error) {
+	file := excelize.NewFile()
+	stream, err := file.NewStreamWriter(file.GetSheetName(0))
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return &xlsxWriter{out: w, file: file, stream: stream}, nil
+}
+
+func (x *xlsxWriter) WriteRow(row []string) error {
+	x.rows++
+	cell, err := excelize.CoordinatesToCellName(1, x.rows)
+	if err != nil {
+		return err
+	}
+
+	values := make([]any, len(row))
+	for i, value := range row {
+		values[i] = value
+	}
+	return x.stream.SetRow(cell, values)
+}
+
+func (x *xlsxWriter) Close() error {
+	defer x.file.Close()
+
+	err := x.stream.Flush()
+	if err != nil {
+		return err
+	}
+
+	_, err = x.file.WriteTo(x.out)
+	return err
+}
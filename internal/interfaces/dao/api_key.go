@@ -0,0 +1,209 @@
+package dao
+
+import (
+	"context"
+	sql2 "database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/lib/database/sql"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/lib/txmanager/utils"
+	"time"
+)
+
+type APIKeyRepository struct {
+	db *sql.Store
+}
+
+type OptsAPIKeyRepository struct {
+	DB *sql.Store
+}
+
+const (
+	apiKeyColumns = `id, name, prefix, secret_hash, scopes, created_by, expires_at, last_used_at, revoked_at, created_at, updated_at`
+
+	insertAPIKey    = `INSERT INTO api_keys (` + apiKeyColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+	selectAPIKey    = `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE TRUE %s`
+	selectAllAPIKey = `SELECT ` + apiKeyColumns + ` FROM api_keys ORDER BY created_at DESC, id`
+	revokeAPIKey    = `UPDATE api_keys SET revoked_at = $1, updated_at = $1 WHERE id = $2 AND revoked_at IS NULL`
+	// touchAPIKey records the use of a key at most once a minute, so a busy key does not write on every request
+	touchAPIKey = `UPDATE api_keys SET last_used_at = $1 WHERE id = $2 AND (last_used_at IS NULL OR last_used_at < $1 - interval '1 minute')`
+)
+
+func NewAPIKeyRepository(opts *OptsAPIKeyRepository) repository.APIKeyRepository {
+	return &APIKeyRepository{db: opts.DB}
+}
+
+func (a *APIKeyRepository) GetAll(ctx context.Context) (*[]model.APIKey, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "APIKeyRepository.GetAll")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		apiKeys []model.APIKey
+		err     error
+	)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &apiKeys, selectAllAPIKey)
+	} else {
+		err = a.db.GetMaster().SelectContext(ctx, &apiKeys, selectAllAPIKey)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[APIKeyRepository.GetAll] Failed to get all api key")
+		return nil, err
+	}
+
+	return &apiKeys, nil
+}
+
+func (a *APIKeyRepository) GetById(ctx context.Context, id *uuid.UUID) (*model.APIKey, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "APIKeyRepository.GetById")
+	defer span.End()
+
+	apiKey, err := a.getOne(ctx, " AND id = $1", id)
+	if err != nil && !errors.Is(err, ErrNoResult) {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[APIKeyRepository.GetById] Failed to get api key by id")
+	}
+	return apiKey, err
+}
+
+func (a *APIKeyRepository) GetByPrefix(ctx context.Context, prefix string) (*model.APIKey, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "APIKeyRepository.GetByPrefix")
+	defer span.End()
+
+	apiKey, err := a.getOne(ctx, " AND prefix = $1", prefix)
+	if err != nil && !errors.Is(err, ErrNoResult) {
+		log.WithFields(log.Fields{
+			"error":  err,
+			"prefix": prefix,
+		}).ErrorWithCtx(ctx, "[APIKeyRepository.GetByPrefix] Failed to get api key by prefix")
+	}
+	return apiKey, err
+}
+
+func (a *APIKeyRepository) Insert(ctx context.Context, apiKey *model.APIKey) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "APIKeyRepository.Insert")
+	defer span.End()
+
+	var (
+		err error
+	)
+
+	args := []any{apiKey.ID, apiKey.Name, apiKey.Prefix, apiKey.SecretHash, apiKey.Scopes, apiKey.CreatedBy,
+		apiKey.ExpiresAt, apiKey.LastUsedAt, apiKey.RevokedAt, apiKey.CreatedAt, apiKey.UpdatedAt}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, insertAPIKey, args...)
+	} else {
+		_, err = a.db.GetMaster().ExecContext(ctx, insertAPIKey, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"name":  apiKey.Name,
+		}).ErrorWithCtx(ctx, "[APIKeyRepository.Insert] Failed to Insert")
+		return err
+	}
+
+	return nil
+}
+
+// Revoke stops the key from being used, ErrNoResult is returned when it does not exist or is already revoked
+func (a *APIKeyRepository) Revoke(ctx context.Context, id *uuid.UUID, at time.Time) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "APIKeyRepository.Revoke")
+	defer span.End()
+
+	var (
+		result sql2.Result
+		err    error
+	)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		result, err = sqlTrx.ExecContext(ctx, revokeAPIKey, at, id)
+	} else {
+		result, err = a.db.GetMaster().ExecContext(ctx, revokeAPIKey, at, id)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[APIKeyRepository.Revoke] Failed to revoke api key")
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNoResult
+	}
+
+	return nil
+}
+
+// Touch records that the key was used at the time
+func (a *APIKeyRepository) Touch(ctx context.Context, id *uuid.UUID, at time.Time) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "APIKeyRepository.Touch")
+	defer span.End()
+
+	var err error
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, touchAPIKey, at, id)
+	} else {
+		_, err = a.db.GetMaster().ExecContext(ctx, touchAPIKey, at, id)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[APIKeyRepository.Touch] Failed to record api key use")
+		return err
+	}
+
+	return nil
+}
+
+func (a *APIKeyRepository) getOne(ctx context.Context, whereQuery string, args ...any) (*model.APIKey, error) {
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		apiKey model.APIKey
+		err    error
+	)
+
+	query := fmt.Sprintf(selectAPIKey, whereQuery)
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &apiKey, query, args...)
+	} else {
+		err = a.db.GetMaster().GetContext(ctx, &apiKey, query, args...)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return nil, ErrNoResult
+		}
+		return nil, err
+	}
+
+	return &apiKey, nil
+}
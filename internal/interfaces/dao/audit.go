@@ -0,0 +1,153 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/lib/database/sql"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/lib/txmanager/utils"
+	"time"
+)
+
+type AuditRepository struct {
+	db *sql.Store
+}
+
+type OptsAuditRepository struct {
+	DB *sql.Store
+}
+
+const (
+	auditColumns = `id, entity, entity_id, action, actor, request_id, diff, created_at`
+
+	insertAudit = `INSERT INTO audit_logs (` + auditColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	selectAudit = `SELECT ` + auditColumns + ` FROM audit_logs WHERE TRUE %s`
+	countAudit  = `SELECT COUNT(*) FROM audit_logs WHERE TRUE %s`
+)
+
+var auditSortColumns = map[string]sortColumn{
+	"created_at": {name: "created_at", cast: "timestamptz"},
+}
+
+func NewAuditRepository(opts *OptsAuditRepository) repository.AuditRepository {
+	return &AuditRepository{db: opts.DB}
+}
+
+func (a *AuditRepository) Insert(ctx context.Context, auditLog *model.AuditLog) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "AuditRepository.Insert")
+	defer span.End()
+
+	var (
+		err error
+	)
+
+	args := []any{auditLog.ID, auditLog.Entity, auditLog.EntityID, auditLog.Action, auditLog.Actor,
+		auditLog.RequestID, auditLog.Diff, auditLog.CreatedAt}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, insertAudit, args...)
+	} else {
+		_, err = a.db.GetMaster().ExecContext(ctx, insertAudit, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":     err,
+			"entity":    auditLog.Entity,
+			"entity_id": auditLog.EntityID,
+		}).ErrorWithCtx(ctx, "[AuditRepository.Insert] Failed to Insert")
+		return err
+	}
+
+	return nil
+}
+
+// Get lists the audit logs matching the filter, From and To bound created_at inclusively
+func (a *AuditRepository) Get(ctx context.Context, filter *model.AuditFilter, page *model.Page) (*[]model.AuditLog, *model.PageInfo, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "AuditRepository.Get")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		args      []any
+		auditLogs []model.AuditLog
+		pageInfo  model.PageInfo
+		err       error
+	)
+
+	whereQuery := ""
+	if filter.Entity != "" {
+		args = append(args, filter.Entity)
+		whereQuery += fmt.Sprintf(" AND entity = $%d", len(args))
+	}
+	if filter.EntityID != nil {
+		args = append(args, filter.EntityID)
+		whereQuery += fmt.Sprintf(" AND entity_id = $%d", len(args))
+	}
+	if filter.Actor != "" {
+		args = append(args, filter.Actor)
+		whereQuery += fmt.Sprintf(" AND actor = $%d", len(args))
+	}
+	if filter.From != nil {
+		args = append(args, filter.From)
+		whereQuery += fmt.Sprintf(" AND created_at >= $%d", len(args))
+	}
+	if filter.To != nil {
+		args = append(args, filter.To)
+		whereQuery += fmt.Sprintf(" AND created_at <= $%d", len(args))
+	}
+
+	pageWhere, pageArgs := keysetQuery(whereQuery, args, page, auditSortColumns)
+	query := fmt.Sprintf(selectAudit, pageWhere)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &auditLogs, query, pageArgs...)
+	} else {
+		err = a.db.GetMaster().SelectContext(ctx, &auditLogs, query, pageArgs...)
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":  err,
+			"filter": filter,
+		}).ErrorWithCtx(ctx, "[AuditRepository.Get] Failed to get audit logs")
+		return nil, nil, err
+	}
+
+	if len(auditLogs) > page.Limit {
+		auditLogs = auditLogs[:page.Limit]
+		last := auditLogs[len(auditLogs)-1]
+
+		var values []string
+		for range page.Sort {
+			// created_at is the only sort field
+			values = append(values, last.CreatedAt.Format(time.RFC3339Nano))
+		}
+		pageInfo.Next = &model.PageCursor{Values: append(values, last.ID.String())}
+	}
+
+	if page.WithTotal {
+		var total int64
+		query = fmt.Sprintf(countAudit, whereQuery)
+
+		if sqlTrx != nil {
+			err = sqlTrx.GetContext(ctx, &total, query, args...)
+		} else {
+			err = a.db.GetMaster().GetContext(ctx, &total, query, args...)
+		}
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error":  err,
+				"filter": filter,
+			}).ErrorWithCtx(ctx, "[AuditRepository.Get] Failed to count audit logs")
+			return nil, nil, err
+		}
+		pageInfo.Total = &total
+	}
+
+	return &auditLogs, &pageInfo, nil
+}
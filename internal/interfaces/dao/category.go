@@ -0,0 +1,199 @@
+package dao
+
+import (
+	"context"
+	sql2 "database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/lib/database/sql"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/lib/txmanager/utils"
+	"time"
+)
+
+type CategoryRepository struct {
+	db *sql.Store
+}
+
+type OptsCategoryRepository struct {
+	DB *sql.Store
+}
+
+const (
+	insertCategory    = `INSERT INTO categories (id, parent_id, name, created_at, updated_at) VALUES ($1, $2, $3, $4, $5)`
+	selectCategory    = `SELECT id, parent_id, name, created_at, updated_at FROM categories WHERE TRUE %s`
+	selectAllCategory = `SELECT id, parent_id, name, created_at, updated_at FROM categories ORDER BY name`
+	updateCategory    = `UPDATE categories SET %s WHERE TRUE %s`
+	deleteCategory    = `DELETE FROM categories WHERE TRUE %s`
+)
+
+func NewCategoryRepository(opts *OptsCategoryRepository) repository.CategoryRepository {
+	return &CategoryRepository{db: opts.DB}
+}
+
+func (c *CategoryRepository) Insert(ctx context.Context, category *model.Category) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "CategoryRepository.Insert")
+	defer span.End()
+
+	var (
+		err error
+	)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, insertCategory, category.ID, category.ParentID, category.Name,
+			category.CreatedAt, category.UpdatedAt)
+	} else {
+		_, err = c.db.GetMaster().ExecContext(ctx, insertCategory, category.ID, category.ParentID, category.Name,
+			category.CreatedAt, category.UpdatedAt)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":    err,
+			"category": *category,
+		}).ErrorWithCtx(ctx, "[CategoryRepository.Insert] Failed to Insert")
+		return err
+	}
+
+	return nil
+}
+
+func (c *CategoryRepository) Update(ctx context.Context, category *model.Category) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "CategoryRepository.Update")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		args []any
+		err  error
+	)
+
+	category.UpdatedAt = time.Now()
+	setQuery := "parent_id = $1, name = $2, updated_at = $3"
+	whereQuery := " AND id = $4"
+	args = append(args, category.ParentID, category.Name, category.UpdatedAt, category.ID)
+
+	query := fmt.Sprintf(updateCategory, setQuery, whereQuery)
+
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = c.db.GetMaster().ExecContext(ctx, query, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":    err,
+			"category": category,
+		}).ErrorWithCtx(ctx, "[CategoryRepository.Update] Failed to update category")
+		return err
+	}
+	return nil
+}
+
+func (c *CategoryRepository) GetAll(ctx context.Context) (*[]model.Category, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "CategoryRepository.GetAll")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		categories []model.Category
+		err        error
+	)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &categories, selectAllCategory)
+	} else {
+		err = c.db.GetMaster().SelectContext(ctx, &categories, selectAllCategory)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[CategoryRepository.GetAll] Failed to get all category")
+		return nil, err
+	}
+
+	return &categories, nil
+}
+
+func (c *CategoryRepository) GetById(ctx context.Context, id *uuid.UUID) (*model.Category, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "CategoryRepository.GetById")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		args     []any
+		category model.Category
+		err      error
+	)
+
+	whereQuery := " AND id = $1"
+	args = append(args, id)
+
+	query := fmt.Sprintf(selectCategory, whereQuery)
+
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &category, query, args...)
+	} else {
+		err = c.db.GetMaster().GetContext(ctx, &category, query, args...)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return nil, ErrNoResult
+		}
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[CategoryRepository.GetById] Failed to get category by id")
+		return nil, err
+	}
+
+	return &category, nil
+}
+
+// Delete removes a leaf category, ErrReferenced is returned while it still has children or wardrobes
+func (c *CategoryRepository) Delete(ctx context.Context, id *uuid.UUID) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "CategoryRepository.Delete")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		args []any
+		err  error
+	)
+
+	whereQuery := " AND id = $1"
+	args = append(args, id)
+
+	query := fmt.Sprintf(deleteCategory, whereQuery)
+
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = c.db.GetMaster().ExecContext(ctx, query, args...)
+	}
+
+	if err != nil {
+		if pqErr, valid := err.(*pq.Error); valid && pqErr.Code == "23503" {
+			return ErrReferenced
+		}
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[CategoryRepository.Delete] Failed to delete category")
+		return err
+	}
+	return nil
+}
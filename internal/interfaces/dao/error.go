@@ -7,4 +7,6 @@ var (
 	ErrNilParam         = errors.New("param cannot be nil")
 	ErrDuplicate        = errors.New("duplicate entry")
 	ErrNoResult         = errors.New("no result")
+	ErrNoTransaction    = errors.New("must be called inside a transaction")
+	ErrReferenced       = errors.New("entry is still referenced")
 )
@@ -0,0 +1,200 @@
+package dao
+
+import (
+	"context"
+	sql2 "database/sql"
+	"errors"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/lib/database/sql"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/lib/txmanager/utils"
+	"time"
+)
+
+type IdempotencyKeyRepository struct {
+	db *sql.Store
+}
+
+type OptsIdempotencyKeyRepository struct {
+	DB *sql.Store
+}
+
+const (
+	idempotencyKeyColumns = `key, fingerprint, status_code, content_type, body, locked_until, expires_at, created_at, completed_at`
+
+	// claimIdempotencyKey takes the key when it is new or expired, or when the same request holds it past its lock
+	// without having answered. Nothing is returned when someone else holds the key.
+	claimIdempotencyKey = `INSERT INTO idempotency_keys (key, fingerprint, locked_until, expires_at, created_at) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (key) DO UPDATE SET fingerprint = EXCLUDED.fingerprint, status_code = NULL, content_type = '', body = NULL,
+			locked_until = EXCLUDED.locked_until, expires_at = EXCLUDED.expires_at, created_at = EXCLUDED.created_at, completed_at = NULL
+		WHERE idempotency_keys.expires_at <= EXCLUDED.created_at
+			OR (idempotency_keys.status_code IS NULL AND idempotency_keys.locked_until <= EXCLUDED.created_at AND idempotency_keys.fingerprint = EXCLUDED.fingerprint)
+		RETURNING key`
+	selectIdempotencyKey        = `SELECT ` + idempotencyKeyColumns + ` FROM idempotency_keys WHERE key = $1`
+	completeIdempotencyKey      = `UPDATE idempotency_keys SET status_code = $1, content_type = $2, body = $3, completed_at = $4 WHERE key = $5 AND status_code IS NULL`
+	releaseIdempotencyKey       = `DELETE FROM idempotency_keys WHERE key = $1 AND status_code IS NULL`
+	deleteExpiredIdempotencyKey = `DELETE FROM idempotency_keys WHERE expires_at <= $1`
+)
+
+func NewIdempotencyKeyRepository(opts *OptsIdempotencyKeyRepository) repository.IdempotencyKeyRepository {
+	return &IdempotencyKeyRepository{db: opts.DB}
+}
+
+// Claim takes the key for the request of its fingerprint, false is returned when the key is held by another request
+// or already answered
+func (i *IdempotencyKeyRepository) Claim(ctx context.Context, key *model.IdempotencyKey) (bool, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "IdempotencyKeyRepository.Claim")
+	defer span.End()
+
+	var (
+		claimed string
+		err     error
+	)
+
+	args := []any{key.Key, key.Fingerprint, key.LockedUntil, key.ExpiresAt, key.CreatedAt}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &claimed, claimIdempotencyKey, args...)
+	} else {
+		err = i.db.GetMaster().GetContext(ctx, &claimed, claimIdempotencyKey, args...)
+	}
+
+	if errors.Is(err, sql2.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"key":   key.Key,
+		}).ErrorWithCtx(ctx, "[IdempotencyKeyRepository.Claim] Failed to claim idempotency key")
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (i *IdempotencyKeyRepository) GetByKey(ctx context.Context, key string) (*model.IdempotencyKey, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "IdempotencyKeyRepository.GetByKey")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		idempotencyKey model.IdempotencyKey
+		err            error
+	)
+
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &idempotencyKey, selectIdempotencyKey, key)
+	} else {
+		err = i.db.GetMaster().GetContext(ctx, &idempotencyKey, selectIdempotencyKey, key)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return nil, ErrNoResult
+		}
+		log.WithFields(log.Fields{
+			"error": err,
+			"key":   key,
+		}).ErrorWithCtx(ctx, "[IdempotencyKeyRepository.GetByKey] Failed to get idempotency key")
+		return nil, err
+	}
+
+	return &idempotencyKey, nil
+}
+
+// Complete stores the response of the request holding the key, ErrNoResult is returned when it no longer holds it
+func (i *IdempotencyKeyRepository) Complete(ctx context.Context, key *model.IdempotencyKey) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "IdempotencyKeyRepository.Complete")
+	defer span.End()
+
+	var (
+		result sql2.Result
+		err    error
+	)
+
+	args := []any{key.StatusCode, key.ContentType, key.Body, key.CompletedAt, key.Key}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		result, err = sqlTrx.ExecContext(ctx, completeIdempotencyKey, args...)
+	} else {
+		result, err = i.db.GetMaster().ExecContext(ctx, completeIdempotencyKey, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"key":   key.Key,
+		}).ErrorWithCtx(ctx, "[IdempotencyKeyRepository.Complete] Failed to complete idempotency key")
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNoResult
+	}
+
+	return nil
+}
+
+// Release forgets a key that was not answered, so the request can be sent again
+func (i *IdempotencyKeyRepository) Release(ctx context.Context, key string) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "IdempotencyKeyRepository.Release")
+	defer span.End()
+
+	var err error
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, releaseIdempotencyKey, key)
+	} else {
+		_, err = i.db.GetMaster().ExecContext(ctx, releaseIdempotencyKey, key)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"key":   key,
+		}).ErrorWithCtx(ctx, "[IdempotencyKeyRepository.Release] Failed to release idempotency key")
+		return err
+	}
+
+	return nil
+}
+
+// DeleteExpired removes the keys expired at the time, answered or not
+func (i *IdempotencyKeyRepository) DeleteExpired(ctx context.Context, at time.Time) (int64, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "IdempotencyKeyRepository.DeleteExpired")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		result sql2.Result
+		err    error
+	)
+
+	if sqlTrx != nil {
+		result, err = sqlTrx.ExecContext(ctx, deleteExpiredIdempotencyKey, at)
+	} else {
+		result, err = i.db.GetMaster().ExecContext(ctx, deleteExpiredIdempotencyKey, at)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"at":    at,
+		}).ErrorWithCtx(ctx, "[IdempotencyKeyRepository.DeleteExpired] Failed to delete expired idempotency key")
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,133 @@
+package dao
+
+import (
+	"context"
+	sql2 "database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/lib/database/sql"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/lib/txmanager/utils"
+	"time"
+)
+
+type ImportJobRepository struct {
+	db *sql.Store
+}
+
+type OptsImportJobRepository struct {
+	DB *sql.Store
+}
+
+const (
+	importJobColumns = `id, file_name, format, dry_run, status, total_rows, created, updated, failed, report, error, created_by, finished_at, created_at, updated_at`
+
+	insertImportJob = `INSERT INTO import_jobs (` + importJobColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`
+	selectImportJob = `SELECT ` + importJobColumns + ` FROM import_jobs WHERE TRUE %s`
+	updateImportJob = `UPDATE import_jobs SET status = $1, total_rows = $2, created = $3, updated = $4, failed = $5, report = $6, error = $7, finished_at = $8, updated_at = $9 WHERE id = $10`
+)
+
+func NewImportJobRepository(opts *OptsImportJobRepository) repository.ImportJobRepository {
+	return &ImportJobRepository{db: opts.DB}
+}
+
+func (i *ImportJobRepository) Insert(ctx context.Context, job *model.ImportJob) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ImportJobRepository.Insert")
+	defer span.End()
+
+	var (
+		err error
+	)
+
+	args := []any{job.ID, job.FileName, job.Format, job.DryRun, job.Status, job.TotalRows, job.Created, job.Updated,
+		job.Failed, job.Report, job.Error, job.CreatedBy, job.FinishedAt, job.CreatedAt, job.UpdatedAt}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, insertImportJob, args...)
+	} else {
+		_, err = i.db.GetMaster().ExecContext(ctx, insertImportJob, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    job.ID,
+		}).ErrorWithCtx(ctx, "[ImportJobRepository.Insert] Failed to Insert")
+		return err
+	}
+
+	return nil
+}
+
+func (i *ImportJobRepository) GetById(ctx context.Context, id *uuid.UUID) (*model.ImportJob, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ImportJobRepository.GetById")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		args []any
+		job  model.ImportJob
+		err  error
+	)
+
+	whereQuery := " AND id = $1"
+	args = append(args, id)
+
+	query := fmt.Sprintf(selectImportJob, whereQuery)
+
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &job, query, args...)
+	} else {
+		err = i.db.GetMaster().GetContext(ctx, &job, query, args...)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return nil, ErrNoResult
+		}
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[ImportJobRepository.GetById] Failed to get import job by id")
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+func (i *ImportJobRepository) Update(ctx context.Context, job *model.ImportJob) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ImportJobRepository.Update")
+	defer span.End()
+
+	var (
+		err error
+	)
+
+	job.UpdatedAt = time.Now()
+	args := []any{job.Status, job.TotalRows, job.Created, job.Updated, job.Failed, job.Report, job.Error,
+		job.FinishedAt, job.UpdatedAt, job.ID}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, updateImportJob, args...)
+	} else {
+		_, err = i.db.GetMaster().ExecContext(ctx, updateImportJob, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":  err,
+			"id":     job.ID,
+			"status": job.Status,
+		}).ErrorWithCtx(ctx, "[ImportJobRepository.Update] Failed to update import job")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,233 @@
+package dao
+
+import (
+	"context"
+	sql2 "database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/lib/database/sql"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/lib/txmanager/utils"
+	"time"
+)
+
+type LocationRepository struct {
+	db *sql.Store
+}
+
+type OptsLocationRepository struct {
+	DB *sql.Store
+}
+
+const (
+	locationColumns = `id, code, name, kind, is_default, created_at, updated_at`
+
+	insertLocation    = `INSERT INTO locations (` + locationColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	selectLocation    = `SELECT ` + locationColumns + ` FROM locations WHERE TRUE %s`
+	selectAllLocation = `SELECT ` + locationColumns + ` FROM locations ORDER BY code`
+	updateLocation    = `UPDATE locations SET code = $1, name = $2, kind = $3, updated_at = $4 WHERE id = $5`
+	// deleteLocation keeps a location still holding stock, the empty stock rows go with it
+	deleteLocation = `DELETE FROM locations WHERE id = $1 AND NOT EXISTS (SELECT 1 FROM wardrobe_stocks WHERE location_id = $1 AND quantity > 0)`
+)
+
+func NewLocationRepository(opts *OptsLocationRepository) repository.LocationRepository {
+	return &LocationRepository{db: opts.DB}
+}
+
+func (l *LocationRepository) GetAll(ctx context.Context) (*[]model.Location, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "LocationRepository.GetAll")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		locations []model.Location
+		err       error
+	)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &locations, selectAllLocation)
+	} else {
+		err = l.db.GetMaster().SelectContext(ctx, &locations, selectAllLocation)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[LocationRepository.GetAll] Failed to get all location")
+		return nil, err
+	}
+
+	return &locations, nil
+}
+
+func (l *LocationRepository) GetById(ctx context.Context, id *uuid.UUID) (*model.Location, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "LocationRepository.GetById")
+	defer span.End()
+
+	location, err := l.getOne(ctx, " AND id = $1", id)
+	if err != nil && !errors.Is(err, ErrNoResult) {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[LocationRepository.GetById] Failed to get location by id")
+	}
+	return location, err
+}
+
+func (l *LocationRepository) GetDefault(ctx context.Context) (*model.Location, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "LocationRepository.GetDefault")
+	defer span.End()
+
+	location, err := l.getOne(ctx, " AND is_default")
+	if err != nil && !errors.Is(err, ErrNoResult) {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[LocationRepository.GetDefault] Failed to get default location")
+	}
+	return location, err
+}
+
+// Insert adds the location, ErrDuplicate is returned when the code is taken
+func (l *LocationRepository) Insert(ctx context.Context, location *model.Location) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "LocationRepository.Insert")
+	defer span.End()
+
+	var (
+		err error
+	)
+
+	args := []any{location.ID, location.Code, location.Name, location.Kind, location.IsDefault, location.CreatedAt, location.UpdatedAt}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, insertLocation, args...)
+	} else {
+		_, err = l.db.GetMaster().ExecContext(ctx, insertLocation, args...)
+	}
+
+	if err != nil {
+		if pqErr, valid := err.(*pq.Error); valid && pqErr.Code == "23505" {
+			return ErrDuplicate
+		}
+		log.WithFields(log.Fields{
+			"error": err,
+			"code":  location.Code,
+		}).ErrorWithCtx(ctx, "[LocationRepository.Insert] Failed to Insert")
+		return err
+	}
+
+	return nil
+}
+
+// Update changes the code, name and kind of the location, ErrDuplicate is returned when the code is taken
+func (l *LocationRepository) Update(ctx context.Context, location *model.Location) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "LocationRepository.Update")
+	defer span.End()
+
+	var (
+		result sql2.Result
+		err    error
+	)
+
+	location.UpdatedAt = time.Now()
+	args := []any{location.Code, location.Name, location.Kind, location.UpdatedAt, location.ID}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		result, err = sqlTrx.ExecContext(ctx, updateLocation, args...)
+	} else {
+		result, err = l.db.GetMaster().ExecContext(ctx, updateLocation, args...)
+	}
+
+	if err != nil {
+		if pqErr, valid := err.(*pq.Error); valid && pqErr.Code == "23505" {
+			return ErrDuplicate
+		}
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    location.ID,
+		}).ErrorWithCtx(ctx, "[LocationRepository.Update] Failed to update location")
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNoResult
+	}
+
+	return nil
+}
+
+// Delete removes an empty location, ErrReferenced is returned while it holds stock or has transfers
+func (l *LocationRepository) Delete(ctx context.Context, id *uuid.UUID) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "LocationRepository.Delete")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		result sql2.Result
+		err    error
+	)
+
+	if sqlTrx != nil {
+		result, err = sqlTrx.ExecContext(ctx, deleteLocation, id)
+	} else {
+		result, err = l.db.GetMaster().ExecContext(ctx, deleteLocation, id)
+	}
+
+	if err != nil {
+		if pqErr, valid := err.(*pq.Error); valid && pqErr.Code == "23503" {
+			return ErrReferenced
+		}
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[LocationRepository.Delete] Failed to delete location")
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrReferenced
+	}
+
+	return nil
+}
+
+func (l *LocationRepository) getOne(ctx context.Context, whereQuery string, args ...any) (*model.Location, error) {
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		location model.Location
+		err      error
+	)
+
+	query := fmt.Sprintf(selectLocation, whereQuery)
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &location, query, args...)
+	} else {
+		err = l.db.GetMaster().GetContext(ctx, &location, query, args...)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return nil, ErrNoResult
+		}
+		return nil, err
+	}
+
+	return &location, nil
+}
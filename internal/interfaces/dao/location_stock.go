@@ -0,0 +1,115 @@
+package dao
+
+import (
+	"context"
+	sql2 "database/sql"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/lib/database/sql"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/lib/txmanager/utils"
+	"time"
+)
+
+type LocationStockRepository struct {
+	db *sql.Store
+}
+
+type OptsLocationStockRepository struct {
+	DB *sql.Store
+}
+
+const (
+	// selectLocationStock lists every location with the stock of the wardrobe there, zero where it has none
+	selectLocationStock = `SELECT $1::uuid AS wardrobe_id, l.id AS location_id, l.code AS location_code, l.name AS location_name,
+		l.is_default, COALESCE(s.quantity, 0) AS quantity, COALESCE(s.updated_at, l.updated_at) AS updated_at
+		FROM locations l LEFT JOIN wardrobe_stocks s ON s.location_id = l.id AND s.wardrobe_id = $1
+		ORDER BY l.is_default DESC, l.code`
+	addLocationStock = `INSERT INTO wardrobe_stocks (wardrobe_id, location_id, quantity, updated_at) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (wardrobe_id, location_id) DO UPDATE SET quantity = wardrobe_stocks.quantity + EXCLUDED.quantity, updated_at = EXCLUDED.updated_at`
+	subLocationStock = `UPDATE wardrobe_stocks SET quantity = quantity - $3, updated_at = $4 WHERE wardrobe_id = $1 AND location_id = $2 AND quantity >= $3`
+)
+
+func NewLocationStockRepository(opts *OptsLocationStockRepository) repository.LocationStockRepository {
+	return &LocationStockRepository{db: opts.DB}
+}
+
+func (l *LocationStockRepository) GetByWardrobeId(ctx context.Context, wardrobeId *uuid.UUID) (*[]model.LocationStock, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "LocationStockRepository.GetByWardrobeId")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		stocks []model.LocationStock
+		err    error
+	)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &stocks, selectLocationStock, wardrobeId)
+	} else {
+		err = l.db.GetMaster().SelectContext(ctx, &stocks, selectLocationStock, wardrobeId)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"wardrobe_id": wardrobeId,
+		}).ErrorWithCtx(ctx, "[LocationStockRepository.GetByWardrobeId] Failed to get location stocks")
+		return nil, err
+	}
+
+	return &stocks, nil
+}
+
+// Adjust changes the stock of the wardrobe at the location by delta. ErrNoUpdateHappened is returned when the location
+// does not hold enough to take delta away, ErrNoResult when the wardrobe or the location does not exist.
+// The stock of the wardrobe itself must be adjusted by the same delta in the same transaction.
+func (l *LocationStockRepository) Adjust(ctx context.Context, wardrobeId, locationId *uuid.UUID, delta int) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "LocationStockRepository.Adjust")
+	defer span.End()
+
+	var (
+		result sql2.Result
+		err    error
+	)
+
+	query, amount := addLocationStock, delta
+	if delta < 0 {
+		query, amount = subLocationStock, -delta
+	}
+	args := []any{wardrobeId, locationId, amount, time.Now()}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		result, err = sqlTrx.ExecContext(ctx, query, args...)
+	} else {
+		result, err = l.db.GetMaster().ExecContext(ctx, query, args...)
+	}
+
+	if err != nil {
+		if pqErr, valid := err.(*pq.Error); valid && pqErr.Code == "23503" {
+			return ErrNoResult
+		}
+		log.WithFields(log.Fields{
+			"error":       err,
+			"wardrobe_id": wardrobeId,
+			"location_id": locationId,
+			"delta":       delta,
+		}).ErrorWithCtx(ctx, "[LocationStockRepository.Adjust] Failed to adjust location stock")
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNoUpdateHappened
+	}
+
+	return nil
+}
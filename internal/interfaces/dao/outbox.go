@@ -0,0 +1,134 @@
+package dao
+
+import (
+	"context"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/lib/database/sql"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/lib/txmanager/utils"
+	"time"
+)
+
+type OutboxRepository struct {
+	db *sql.Store
+}
+
+type OptsOutboxRepository struct {
+	DB *sql.Store
+}
+
+// outboxRelayLock is the advisory lock key held by the instance claiming events, claims made one at a time and the
+// lease of the claimed events are what keep the events of an aggregate in order
+const outboxRelayLock = 7_020_240_904
+
+const (
+	outboxColumns = `id, seq, aggregate_type, aggregate_id, event_type, payload, actor, request_id, status, attempts, last_error, next_attempt_at, published_at, created_at, updated_at`
+
+	insertOutbox = `INSERT INTO outbox_events (id, aggregate_type, aggregate_id, event_type, payload, actor, request_id, status, next_attempt_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+	lockOutboxRelay = `SELECT pg_try_advisory_xact_lock($1)`
+	// claimPendingOutbox leaves out the events queued behind an event of the same aggregate that waits for its retry or
+	// is claimed, the claimed events are pushed back by the lease so they are not picked up again while they are published
+	claimPendingOutbox = `WITH claimed AS (UPDATE outbox_events SET next_attempt_at = now() + $2 * interval '1 millisecond', updated_at = now()
+		WHERE id IN (SELECT id FROM outbox_events e WHERE status = 'pending' AND next_attempt_at <= now()
+			AND NOT EXISTS (SELECT 1 FROM outbox_events b WHERE b.aggregate_id = e.aggregate_id AND b.status = 'pending' AND b.seq < e.seq AND b.next_attempt_at > now())
+			ORDER BY seq LIMIT $1 FOR UPDATE)
+		RETURNING ` + outboxColumns + `)
+		SELECT ` + outboxColumns + ` FROM claimed ORDER BY seq`
+	updateOutbox = `UPDATE outbox_events SET status = $1, attempts = $2, last_error = $3, next_attempt_at = $4, published_at = $5, updated_at = $6 WHERE id = $7`
+)
+
+func NewOutboxRepository(opts *OptsOutboxRepository) repository.OutboxRepository {
+	return &OutboxRepository{db: opts.DB}
+}
+
+// Insert queues the event, it must be called inside the transaction of the change the event describes
+func (o *OutboxRepository) Insert(ctx context.Context, event *model.OutboxEvent) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "OutboxRepository.Insert")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx == nil {
+		return ErrNoTransaction
+	}
+
+	_, err := sqlTrx.ExecContext(ctx, insertOutbox, event.ID, event.AggregateType, event.AggregateID, event.EventType,
+		event.Payload, event.Actor, event.RequestID, event.Status, event.NextAttemptAt, event.CreatedAt, event.UpdatedAt)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":        err,
+			"aggregate_id": event.AggregateID,
+			"event_type":   event.EventType,
+		}).ErrorWithCtx(ctx, "[OutboxRepository.Insert] Failed to Insert")
+		return err
+	}
+
+	return nil
+}
+
+// ClaimPending takes the oldest events due for publishing in order and moves their next attempt a lease ahead, it must
+// be called inside a transaction. Nothing is returned while another instance is claiming.
+func (o *OutboxRepository) ClaimPending(ctx context.Context, limit int, lease time.Duration) (*[]model.OutboxEvent, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "OutboxRepository.ClaimPending")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx == nil {
+		return nil, ErrNoTransaction
+	}
+
+	events := []model.OutboxEvent{}
+
+	var locked bool
+	err := sqlTrx.GetContext(ctx, &locked, lockOutboxRelay, outboxRelayLock)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[OutboxRepository.ClaimPending] Failed to lock outbox relay")
+		return nil, err
+	}
+	if !locked {
+		return &events, nil
+	}
+
+	err = sqlTrx.SelectContext(ctx, &events, claimPendingOutbox, limit, lease.Milliseconds())
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[OutboxRepository.ClaimPending] Failed to claim outbox events")
+		return nil, err
+	}
+
+	return &events, nil
+}
+
+func (o *OutboxRepository) Update(ctx context.Context, event *model.OutboxEvent) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "OutboxRepository.Update")
+	defer span.End()
+
+	var (
+		err error
+	)
+
+	event.UpdatedAt = time.Now()
+	args := []any{event.Status, event.Attempts, event.LastError, event.NextAttemptAt, event.PublishedAt, event.UpdatedAt, event.ID}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, updateOutbox, args...)
+	} else {
+		_, err = o.db.GetMaster().ExecContext(ctx, updateOutbox, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    event.ID,
+		}).ErrorWithCtx(ctx, "[OutboxRepository.Update] Failed to update outbox event")
+		return err
+	}
+
+	return nil
+}
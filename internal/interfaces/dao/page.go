@@ -0,0 +1,69 @@
+package dao
+
+import (
+	"fmt"
+	"sagara_backend_test/internal/domain/model"
+	"strings"
+)
+
+// sortColumn is a column a list can be sorted by, cast is the type its cursor value is compared as
+type sortColumn struct {
+	name string
+	cast string
+}
+
+// keysetQuery appends to whereQuery the condition skipping every row up to the page cursor,
+// followed by the ordering and a limit fetching one extra row to tell whether a next page exists.
+// The id column always closes the ordering so rows sharing the same sort values are never skipped.
+func keysetQuery(whereQuery string, args []any, page *model.Page, columns map[string]sortColumn) (string, []any) {
+	var (
+		sortColumns []sortColumn
+		descending  []bool
+		orderBy     []string
+	)
+	for _, sort := range page.Sort {
+		column := columns[sort.Field]
+		sortColumns = append(sortColumns, column)
+		descending = append(descending, sort.Desc)
+	}
+	sortColumns = append(sortColumns, sortColumn{name: "id", cast: "uuid"})
+	descending = append(descending, false)
+
+	if page.After != nil {
+		// (a > $1) OR (a = $1 AND b < $2) OR (a = $1 AND b = $2 AND id > $3) ...
+		var (
+			placeholders []string
+			conditions   []string
+		)
+		for i, column := range sortColumns {
+			args = append(args, page.After.Values[i])
+			placeholders = append(placeholders, fmt.Sprintf("$%d::%s", len(args), column.cast))
+
+			var equals []string
+			for j := 0; j < i; j++ {
+				equals = append(equals, fmt.Sprintf("%s = %s", sortColumns[j].name, placeholders[j]))
+			}
+
+			operator := ">"
+			if descending[i] {
+				operator = "<"
+			}
+			equals = append(equals, fmt.Sprintf("%s %s %s", column.name, operator, placeholders[i]))
+			conditions = append(conditions, "("+strings.Join(equals, " AND ")+")")
+		}
+		whereQuery += " AND (" + strings.Join(conditions, " OR ") + ")"
+	}
+
+	for i, column := range sortColumns {
+		direction := "ASC"
+		if descending[i] {
+			direction = "DESC"
+		}
+		orderBy = append(orderBy, column.name+" "+direction)
+	}
+
+	args = append(args, page.Limit+1)
+	whereQuery += fmt.Sprintf(" ORDER BY %s LIMIT $%d", strings.Join(orderBy, ", "), len(args))
+
+	return whereQuery, args
+}
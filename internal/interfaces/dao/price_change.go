@@ -0,0 +1,94 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/lib/database/sql"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/lib/txmanager/utils"
+)
+
+type PriceChangeRepository struct {
+	db *sql.Store
+}
+
+type OptsPriceChangeRepository struct {
+	DB *sql.Store
+}
+
+const (
+	priceChangeColumns = `id, wardrobe_id, schedule_id, old_price, old_currency, new_price, new_currency, changed_by, reason, created_at`
+
+	insertPriceChange = `INSERT INTO price_changes (` + priceChangeColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+	selectPriceChange = `SELECT ` + priceChangeColumns + ` FROM price_changes WHERE TRUE %s`
+)
+
+func NewPriceChangeRepository(opts *OptsPriceChangeRepository) repository.PriceChangeRepository {
+	return &PriceChangeRepository{db: opts.DB}
+}
+
+func (p *PriceChangeRepository) Insert(ctx context.Context, change *model.PriceChange) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PriceChangeRepository.Insert")
+	defer span.End()
+
+	var (
+		err error
+	)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, insertPriceChange, change.ID, change.WardrobeID, change.ScheduleID, change.OldPrice,
+			change.OldCurrency, change.NewPrice, change.NewCurrency, change.ChangedBy, change.Reason, change.CreatedAt)
+	} else {
+		_, err = p.db.GetMaster().ExecContext(ctx, insertPriceChange, change.ID, change.WardrobeID, change.ScheduleID, change.OldPrice,
+			change.OldCurrency, change.NewPrice, change.NewCurrency, change.ChangedBy, change.Reason, change.CreatedAt)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":  err,
+			"change": *change,
+		}).ErrorWithCtx(ctx, "[PriceChangeRepository.Insert] Failed to Insert")
+		return err
+	}
+
+	return nil
+}
+
+func (p *PriceChangeRepository) GetByWardrobeId(ctx context.Context, wardrobeId *uuid.UUID) (*[]model.PriceChange, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PriceChangeRepository.GetByWardrobeId")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		args    []any
+		changes []model.PriceChange
+		err     error
+	)
+
+	whereQuery := " AND wardrobe_id = $1 ORDER BY created_at DESC"
+	args = append(args, wardrobeId)
+
+	query := fmt.Sprintf(selectPriceChange, whereQuery)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &changes, query, args...)
+	} else {
+		err = p.db.GetMaster().SelectContext(ctx, &changes, query, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"wardrobe_id": wardrobeId,
+		}).ErrorWithCtx(ctx, "[PriceChangeRepository.GetByWardrobeId] Failed to get price changes")
+		return nil, err
+	}
+
+	return &changes, nil
+}
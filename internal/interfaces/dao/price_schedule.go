@@ -0,0 +1,234 @@
+package dao
+
+import (
+	"context"
+	sql2 "database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/lib/database/sql"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/lib/txmanager/utils"
+	"time"
+)
+
+type PriceScheduleRepository struct {
+	db *sql.Store
+}
+
+type OptsPriceScheduleRepository struct {
+	DB *sql.Store
+}
+
+const (
+	priceScheduleColumns = `id, wardrobe_id, price, currency, effective_from, effective_until, status, reason, created_by, previous_price, previous_currency, created_at, updated_at`
+
+	insertPriceSchedule  = `INSERT INTO price_schedules (` + priceScheduleColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`
+	selectPriceSchedule  = `SELECT ` + priceScheduleColumns + ` FROM price_schedules WHERE TRUE %s`
+	liveWardrobeSchedule = ` AND wardrobe_id IN (SELECT id FROM wardrobe WHERE deleted_at IS NULL)`
+	updatePriceSchedule  = `UPDATE price_schedules SET status = $1, previous_price = $2, previous_currency = $3, updated_at = $4 WHERE id = $5 AND status = $6`
+)
+
+func NewPriceScheduleRepository(opts *OptsPriceScheduleRepository) repository.PriceScheduleRepository {
+	return &PriceScheduleRepository{db: opts.DB}
+}
+
+func (p *PriceScheduleRepository) Insert(ctx context.Context, schedule *model.PriceSchedule) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PriceScheduleRepository.Insert")
+	defer span.End()
+
+	var (
+		err error
+	)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, insertPriceSchedule, schedule.ID, schedule.WardrobeID, schedule.Price, schedule.Currency,
+			schedule.EffectiveFrom, schedule.EffectiveUntil, schedule.Status, schedule.Reason, schedule.CreatedBy,
+			schedule.PreviousPrice, schedule.PreviousCurrency, schedule.CreatedAt, schedule.UpdatedAt)
+	} else {
+		_, err = p.db.GetMaster().ExecContext(ctx, insertPriceSchedule, schedule.ID, schedule.WardrobeID, schedule.Price, schedule.Currency,
+			schedule.EffectiveFrom, schedule.EffectiveUntil, schedule.Status, schedule.Reason, schedule.CreatedBy,
+			schedule.PreviousPrice, schedule.PreviousCurrency, schedule.CreatedAt, schedule.UpdatedAt)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":    err,
+			"schedule": *schedule,
+		}).ErrorWithCtx(ctx, "[PriceScheduleRepository.Insert] Failed to Insert")
+		return err
+	}
+
+	return nil
+}
+
+func (p *PriceScheduleRepository) GetById(ctx context.Context, id *uuid.UUID) (*model.PriceSchedule, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PriceScheduleRepository.GetById")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		args     []any
+		schedule model.PriceSchedule
+		err      error
+	)
+
+	whereQuery := " AND id = $1"
+	args = append(args, id)
+
+	query := fmt.Sprintf(selectPriceSchedule, whereQuery)
+
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &schedule, query, args...)
+	} else {
+		err = p.db.GetMaster().GetContext(ctx, &schedule, query, args...)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return nil, ErrNoResult
+		}
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[PriceScheduleRepository.GetById] Failed to get price schedule by id")
+		return nil, err
+	}
+
+	return &schedule, nil
+}
+
+// GetByWardrobeId returns the schedules of the wardrobe by their start, only the given statuses when there are any
+func (p *PriceScheduleRepository) GetByWardrobeId(ctx context.Context, wardrobeId *uuid.UUID, statuses ...string) (*[]model.PriceSchedule, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PriceScheduleRepository.GetByWardrobeId")
+	defer span.End()
+
+	var (
+		args       []any
+		whereQuery string
+	)
+
+	args = append(args, wardrobeId)
+	whereQuery += " AND wardrobe_id = $1"
+
+	if len(statuses) > 0 {
+		args = append(args, pq.Array(statuses))
+		whereQuery += " AND status = ANY($2)"
+	}
+
+	schedules, err := p.selectSchedules(ctx, whereQuery+" ORDER BY effective_from", args)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"wardrobe_id": wardrobeId,
+		}).ErrorWithCtx(ctx, "[PriceScheduleRepository.GetByWardrobeId] Failed to get price schedules")
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+// GetStarting returns the pending schedules whose start has been reached, oldest first.
+// Schedules of wardrobes in the trash wait until the wardrobe is restored
+func (p *PriceScheduleRepository) GetStarting(ctx context.Context, now time.Time) (*[]model.PriceSchedule, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PriceScheduleRepository.GetStarting")
+	defer span.End()
+
+	whereQuery := " AND status = $1 AND effective_from <= $2" + liveWardrobeSchedule + " ORDER BY effective_from"
+	schedules, err := p.selectSchedules(ctx, whereQuery, []any{model.PriceSchedulePending, now})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[PriceScheduleRepository.GetStarting] Failed to get starting price schedules")
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+// GetEnding returns the active schedules whose end has been reached, oldest first
+func (p *PriceScheduleRepository) GetEnding(ctx context.Context, now time.Time) (*[]model.PriceSchedule, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PriceScheduleRepository.GetEnding")
+	defer span.End()
+
+	whereQuery := " AND status = $1 AND effective_until <= $2" + liveWardrobeSchedule + " ORDER BY effective_until"
+	schedules, err := p.selectSchedules(ctx, whereQuery, []any{model.PriceScheduleActive, now})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[PriceScheduleRepository.GetEnding] Failed to get ending price schedules")
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+// UpdateStatus saves the status and previous price of the schedule only while it still has fromStatus,
+// ErrNoUpdateHappened is returned when someone else moved it first
+func (p *PriceScheduleRepository) UpdateStatus(ctx context.Context, schedule *model.PriceSchedule, fromStatus string) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PriceScheduleRepository.UpdateStatus")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		result sql2.Result
+		err    error
+	)
+
+	schedule.UpdatedAt = time.Now()
+	args := []any{schedule.Status, schedule.PreviousPrice, schedule.PreviousCurrency, schedule.UpdatedAt, schedule.ID, fromStatus}
+
+	if sqlTrx != nil {
+		result, err = sqlTrx.ExecContext(ctx, updatePriceSchedule, args...)
+	} else {
+		result, err = p.db.GetMaster().ExecContext(ctx, updatePriceSchedule, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":    err,
+			"schedule": schedule,
+		}).ErrorWithCtx(ctx, "[PriceScheduleRepository.UpdateStatus] Failed to update price schedule status")
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNoUpdateHappened
+	}
+
+	return nil
+}
+
+func (p *PriceScheduleRepository) selectSchedules(ctx context.Context, whereQuery string, args []any) (*[]model.PriceSchedule, error) {
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		schedules []model.PriceSchedule
+		err       error
+	)
+
+	query := fmt.Sprintf(selectPriceSchedule, whereQuery)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &schedules, query, args...)
+	} else {
+		err = p.db.GetMaster().SelectContext(ctx, &schedules, query, args...)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &schedules, nil
+}
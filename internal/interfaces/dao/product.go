@@ -0,0 +1,233 @@
+package dao
+
+import (
+	"context"
+	sql2 "database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/lib/database/sql"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/lib/txmanager/utils"
+	"time"
+)
+
+type ProductRepository struct {
+	db *sql.Store
+}
+
+type OptsProductRepository struct {
+	DB *sql.Store
+}
+
+const (
+	insertProduct    = `INSERT INTO products (id, name, description, price, currency, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	selectProduct    = `SELECT id, name, description, price, currency, created_at, updated_at FROM products WHERE TRUE %s`
+	selectAllProduct = `SELECT id, name, description, price, currency, created_at, updated_at FROM products ORDER BY name`
+	updateProduct    = `UPDATE products SET %s WHERE TRUE %s`
+	deleteProduct    = `DELETE FROM products WHERE TRUE %s`
+)
+
+func NewProductRepository(opts *OptsProductRepository) repository.ProductRepository {
+	return &ProductRepository{db: opts.DB}
+}
+
+func (p *ProductRepository) Insert(ctx context.Context, product *model.Product) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ProductRepository.Insert")
+	defer span.End()
+
+	var (
+		err error
+	)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, insertProduct, product.ID, product.Name, product.Description,
+			product.Price, product.Currency, product.CreatedAt, product.UpdatedAt)
+	} else {
+		_, err = p.db.GetMaster().ExecContext(ctx, insertProduct, product.ID, product.Name, product.Description,
+			product.Price, product.Currency, product.CreatedAt, product.UpdatedAt)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":   err,
+			"product": *product,
+		}).ErrorWithCtx(ctx, "[ProductRepository.Insert] Failed to Insert")
+		return err
+	}
+
+	return nil
+}
+
+func (p *ProductRepository) Update(ctx context.Context, product *model.Product) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ProductRepository.Update")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		args []any
+		err  error
+	)
+
+	product.UpdatedAt = time.Now()
+	setQuery := "name = $1, description = $2, price = $3, currency = $4, updated_at = $5"
+	whereQuery := " AND id = $6"
+	args = append(args, product.Name, product.Description, product.Price, product.Currency, product.UpdatedAt, product.ID)
+
+	query := fmt.Sprintf(updateProduct, setQuery, whereQuery)
+
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = p.db.GetMaster().ExecContext(ctx, query, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":   err,
+			"product": product,
+		}).ErrorWithCtx(ctx, "[ProductRepository.Update] Failed to update product")
+		return err
+	}
+	return nil
+}
+
+func (p *ProductRepository) GetAll(ctx context.Context) (*[]model.Product, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ProductRepository.GetAll")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		products []model.Product
+		err      error
+	)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &products, selectAllProduct)
+	} else {
+		err = p.db.GetMaster().SelectContext(ctx, &products, selectAllProduct)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[ProductRepository.GetAll] Failed to get all product")
+		return nil, err
+	}
+
+	return &products, nil
+}
+
+func (p *ProductRepository) GetById(ctx context.Context, id *uuid.UUID) (*model.Product, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ProductRepository.GetById")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		args    []any
+		product model.Product
+		err     error
+	)
+
+	whereQuery := " AND id = $1"
+	args = append(args, id)
+
+	query := fmt.Sprintf(selectProduct, whereQuery)
+
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &product, query, args...)
+	} else {
+		err = p.db.GetMaster().GetContext(ctx, &product, query, args...)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return nil, ErrNoResult
+		}
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[ProductRepository.GetById] Failed to get product by id")
+		return nil, err
+	}
+
+	return &product, nil
+}
+
+func (p *ProductRepository) GetByIds(ctx context.Context, ids []uuid.UUID) (*[]model.Product, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ProductRepository.GetByIds")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		args     []any
+		products []model.Product
+		err      error
+	)
+
+	whereQuery := " AND id = ANY($1)"
+	args = append(args, pq.Array(ids))
+
+	query := fmt.Sprintf(selectProduct, whereQuery)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &products, query, args...)
+	} else {
+		err = p.db.GetMaster().SelectContext(ctx, &products, query, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"ids":   ids,
+		}).ErrorWithCtx(ctx, "[ProductRepository.GetByIds] Failed to get products")
+		return nil, err
+	}
+
+	return &products, nil
+}
+
+// Delete removes a product without variants, ErrReferenced is returned while variants still point to it
+func (p *ProductRepository) Delete(ctx context.Context, id *uuid.UUID) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ProductRepository.Delete")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		args []any
+		err  error
+	)
+
+	whereQuery := " AND id = $1"
+	args = append(args, id)
+
+	query := fmt.Sprintf(deleteProduct, whereQuery)
+
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = p.db.GetMaster().ExecContext(ctx, query, args...)
+	}
+
+	if err != nil {
+		if pqErr, valid := err.(*pq.Error); valid && pqErr.Code == "23503" {
+			return ErrReferenced
+		}
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[ProductRepository.Delete] Failed to delete product")
+		return err
+	}
+	return nil
+}
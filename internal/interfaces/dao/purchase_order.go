@@ -0,0 +1,405 @@
+package dao
+
+import (
+	"context"
+	sql2 "database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/lib/database/sql"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/lib/txmanager/utils"
+	"strings"
+	"time"
+)
+
+type PurchaseOrderRepository struct {
+	db *sql.Store
+}
+
+type OptsPurchaseOrderRepository struct {
+	DB *sql.Store
+}
+
+const (
+	purchaseOrderColumns = `id, supplier, currency, location_id, status, note, created_by, sent_at, received_at, cancelled_at, created_at, updated_at`
+
+	purchaseOrderTotal = `(SELECT COALESCE(SUM(l.quantity * l.unit_cost), 0) FROM purchase_order_lines l WHERE l.purchase_order_id = purchase_orders.id)`
+
+	insertPurchaseOrder = `INSERT INTO purchase_orders (` + purchaseOrderColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+	selectPurchaseOrder = `SELECT ` + purchaseOrderColumns + `, ` + purchaseOrderTotal + ` AS total FROM purchase_orders WHERE TRUE %s`
+	countPurchaseOrder  = `SELECT COUNT(*) FROM purchase_orders WHERE TRUE %s`
+	lockPurchaseOrder   = `SELECT ` + purchaseOrderColumns + `, ` + purchaseOrderTotal + ` AS total FROM purchase_orders WHERE id = $1 FOR UPDATE`
+	updatePurchaseOrder = `UPDATE purchase_orders SET supplier = $1, currency = $2, location_id = $3, status = $4, note = $5, sent_at = $6,
+		received_at = $7, cancelled_at = $8, updated_at = $9 WHERE id = $10`
+
+	purchaseOrderLineColumns = `id, purchase_order_id, wardrobe_id, quantity, unit_cost, received_quantity, created_at, updated_at`
+
+	insertPurchaseOrderLine = `INSERT INTO purchase_order_lines (` + purchaseOrderLineColumns + `) VALUES %s`
+	selectPurchaseOrderLine = `SELECT ` + purchaseOrderLineColumns + ` FROM purchase_order_lines WHERE purchase_order_id = $1 ORDER BY created_at, id`
+	deletePurchaseOrderLine = `DELETE FROM purchase_order_lines WHERE purchase_order_id = $1`
+	// receivePurchaseOrderLine never receives more than the line ordered
+	receivePurchaseOrderLine = `UPDATE purchase_order_lines SET received_quantity = received_quantity + $1, updated_at = $2
+		WHERE id = $3 AND received_quantity + $1 <= quantity`
+)
+
+var purchaseOrderSortColumns = map[string]sortColumn{
+	"created_at": {name: "created_at", cast: "timestamptz"},
+}
+
+func NewPurchaseOrderRepository(opts *OptsPurchaseOrderRepository) repository.PurchaseOrderRepository {
+	return &PurchaseOrderRepository{db: opts.DB}
+}
+
+// Insert adds the order without its lines, ErrNoResult is returned when its location does not exist
+func (p *PurchaseOrderRepository) Insert(ctx context.Context, order *model.PurchaseOrder) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PurchaseOrderRepository.Insert")
+	defer span.End()
+
+	var (
+		err error
+	)
+
+	args := []any{order.ID, order.Supplier, order.Currency, order.LocationID, order.Status, order.Note, order.CreatedBy,
+		order.SentAt, order.ReceivedAt, order.CancelledAt, order.CreatedAt, order.UpdatedAt}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, insertPurchaseOrder, args...)
+	} else {
+		_, err = p.db.GetMaster().ExecContext(ctx, insertPurchaseOrder, args...)
+	}
+
+	if err != nil {
+		if pqErr, valid := err.(*pq.Error); valid && pqErr.Code == "23503" {
+			return ErrNoResult
+		}
+		log.WithFields(log.Fields{
+			"error":    err,
+			"supplier": order.Supplier,
+		}).ErrorWithCtx(ctx, "[PurchaseOrderRepository.Insert] Failed to Insert")
+		return err
+	}
+
+	return nil
+}
+
+func (p *PurchaseOrderRepository) GetById(ctx context.Context, id *uuid.UUID) (*model.PurchaseOrder, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PurchaseOrderRepository.GetById")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		order model.PurchaseOrder
+		err   error
+	)
+
+	query := fmt.Sprintf(selectPurchaseOrder, " AND id = $1")
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &order, query, id)
+	} else {
+		err = p.db.GetMaster().GetContext(ctx, &order, query, id)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return nil, ErrNoResult
+		}
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[PurchaseOrderRepository.GetById] Failed to get purchase order by id")
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+// LockById locks the order until the end of the transaction, it must be called inside a transaction
+func (p *PurchaseOrderRepository) LockById(ctx context.Context, id *uuid.UUID) (*model.PurchaseOrder, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PurchaseOrderRepository.LockById")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx == nil {
+		return nil, ErrNoTransaction
+	}
+
+	var order model.PurchaseOrder
+	err := sqlTrx.GetContext(ctx, &order, lockPurchaseOrder, id)
+	if err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return nil, ErrNoResult
+		}
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[PurchaseOrderRepository.LockById] Failed to lock purchase order")
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+func (p *PurchaseOrderRepository) Get(ctx context.Context, filter *model.PurchaseOrderFilter, page *model.Page) (*[]model.PurchaseOrder, *model.PageInfo, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PurchaseOrderRepository.Get")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		orders   []model.PurchaseOrder
+		pageInfo model.PageInfo
+		err      error
+	)
+
+	whereQuery := ""
+	var args []any
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		whereQuery += fmt.Sprintf(" AND status = $%d", len(args))
+	}
+	if filter.Supplier != "" {
+		args = append(args, filter.Supplier)
+		whereQuery += fmt.Sprintf(" AND supplier ILIKE '%%' || $%d || '%%'", len(args))
+	}
+
+	pageWhere, pageArgs := keysetQuery(whereQuery, args, page, purchaseOrderSortColumns)
+	query := fmt.Sprintf(selectPurchaseOrder, pageWhere)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &orders, query, pageArgs...)
+	} else {
+		err = p.db.GetMaster().SelectContext(ctx, &orders, query, pageArgs...)
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[PurchaseOrderRepository.Get] Failed to get purchase orders")
+		return nil, nil, err
+	}
+
+	if len(orders) > page.Limit {
+		orders = orders[:page.Limit]
+		last := orders[len(orders)-1]
+
+		var values []string
+		for range page.Sort {
+			// created_at is the only sort field
+			values = append(values, last.CreatedAt.Format(time.RFC3339Nano))
+		}
+		pageInfo.Next = &model.PageCursor{Values: append(values, last.ID.String())}
+	}
+
+	if page.WithTotal {
+		var total int64
+		query = fmt.Sprintf(countPurchaseOrder, whereQuery)
+
+		if sqlTrx != nil {
+			err = sqlTrx.GetContext(ctx, &total, query, args...)
+		} else {
+			err = p.db.GetMaster().GetContext(ctx, &total, query, args...)
+		}
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error": err,
+			}).ErrorWithCtx(ctx, "[PurchaseOrderRepository.Get] Failed to count purchase orders")
+			return nil, nil, err
+		}
+		pageInfo.Total = &total
+	}
+
+	return &orders, &pageInfo, nil
+}
+
+func (p *PurchaseOrderRepository) Update(ctx context.Context, order *model.PurchaseOrder) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PurchaseOrderRepository.Update")
+	defer span.End()
+
+	var (
+		result sql2.Result
+		err    error
+	)
+
+	order.UpdatedAt = time.Now()
+	args := []any{order.Supplier, order.Currency, order.LocationID, order.Status, order.Note, order.SentAt,
+		order.ReceivedAt, order.CancelledAt, order.UpdatedAt, order.ID}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		result, err = sqlTrx.ExecContext(ctx, updatePurchaseOrder, args...)
+	} else {
+		result, err = p.db.GetMaster().ExecContext(ctx, updatePurchaseOrder, args...)
+	}
+
+	if err != nil {
+		if pqErr, valid := err.(*pq.Error); valid && pqErr.Code == "23503" {
+			return ErrNoResult
+		}
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    order.ID,
+		}).ErrorWithCtx(ctx, "[PurchaseOrderRepository.Update] Failed to update purchase order")
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNoResult
+	}
+
+	return nil
+}
+
+func (p *PurchaseOrderRepository) GetLines(ctx context.Context, orderId *uuid.UUID) (*[]model.PurchaseOrderLine, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PurchaseOrderRepository.GetLines")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		lines []model.PurchaseOrderLine
+		err   error
+	)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &lines, selectPurchaseOrderLine, orderId)
+	} else {
+		err = p.db.GetMaster().SelectContext(ctx, &lines, selectPurchaseOrderLine, orderId)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":             err,
+			"purchase_order_id": orderId,
+		}).ErrorWithCtx(ctx, "[PurchaseOrderRepository.GetLines] Failed to get purchase order lines")
+		return nil, err
+	}
+
+	return &lines, nil
+}
+
+// InsertLines adds the lines in one statement, ErrNoResult is returned when one of their wardrobes does not exist
+// and ErrDuplicate when a wardrobe is on the order twice
+func (p *PurchaseOrderRepository) InsertLines(ctx context.Context, lines []model.PurchaseOrderLine) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PurchaseOrderRepository.InsertLines")
+	defer span.End()
+
+	if len(lines) == 0 {
+		return nil
+	}
+
+	var (
+		values []string
+		args   []any
+		err    error
+	)
+
+	for _, line := range lines {
+		var placeholders []string
+		for _, arg := range []any{line.ID, line.PurchaseOrderID, line.WardrobeID, line.Quantity, line.UnitCost,
+			line.ReceivedQuantity, line.CreatedAt, line.UpdatedAt} {
+			args = append(args, arg)
+			placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)))
+		}
+		values = append(values, "("+strings.Join(placeholders, ", ")+")")
+	}
+	query := fmt.Sprintf(insertPurchaseOrderLine, strings.Join(values, ", "))
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = p.db.GetMaster().ExecContext(ctx, query, args...)
+	}
+
+	if err != nil {
+		if pqErr, valid := err.(*pq.Error); valid {
+			switch pqErr.Code {
+			case "23503":
+				return ErrNoResult
+			case "23505":
+				return ErrDuplicate
+			}
+		}
+		log.WithFields(log.Fields{
+			"error":             err,
+			"purchase_order_id": lines[0].PurchaseOrderID,
+		}).ErrorWithCtx(ctx, "[PurchaseOrderRepository.InsertLines] Failed to insert purchase order lines")
+		return err
+	}
+
+	return nil
+}
+
+func (p *PurchaseOrderRepository) DeleteLines(ctx context.Context, orderId *uuid.UUID) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PurchaseOrderRepository.DeleteLines")
+	defer span.End()
+
+	var err error
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, deletePurchaseOrderLine, orderId)
+	} else {
+		_, err = p.db.GetMaster().ExecContext(ctx, deletePurchaseOrderLine, orderId)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":             err,
+			"purchase_order_id": orderId,
+		}).ErrorWithCtx(ctx, "[PurchaseOrderRepository.DeleteLines] Failed to delete purchase order lines")
+		return err
+	}
+
+	return nil
+}
+
+// ReceiveLine adds quantity to what was received of the line, ErrNoUpdateHappened is returned when it would go over
+// the quantity ordered
+func (p *PurchaseOrderRepository) ReceiveLine(ctx context.Context, lineId *uuid.UUID, quantity int) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PurchaseOrderRepository.ReceiveLine")
+	defer span.End()
+
+	var (
+		result sql2.Result
+		err    error
+	)
+
+	args := []any{quantity, time.Now(), lineId}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		result, err = sqlTrx.ExecContext(ctx, receivePurchaseOrderLine, args...)
+	} else {
+		result, err = p.db.GetMaster().ExecContext(ctx, receivePurchaseOrderLine, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":    err,
+			"line_id":  lineId,
+			"quantity": quantity,
+		}).ErrorWithCtx(ctx, "[PurchaseOrderRepository.ReceiveLine] Failed to receive purchase order line")
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNoUpdateHappened
+	}
+
+	return nil
+}
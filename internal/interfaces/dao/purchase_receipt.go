@@ -0,0 +1,115 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/lib/database/sql"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/lib/txmanager/utils"
+)
+
+type PurchaseReceiptRepository struct {
+	db *sql.Store
+}
+
+type OptsPurchaseReceiptRepository struct {
+	DB *sql.Store
+}
+
+const (
+	purchaseReceiptColumns = `id, purchase_order_id, location_id, note, received_by, created_at`
+
+	insertPurchaseReceipt     = `INSERT INTO purchase_receipts (` + purchaseReceiptColumns + `) VALUES ($1, $2, $3, $4, $5, $6)`
+	insertPurchaseReceiptLine = `INSERT INTO purchase_receipt_lines (receipt_id, line_id, quantity) VALUES ($1, $2, $3)`
+	selectPurchaseReceipt     = `SELECT ` + purchaseReceiptColumns + ` FROM purchase_receipts WHERE purchase_order_id = $1 ORDER BY created_at, id`
+	selectPurchaseReceiptLine = `SELECT l.receipt_id, l.line_id, l.quantity FROM purchase_receipt_lines l
+		JOIN purchase_receipts r ON r.id = l.receipt_id WHERE r.purchase_order_id = $1`
+)
+
+func NewPurchaseReceiptRepository(opts *OptsPurchaseReceiptRepository) repository.PurchaseReceiptRepository {
+	return &PurchaseReceiptRepository{db: opts.DB}
+}
+
+// Insert adds the receipt with its lines, it must be called inside a transaction
+func (p *PurchaseReceiptRepository) Insert(ctx context.Context, receipt *model.PurchaseReceipt) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PurchaseReceiptRepository.Insert")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx == nil {
+		return ErrNoTransaction
+	}
+
+	_, err := sqlTrx.ExecContext(ctx, insertPurchaseReceipt, receipt.ID, receipt.PurchaseOrderID, receipt.LocationID,
+		receipt.Note, receipt.ReceivedBy, receipt.CreatedAt)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":             err,
+			"purchase_order_id": receipt.PurchaseOrderID,
+		}).ErrorWithCtx(ctx, "[PurchaseReceiptRepository.Insert] Failed to Insert")
+		return err
+	}
+
+	for _, line := range receipt.Lines {
+		_, err = sqlTrx.ExecContext(ctx, insertPurchaseReceiptLine, receipt.ID, line.LineID, line.Quantity)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error":   err,
+				"id":      receipt.ID,
+				"line_id": line.LineID,
+			}).ErrorWithCtx(ctx, "[PurchaseReceiptRepository.Insert] Failed to insert receipt line")
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetByPurchaseOrder returns the receipts of the order with their lines, the oldest first
+func (p *PurchaseReceiptRepository) GetByPurchaseOrder(ctx context.Context, orderId *uuid.UUID) (*[]model.PurchaseReceipt, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PurchaseReceiptRepository.GetByPurchaseOrder")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		receipts []model.PurchaseReceipt
+		lines    []model.PurchaseReceiptLine
+		err      error
+	)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &receipts, selectPurchaseReceipt, orderId)
+	} else {
+		err = p.db.GetMaster().SelectContext(ctx, &receipts, selectPurchaseReceipt, orderId)
+	}
+	if err == nil {
+		if sqlTrx != nil {
+			err = sqlTrx.SelectContext(ctx, &lines, selectPurchaseReceiptLine, orderId)
+		} else {
+			err = p.db.GetMaster().SelectContext(ctx, &lines, selectPurchaseReceiptLine, orderId)
+		}
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":             err,
+			"purchase_order_id": orderId,
+		}).ErrorWithCtx(ctx, "[PurchaseReceiptRepository.GetByPurchaseOrder] Failed to get purchase receipts")
+		return nil, err
+	}
+
+	index := map[uuid.UUID]int{}
+	for i, receipt := range receipts {
+		index[receipt.ID] = i
+	}
+	for _, line := range lines {
+		receipt := &receipts[index[line.ReceiptID]]
+		receipt.Lines = append(receipt.Lines, line)
+	}
+
+	return &receipts, nil
+}
@@ -0,0 +1,164 @@
+package dao
+
+import (
+	"context"
+	sql2 "database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/lib/database/sql"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/lib/txmanager/utils"
+	"time"
+)
+
+type ReservationRepository struct {
+	db *sql.Store
+}
+
+type OptsReservationRepository struct {
+	DB *sql.Store
+}
+
+const (
+	insertReservation       = `INSERT INTO stock_reservations (id, wardrobe_id, quantity, status, expires_at, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	selectReservation       = `SELECT id, wardrobe_id, quantity, status, expires_at, created_at, updated_at FROM stock_reservations WHERE TRUE %s`
+	updateActiveReservation = `UPDATE stock_reservations SET status = $1, updated_at = $2 WHERE id = $3 AND status = 'active' AND expires_at > $2 RETURNING id, wardrobe_id, quantity, status, expires_at, created_at, updated_at`
+	// expireReservations bumps the version of the wardrobes whose reserved stock changed, so their ETag goes stale
+	expireReservations = `WITH expired AS (UPDATE stock_reservations SET status = 'expired', updated_at = $1 WHERE status = 'active' AND expires_at <= $1 RETURNING wardrobe_id),
+		bumped AS (UPDATE wardrobe SET version = version + 1 WHERE id IN (SELECT wardrobe_id FROM expired))
+		SELECT COUNT(*) FROM expired`
+)
+
+func NewReservationRepository(opts *OptsReservationRepository) repository.ReservationRepository {
+	return &ReservationRepository{db: opts.DB}
+}
+
+func (r *ReservationRepository) Insert(ctx context.Context, reservation *model.Reservation) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ReservationRepository.Insert")
+	defer span.End()
+
+	var (
+		err error
+	)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, insertReservation, reservation.ID, reservation.WardrobeID, reservation.Quantity,
+			reservation.Status, reservation.ExpiresAt, reservation.CreatedAt, reservation.UpdatedAt)
+	} else {
+		_, err = r.db.GetMaster().ExecContext(ctx, insertReservation, reservation.ID, reservation.WardrobeID, reservation.Quantity,
+			reservation.Status, reservation.ExpiresAt, reservation.CreatedAt, reservation.UpdatedAt)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"reservation": *reservation,
+		}).ErrorWithCtx(ctx, "[ReservationRepository.Insert] Failed to Insert")
+		return err
+	}
+
+	return nil
+}
+
+func (r *ReservationRepository) GetById(ctx context.Context, id *uuid.UUID) (*model.Reservation, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ReservationRepository.GetById")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		args        []any
+		reservation model.Reservation
+		err         error
+	)
+
+	whereQuery := " AND id = $1"
+	args = append(args, id)
+
+	query := fmt.Sprintf(selectReservation, whereQuery)
+
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &reservation, query, args...)
+	} else {
+		err = r.db.GetMaster().GetContext(ctx, &reservation, query, args...)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return nil, ErrNoResult
+		}
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[ReservationRepository.GetById] Failed to get reservation by id")
+		return nil, err
+	}
+
+	return &reservation, nil
+}
+
+// UpdateStatus moves an active and unexpired reservation to the given status,
+// ErrNoUpdateHappened is returned when the reservation is missing or already closed
+func (r *ReservationRepository) UpdateStatus(ctx context.Context, id *uuid.UUID, status string) (*model.Reservation, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ReservationRepository.UpdateStatus")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		reservation model.Reservation
+		err         error
+	)
+
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &reservation, updateActiveReservation, status, time.Now(), id)
+	} else {
+		err = r.db.GetMaster().GetContext(ctx, &reservation, updateActiveReservation, status, time.Now(), id)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return nil, ErrNoUpdateHappened
+		}
+		log.WithFields(log.Fields{
+			"error":  err,
+			"id":     id,
+			"status": status,
+		}).ErrorWithCtx(ctx, "[ReservationRepository.UpdateStatus] Failed to update reservation status")
+		return nil, err
+	}
+
+	return &reservation, nil
+}
+
+func (r *ReservationRepository) ExpireStale(ctx context.Context, now time.Time) (int64, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ReservationRepository.ExpireStale")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		expired int64
+		err     error
+	)
+
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &expired, expireReservations, now)
+	} else {
+		err = r.db.GetMaster().GetContext(ctx, &expired, expireReservations, now)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[ReservationRepository.ExpireStale] Failed to expire reservations")
+		return 0, err
+	}
+
+	return expired, nil
+}
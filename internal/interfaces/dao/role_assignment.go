@@ -0,0 +1,160 @@
+package dao
+
+import (
+	"context"
+	sql2 "database/sql"
+	"errors"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/lib/database/sql"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/lib/txmanager/utils"
+)
+
+type RoleAssignmentRepository struct {
+	db *sql.Store
+}
+
+type OptsRoleAssignmentRepository struct {
+	DB *sql.Store
+}
+
+const (
+	roleAssignmentColumns = `subject, role, assigned_by, created_at, updated_at`
+
+	selectAllRoleAssignment       = `SELECT ` + roleAssignmentColumns + ` FROM role_assignments ORDER BY subject`
+	selectRoleAssignmentBySubject = `SELECT ` + roleAssignmentColumns + ` FROM role_assignments WHERE subject = $1`
+	// upsertRoleAssignment replaces the role of the subject, a subject has a single role
+	upsertRoleAssignment = `INSERT INTO role_assignments (` + roleAssignmentColumns + `) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (subject) DO UPDATE SET role = EXCLUDED.role, assigned_by = EXCLUDED.assigned_by, updated_at = EXCLUDED.updated_at
+		RETURNING created_at`
+	deleteRoleAssignment = `DELETE FROM role_assignments WHERE subject = $1`
+)
+
+func NewRoleAssignmentRepository(opts *OptsRoleAssignmentRepository) repository.RoleAssignmentRepository {
+	return &RoleAssignmentRepository{db: opts.DB}
+}
+
+func (r *RoleAssignmentRepository) GetAll(ctx context.Context) (*[]model.RoleAssignment, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "RoleAssignmentRepository.GetAll")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		assignments []model.RoleAssignment
+		err         error
+	)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &assignments, selectAllRoleAssignment)
+	} else {
+		err = r.db.GetMaster().SelectContext(ctx, &assignments, selectAllRoleAssignment)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[RoleAssignmentRepository.GetAll] Failed to get all role assignment")
+		return nil, err
+	}
+
+	return &assignments, nil
+}
+
+func (r *RoleAssignmentRepository) GetBySubject(ctx context.Context, subject string) (*model.RoleAssignment, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "RoleAssignmentRepository.GetBySubject")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		assignment model.RoleAssignment
+		err        error
+	)
+
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &assignment, selectRoleAssignmentBySubject, subject)
+	} else {
+		err = r.db.GetMaster().GetContext(ctx, &assignment, selectRoleAssignmentBySubject, subject)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return nil, ErrNoResult
+		}
+		log.WithFields(log.Fields{
+			"error":   err,
+			"subject": subject,
+		}).ErrorWithCtx(ctx, "[RoleAssignmentRepository.GetBySubject] Failed to get role assignment by subject")
+		return nil, err
+	}
+
+	return &assignment, nil
+}
+
+// Upsert assigns the role to the subject, CreatedAt is set back to when the subject was first assigned a role
+func (r *RoleAssignmentRepository) Upsert(ctx context.Context, assignment *model.RoleAssignment) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "RoleAssignmentRepository.Upsert")
+	defer span.End()
+
+	var (
+		err error
+	)
+
+	args := []any{assignment.Subject, assignment.Role, assignment.AssignedBy, assignment.CreatedAt, assignment.UpdatedAt}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &assignment.CreatedAt, upsertRoleAssignment, args...)
+	} else {
+		err = r.db.GetMaster().GetContext(ctx, &assignment.CreatedAt, upsertRoleAssignment, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":   err,
+			"subject": assignment.Subject,
+		}).ErrorWithCtx(ctx, "[RoleAssignmentRepository.Upsert] Failed to Upsert")
+		return err
+	}
+
+	return nil
+}
+
+// Delete takes the role of the subject away, ErrNoResult is returned when the subject had none
+func (r *RoleAssignmentRepository) Delete(ctx context.Context, subject string) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "RoleAssignmentRepository.Delete")
+	defer span.End()
+
+	var (
+		result sql2.Result
+		err    error
+	)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		result, err = sqlTrx.ExecContext(ctx, deleteRoleAssignment, subject)
+	} else {
+		result, err = r.db.GetMaster().ExecContext(ctx, deleteRoleAssignment, subject)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":   err,
+			"subject": subject,
+		}).ErrorWithCtx(ctx, "[RoleAssignmentRepository.Delete] Failed to delete role assignment")
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNoResult
+	}
+
+	return nil
+}
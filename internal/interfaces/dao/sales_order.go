@@ -0,0 +1,334 @@
+package dao
+
+import (
+	"context"
+	sql2 "database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/lib/database/sql"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/lib/txmanager/utils"
+	"strings"
+	"time"
+)
+
+type SalesOrderRepository struct {
+	db *sql.Store
+}
+
+type OptsSalesOrderRepository struct {
+	DB *sql.Store
+}
+
+const (
+	salesOrderColumns = `id, customer, currency, total, location_id, status, note, created_by, fulfilled_at, cancelled_at, created_at, updated_at`
+
+	insertSalesOrder = `INSERT INTO sales_orders (` + salesOrderColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+	selectSalesOrder = `SELECT ` + salesOrderColumns + ` FROM sales_orders WHERE TRUE %s`
+	countSalesOrder  = `SELECT COUNT(*) FROM sales_orders WHERE TRUE %s`
+	lockSalesOrder   = `SELECT ` + salesOrderColumns + ` FROM sales_orders WHERE id = $1 FOR UPDATE`
+	updateSalesOrder = `UPDATE sales_orders SET customer = $1, status = $2, note = $3, fulfilled_at = $4, cancelled_at = $5,
+		updated_at = $6 WHERE id = $7`
+
+	salesOrderLineColumns = `id, sales_order_id, wardrobe_id, quantity, unit_price, created_at`
+
+	insertSalesOrderLine = `INSERT INTO sales_order_lines (` + salesOrderLineColumns + `) VALUES %s`
+	selectSalesOrderLine = `SELECT ` + salesOrderLineColumns + ` FROM sales_order_lines WHERE sales_order_id = $1 ORDER BY created_at, id`
+)
+
+var salesOrderSortColumns = map[string]sortColumn{
+	"created_at": {name: "created_at", cast: "timestamptz"},
+}
+
+func NewSalesOrderRepository(opts *OptsSalesOrderRepository) repository.SalesOrderRepository {
+	return &SalesOrderRepository{db: opts.DB}
+}
+
+// Insert adds the order without its lines, ErrNoResult is returned when its location does not exist
+func (s *SalesOrderRepository) Insert(ctx context.Context, order *model.SalesOrder) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "SalesOrderRepository.Insert")
+	defer span.End()
+
+	var (
+		err error
+	)
+
+	args := []any{order.ID, order.Customer, order.Currency, order.Total, order.LocationID, order.Status, order.Note,
+		order.CreatedBy, order.FulfilledAt, order.CancelledAt, order.CreatedAt, order.UpdatedAt}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, insertSalesOrder, args...)
+	} else {
+		_, err = s.db.GetMaster().ExecContext(ctx, insertSalesOrder, args...)
+	}
+
+	if err != nil {
+		if pqErr, valid := err.(*pq.Error); valid && pqErr.Code == "23503" {
+			return ErrNoResult
+		}
+		log.WithFields(log.Fields{
+			"error":    err,
+			"customer": order.Customer,
+		}).ErrorWithCtx(ctx, "[SalesOrderRepository.Insert] Failed to Insert")
+		return err
+	}
+
+	return nil
+}
+
+func (s *SalesOrderRepository) GetById(ctx context.Context, id *uuid.UUID) (*model.SalesOrder, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "SalesOrderRepository.GetById")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		order model.SalesOrder
+		err   error
+	)
+
+	query := fmt.Sprintf(selectSalesOrder, " AND id = $1")
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &order, query, id)
+	} else {
+		err = s.db.GetMaster().GetContext(ctx, &order, query, id)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return nil, ErrNoResult
+		}
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[SalesOrderRepository.GetById] Failed to get sales order by id")
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+// LockById locks the order until the end of the transaction, it must be called inside a transaction
+func (s *SalesOrderRepository) LockById(ctx context.Context, id *uuid.UUID) (*model.SalesOrder, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "SalesOrderRepository.LockById")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx == nil {
+		return nil, ErrNoTransaction
+	}
+
+	var order model.SalesOrder
+	err := sqlTrx.GetContext(ctx, &order, lockSalesOrder, id)
+	if err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return nil, ErrNoResult
+		}
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[SalesOrderRepository.LockById] Failed to lock sales order")
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+func (s *SalesOrderRepository) Get(ctx context.Context, filter *model.SalesOrderFilter, page *model.Page) (*[]model.SalesOrder, *model.PageInfo, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "SalesOrderRepository.Get")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		orders   []model.SalesOrder
+		pageInfo model.PageInfo
+		err      error
+	)
+
+	whereQuery := ""
+	var args []any
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		whereQuery += fmt.Sprintf(" AND status = $%d", len(args))
+	}
+	if filter.From != nil {
+		args = append(args, *filter.From)
+		whereQuery += fmt.Sprintf(" AND created_at >= $%d", len(args))
+	}
+	if filter.To != nil {
+		args = append(args, *filter.To)
+		whereQuery += fmt.Sprintf(" AND created_at <= $%d", len(args))
+	}
+
+	pageWhere, pageArgs := keysetQuery(whereQuery, args, page, salesOrderSortColumns)
+	query := fmt.Sprintf(selectSalesOrder, pageWhere)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &orders, query, pageArgs...)
+	} else {
+		err = s.db.GetMaster().SelectContext(ctx, &orders, query, pageArgs...)
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[SalesOrderRepository.Get] Failed to get sales orders")
+		return nil, nil, err
+	}
+
+	if len(orders) > page.Limit {
+		orders = orders[:page.Limit]
+		last := orders[len(orders)-1]
+
+		var values []string
+		for range page.Sort {
+			// created_at is the only sort field
+			values = append(values, last.CreatedAt.Format(time.RFC3339Nano))
+		}
+		pageInfo.Next = &model.PageCursor{Values: append(values, last.ID.String())}
+	}
+
+	if page.WithTotal {
+		var total int64
+		query = fmt.Sprintf(countSalesOrder, whereQuery)
+
+		if sqlTrx != nil {
+			err = sqlTrx.GetContext(ctx, &total, query, args...)
+		} else {
+			err = s.db.GetMaster().GetContext(ctx, &total, query, args...)
+		}
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error": err,
+			}).ErrorWithCtx(ctx, "[SalesOrderRepository.Get] Failed to count sales orders")
+			return nil, nil, err
+		}
+		pageInfo.Total = &total
+	}
+
+	return &orders, &pageInfo, nil
+}
+
+func (s *SalesOrderRepository) Update(ctx context.Context, order *model.SalesOrder) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "SalesOrderRepository.Update")
+	defer span.End()
+
+	var (
+		result sql2.Result
+		err    error
+	)
+
+	order.UpdatedAt = time.Now()
+	args := []any{order.Customer, order.Status, order.Note, order.FulfilledAt, order.CancelledAt, order.UpdatedAt, order.ID}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		result, err = sqlTrx.ExecContext(ctx, updateSalesOrder, args...)
+	} else {
+		result, err = s.db.GetMaster().ExecContext(ctx, updateSalesOrder, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    order.ID,
+		}).ErrorWithCtx(ctx, "[SalesOrderRepository.Update] Failed to update sales order")
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNoResult
+	}
+
+	return nil
+}
+
+func (s *SalesOrderRepository) GetLines(ctx context.Context, orderId *uuid.UUID) (*[]model.SalesOrderLine, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "SalesOrderRepository.GetLines")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		lines []model.SalesOrderLine
+		err   error
+	)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &lines, selectSalesOrderLine, orderId)
+	} else {
+		err = s.db.GetMaster().SelectContext(ctx, &lines, selectSalesOrderLine, orderId)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":          err,
+			"sales_order_id": orderId,
+		}).ErrorWithCtx(ctx, "[SalesOrderRepository.GetLines] Failed to get sales order lines")
+		return nil, err
+	}
+
+	return &lines, nil
+}
+
+// InsertLines adds the lines in one statement, ErrNoResult is returned when one of their wardrobes does not exist
+// and ErrDuplicate when a wardrobe is on the order twice
+func (s *SalesOrderRepository) InsertLines(ctx context.Context, lines []model.SalesOrderLine) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "SalesOrderRepository.InsertLines")
+	defer span.End()
+
+	if len(lines) == 0 {
+		return nil
+	}
+
+	var (
+		values []string
+		args   []any
+		err    error
+	)
+
+	for _, line := range lines {
+		var placeholders []string
+		for _, arg := range []any{line.ID, line.SalesOrderID, line.WardrobeID, line.Quantity, line.UnitPrice, line.CreatedAt} {
+			args = append(args, arg)
+			placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)))
+		}
+		values = append(values, "("+strings.Join(placeholders, ", ")+")")
+	}
+	query := fmt.Sprintf(insertSalesOrderLine, strings.Join(values, ", "))
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = s.db.GetMaster().ExecContext(ctx, query, args...)
+	}
+
+	if err != nil {
+		if pqErr, valid := err.(*pq.Error); valid {
+			switch pqErr.Code {
+			case "23503":
+				return ErrNoResult
+			case "23505":
+				return ErrDuplicate
+			}
+		}
+		log.WithFields(log.Fields{
+			"error":          err,
+			"sales_order_id": lines[0].SalesOrderID,
+		}).ErrorWithCtx(ctx, "[SalesOrderRepository.InsertLines] Failed to insert sales order lines")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,250 @@
+package dao
+
+import (
+	"context"
+	sql2 "database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/lib/database/sql"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/lib/txmanager/utils"
+	"time"
+)
+
+type StockAlertRepository struct {
+	db *sql.Store
+}
+
+type OptsStockAlertRepository struct {
+	DB *sql.Store
+}
+
+const (
+	stockAlertColumns = `id, wardrobe_id, rule_id, threshold, stock, status, notified_channels, notified_at, notify_attempts, notify_error, acknowledged_by, acknowledged_at, resolved_at, created_at, updated_at`
+
+	insertStockAlert = `INSERT INTO stock_alerts (` + stockAlertColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`
+	selectStockAlert = `SELECT ` + stockAlertColumns + ` FROM stock_alerts WHERE TRUE %s`
+	countStockAlert  = `SELECT COUNT(*) FROM stock_alerts WHERE TRUE %s`
+	// lockUnnotifiedStockAlert skips the alerts another instance is already notifying
+	lockUnnotifiedStockAlert = `SELECT ` + stockAlertColumns + ` FROM stock_alerts WHERE notified_at IS NULL AND notify_attempts < $1 ORDER BY created_at LIMIT $2 FOR UPDATE SKIP LOCKED`
+	updateStockAlert         = `UPDATE stock_alerts SET status = $1, notified_channels = $2, notified_at = $3, notify_attempts = $4, notify_error = $5,
+		acknowledged_by = $6, acknowledged_at = $7, resolved_at = $8, updated_at = $9 WHERE id = $10`
+)
+
+var stockAlertSortColumns = map[string]sortColumn{
+	"created_at": {name: "created_at", cast: "timestamptz"},
+}
+
+func NewStockAlertRepository(opts *OptsStockAlertRepository) repository.StockAlertRepository {
+	return &StockAlertRepository{db: opts.DB}
+}
+
+// Insert adds the alert, ErrDuplicate is returned when the wardrobe already has an unresolved one
+func (s *StockAlertRepository) Insert(ctx context.Context, alert *model.StockAlert) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "StockAlertRepository.Insert")
+	defer span.End()
+
+	var (
+		err error
+	)
+
+	args := []any{alert.ID, alert.WardrobeID, alert.RuleID, alert.Threshold, alert.Stock, alert.Status, alert.NotifiedChannels,
+		alert.NotifiedAt, alert.NotifyAttempts, alert.NotifyError, alert.AcknowledgedBy, alert.AcknowledgedAt, alert.ResolvedAt,
+		alert.CreatedAt, alert.UpdatedAt}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, insertStockAlert, args...)
+	} else {
+		_, err = s.db.GetMaster().ExecContext(ctx, insertStockAlert, args...)
+	}
+
+	if err != nil {
+		if pqErr, valid := err.(*pq.Error); valid && pqErr.Code == "23505" {
+			return ErrDuplicate
+		}
+		log.WithFields(log.Fields{
+			"error":       err,
+			"wardrobe_id": alert.WardrobeID,
+		}).ErrorWithCtx(ctx, "[StockAlertRepository.Insert] Failed to Insert")
+		return err
+	}
+
+	return nil
+}
+
+func (s *StockAlertRepository) GetById(ctx context.Context, id *uuid.UUID) (*model.StockAlert, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "StockAlertRepository.GetById")
+	defer span.End()
+
+	alert, err := s.getOne(ctx, " AND id = $1", id)
+	if err != nil && !errors.Is(err, ErrNoResult) {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[StockAlertRepository.GetById] Failed to get stock alert")
+	}
+	return alert, err
+}
+
+// GetUnresolved returns the open or acknowledged alert of the wardrobe, ErrNoResult when there is none
+func (s *StockAlertRepository) GetUnresolved(ctx context.Context, wardrobeId *uuid.UUID) (*model.StockAlert, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "StockAlertRepository.GetUnresolved")
+	defer span.End()
+
+	alert, err := s.getOne(ctx, " AND wardrobe_id = $1 AND status <> '"+model.StockAlertResolved+"'", wardrobeId)
+	if err != nil && !errors.Is(err, ErrNoResult) {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"wardrobe_id": wardrobeId,
+		}).ErrorWithCtx(ctx, "[StockAlertRepository.GetUnresolved] Failed to get stock alert")
+	}
+	return alert, err
+}
+
+func (s *StockAlertRepository) GetByStatus(ctx context.Context, status string, page *model.Page) (*[]model.StockAlert, *model.PageInfo, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "StockAlertRepository.GetByStatus")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		alerts   []model.StockAlert
+		pageInfo model.PageInfo
+		err      error
+	)
+
+	whereQuery := " AND status = $1"
+	args := []any{status}
+
+	pageWhere, pageArgs := keysetQuery(whereQuery, args, page, stockAlertSortColumns)
+	query := fmt.Sprintf(selectStockAlert, pageWhere)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &alerts, query, pageArgs...)
+	} else {
+		err = s.db.GetMaster().SelectContext(ctx, &alerts, query, pageArgs...)
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":  err,
+			"status": status,
+		}).ErrorWithCtx(ctx, "[StockAlertRepository.GetByStatus] Failed to get stock alerts")
+		return nil, nil, err
+	}
+
+	if len(alerts) > page.Limit {
+		alerts = alerts[:page.Limit]
+		last := alerts[len(alerts)-1]
+
+		var values []string
+		for range page.Sort {
+			// created_at is the only sort field
+			values = append(values, last.CreatedAt.Format(time.RFC3339Nano))
+		}
+		pageInfo.Next = &model.PageCursor{Values: append(values, last.ID.String())}
+	}
+
+	if page.WithTotal {
+		var total int64
+		query = fmt.Sprintf(countStockAlert, whereQuery)
+
+		if sqlTrx != nil {
+			err = sqlTrx.GetContext(ctx, &total, query, args...)
+		} else {
+			err = s.db.GetMaster().GetContext(ctx, &total, query, args...)
+		}
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error":  err,
+				"status": status,
+			}).ErrorWithCtx(ctx, "[StockAlertRepository.GetByStatus] Failed to count stock alerts")
+			return nil, nil, err
+		}
+		pageInfo.Total = &total
+	}
+
+	return &alerts, &pageInfo, nil
+}
+
+// LockUnnotified locks the oldest alerts still to be delivered, it must be called inside a transaction
+func (s *StockAlertRepository) LockUnnotified(ctx context.Context, limit, maxAttempts int) (*[]model.StockAlert, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "StockAlertRepository.LockUnnotified")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx == nil {
+		return nil, ErrNoTransaction
+	}
+
+	var alerts []model.StockAlert
+	err := sqlTrx.SelectContext(ctx, &alerts, lockUnnotifiedStockAlert, maxAttempts, limit)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[StockAlertRepository.LockUnnotified] Failed to lock stock alerts")
+		return nil, err
+	}
+
+	return &alerts, nil
+}
+
+func (s *StockAlertRepository) Update(ctx context.Context, alert *model.StockAlert) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "StockAlertRepository.Update")
+	defer span.End()
+
+	var (
+		err error
+	)
+
+	alert.UpdatedAt = time.Now()
+	args := []any{alert.Status, alert.NotifiedChannels, alert.NotifiedAt, alert.NotifyAttempts, alert.NotifyError,
+		alert.AcknowledgedBy, alert.AcknowledgedAt, alert.ResolvedAt, alert.UpdatedAt, alert.ID}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, updateStockAlert, args...)
+	} else {
+		_, err = s.db.GetMaster().ExecContext(ctx, updateStockAlert, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    alert.ID,
+		}).ErrorWithCtx(ctx, "[StockAlertRepository.Update] Failed to update stock alert")
+		return err
+	}
+
+	return nil
+}
+
+func (s *StockAlertRepository) getOne(ctx context.Context, whereQuery string, args ...any) (*model.StockAlert, error) {
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		alert model.StockAlert
+		err   error
+	)
+
+	query := fmt.Sprintf(selectStockAlert, whereQuery)
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &alert, query, args...)
+	} else {
+		err = s.db.GetMaster().GetContext(ctx, &alert, query, args...)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return nil, ErrNoResult
+		}
+		return nil, err
+	}
+
+	return &alert, nil
+}
@@ -0,0 +1,183 @@
+package dao
+
+import (
+	"context"
+	sql2 "database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/lib/database/sql"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/lib/txmanager/utils"
+)
+
+type StockAlertRuleRepository struct {
+	db *sql.Store
+}
+
+type OptsStockAlertRuleRepository struct {
+	DB *sql.Store
+}
+
+const (
+	stockAlertRuleColumns = `id, wardrobe_id, category_id, threshold, created_at, updated_at`
+
+	// upsertStockAlertRule replaces the threshold of the rule already set on the target, %s is the target column
+	upsertStockAlertRule = `INSERT INTO stock_alert_rules (` + stockAlertRuleColumns + `) VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (%[1]s) WHERE %[1]s IS NOT NULL DO UPDATE SET threshold = EXCLUDED.threshold, updated_at = EXCLUDED.updated_at
+		RETURNING ` + stockAlertRuleColumns
+	selectAllStockAlertRule = `SELECT ` + stockAlertRuleColumns + ` FROM stock_alert_rules ORDER BY created_at, id`
+	deleteStockAlertRule    = `DELETE FROM stock_alert_rules WHERE id = $1`
+	// selectWardrobeRule picks the rule of the wardrobe, or else the rule of its closest category up the tree
+	selectWardrobeRule = `WITH RECURSIVE ancestors AS (
+			SELECT id, parent_id, 0 AS depth FROM categories WHERE id = $2
+			UNION ALL
+			SELECT c.id, c.parent_id, a.depth + 1 FROM categories c JOIN ancestors a ON c.id = a.parent_id
+		)
+		SELECT r.id, r.wardrobe_id, r.category_id, r.threshold, r.created_at, r.updated_at FROM stock_alert_rules r
+		LEFT JOIN ancestors a ON a.id = r.category_id
+		WHERE r.wardrobe_id = $1 OR a.id IS NOT NULL
+		ORDER BY r.wardrobe_id IS NULL, a.depth LIMIT 1`
+)
+
+func NewStockAlertRuleRepository(opts *OptsStockAlertRuleRepository) repository.StockAlertRuleRepository {
+	return &StockAlertRuleRepository{db: opts.DB}
+}
+
+func (s *StockAlertRuleRepository) GetAll(ctx context.Context) (*[]model.StockAlertRule, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "StockAlertRuleRepository.GetAll")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		rules []model.StockAlertRule
+		err   error
+	)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &rules, selectAllStockAlertRule)
+	} else {
+		err = s.db.GetMaster().SelectContext(ctx, &rules, selectAllStockAlertRule)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[StockAlertRuleRepository.GetAll] Failed to get all stock alert rule")
+		return nil, err
+	}
+
+	return &rules, nil
+}
+
+// Upsert sets the rule of its wardrobe or category, a rule already set on the same target keeps its id.
+// ErrNoResult is returned when the target does not exist.
+func (s *StockAlertRuleRepository) Upsert(ctx context.Context, rule *model.StockAlertRule) (*model.StockAlertRule, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "StockAlertRuleRepository.Upsert")
+	defer span.End()
+
+	var (
+		stored model.StockAlertRule
+		err    error
+	)
+
+	target := "category_id"
+	if rule.WardrobeID != nil {
+		target = "wardrobe_id"
+	}
+	query := fmt.Sprintf(upsertStockAlertRule, target)
+	args := []any{rule.ID, rule.WardrobeID, rule.CategoryID, rule.Threshold, rule.CreatedAt, rule.UpdatedAt}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &stored, query, args...)
+	} else {
+		err = s.db.GetMaster().GetContext(ctx, &stored, query, args...)
+	}
+
+	if err != nil {
+		if pqErr, valid := err.(*pq.Error); valid && pqErr.Code == "23503" {
+			return nil, ErrNoResult
+		}
+		log.WithFields(log.Fields{
+			"error": err,
+			"rule":  *rule,
+		}).ErrorWithCtx(ctx, "[StockAlertRuleRepository.Upsert] Failed to Upsert")
+		return nil, err
+	}
+
+	return &stored, nil
+}
+
+func (s *StockAlertRuleRepository) Delete(ctx context.Context, id *uuid.UUID) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "StockAlertRuleRepository.Delete")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		result sql2.Result
+		err    error
+	)
+
+	if sqlTrx != nil {
+		result, err = sqlTrx.ExecContext(ctx, deleteStockAlertRule, id)
+	} else {
+		result, err = s.db.GetMaster().ExecContext(ctx, deleteStockAlertRule, id)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[StockAlertRuleRepository.Delete] Failed to delete stock alert rule")
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNoResult
+	}
+
+	return nil
+}
+
+// GetForWardrobe returns the rule applying to the wardrobe, ErrNoResult when there is none
+func (s *StockAlertRuleRepository) GetForWardrobe(ctx context.Context, wardrobe *model.Wardrobe) (*model.StockAlertRule, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "StockAlertRuleRepository.GetForWardrobe")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		rule model.StockAlertRule
+		err  error
+	)
+
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &rule, selectWardrobeRule, wardrobe.ID, wardrobe.CategoryID)
+	} else {
+		err = s.db.GetMaster().GetContext(ctx, &rule, selectWardrobeRule, wardrobe.ID, wardrobe.CategoryID)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return nil, ErrNoResult
+		}
+		log.WithFields(log.Fields{
+			"error":       err,
+			"wardrobe_id": wardrobe.ID,
+		}).ErrorWithCtx(ctx, "[StockAlertRuleRepository.GetForWardrobe] Failed to get stock alert rule")
+		return nil, err
+	}
+
+	return &rule, nil
+}
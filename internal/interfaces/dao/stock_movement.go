@@ -0,0 +1,92 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/lib/database/sql"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/lib/txmanager/utils"
+)
+
+type StockMovementRepository struct {
+	db *sql.Store
+}
+
+type OptsStockMovementRepository struct {
+	DB *sql.Store
+}
+
+const (
+	insertStockMovement = `INSERT INTO stock_movements (id, wardrobe_id, delta, reason, balance, created_at) VALUES ($1, $2, $3, $4, $5, $6)`
+	selectStockMovement = `SELECT id, wardrobe_id, delta, reason, balance, created_at FROM stock_movements WHERE TRUE %s`
+)
+
+func NewStockMovementRepository(opts *OptsStockMovementRepository) repository.StockMovementRepository {
+	return &StockMovementRepository{db: opts.DB}
+}
+
+func (s *StockMovementRepository) Insert(ctx context.Context, movement *model.StockMovement) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "StockMovementRepository.Insert")
+	defer span.End()
+
+	var (
+		err error
+	)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, insertStockMovement, movement.ID, movement.WardrobeID, movement.Delta,
+			movement.Reason, movement.Balance, movement.CreatedAt)
+	} else {
+		_, err = s.db.GetMaster().ExecContext(ctx, insertStockMovement, movement.ID, movement.WardrobeID, movement.Delta,
+			movement.Reason, movement.Balance, movement.CreatedAt)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":    err,
+			"movement": *movement,
+		}).ErrorWithCtx(ctx, "[StockMovementRepository.Insert] Failed to Insert")
+		return err
+	}
+
+	return nil
+}
+
+func (s *StockMovementRepository) GetByWardrobeId(ctx context.Context, wardrobeId *uuid.UUID) (*[]model.StockMovement, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "StockMovementRepository.GetByWardrobeId")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		args      []any
+		movements []model.StockMovement
+		err       error
+	)
+
+	whereQuery := " AND wardrobe_id = $1 ORDER BY created_at DESC"
+	args = append(args, wardrobeId)
+
+	query := fmt.Sprintf(selectStockMovement, whereQuery)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &movements, query, args...)
+	} else {
+		err = s.db.GetMaster().SelectContext(ctx, &movements, query, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"wardrobe_id": wardrobeId,
+		}).ErrorWithCtx(ctx, "[StockMovementRepository.GetByWardrobeId] Failed to get stock movements")
+		return nil, err
+	}
+
+	return &movements, nil
+}
@@ -0,0 +1,279 @@
+package dao
+
+import (
+	"context"
+	sql2 "database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/lib/database/sql"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/lib/txmanager/utils"
+	"time"
+)
+
+type StockTransferRepository struct {
+	db *sql.Store
+}
+
+type OptsStockTransferRepository struct {
+	DB *sql.Store
+}
+
+const (
+	stockTransferColumns = `id, wardrobe_id, from_location_id, to_location_id, quantity, status, note, created_by, received_at, cancelled_at, created_at, updated_at`
+
+	insertStockTransfer       = `INSERT INTO stock_transfers (` + stockTransferColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+	selectStockTransfer       = `SELECT ` + stockTransferColumns + ` FROM stock_transfers WHERE TRUE %s`
+	countStockTransfer        = `SELECT COUNT(*) FROM stock_transfers WHERE TRUE %s`
+	lockStockTransfer         = `SELECT ` + stockTransferColumns + ` FROM stock_transfers WHERE id = $1 FOR UPDATE`
+	sumInTransitStockTransfer = `SELECT COALESCE(SUM(quantity), 0) FROM stock_transfers WHERE wardrobe_id = $1 AND status = 'in_transit'`
+	updateStockTransfer       = `UPDATE stock_transfers SET status = $1, received_at = $2, cancelled_at = $3, updated_at = $4 WHERE id = $5`
+)
+
+var stockTransferSortColumns = map[string]sortColumn{
+	"created_at": {name: "created_at", cast: "timestamptz"},
+}
+
+func NewStockTransferRepository(opts *OptsStockTransferRepository) repository.StockTransferRepository {
+	return &StockTransferRepository{db: opts.DB}
+}
+
+// Insert adds the transfer, ErrNoResult is returned when one of its locations does not exist
+func (s *StockTransferRepository) Insert(ctx context.Context, transfer *model.StockTransfer) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "StockTransferRepository.Insert")
+	defer span.End()
+
+	var (
+		err error
+	)
+
+	args := []any{transfer.ID, transfer.WardrobeID, transfer.FromLocationID, transfer.ToLocationID, transfer.Quantity,
+		transfer.Status, transfer.Note, transfer.CreatedBy, transfer.ReceivedAt, transfer.CancelledAt,
+		transfer.CreatedAt, transfer.UpdatedAt}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, insertStockTransfer, args...)
+	} else {
+		_, err = s.db.GetMaster().ExecContext(ctx, insertStockTransfer, args...)
+	}
+
+	if err != nil {
+		if pqErr, valid := err.(*pq.Error); valid && pqErr.Code == "23503" {
+			return ErrNoResult
+		}
+		log.WithFields(log.Fields{
+			"error":       err,
+			"wardrobe_id": transfer.WardrobeID,
+		}).ErrorWithCtx(ctx, "[StockTransferRepository.Insert] Failed to Insert")
+		return err
+	}
+
+	return nil
+}
+
+func (s *StockTransferRepository) GetById(ctx context.Context, id *uuid.UUID) (*model.StockTransfer, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "StockTransferRepository.GetById")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		transfer model.StockTransfer
+		err      error
+	)
+
+	query := fmt.Sprintf(selectStockTransfer, " AND id = $1")
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &transfer, query, id)
+	} else {
+		err = s.db.GetMaster().GetContext(ctx, &transfer, query, id)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return nil, ErrNoResult
+		}
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[StockTransferRepository.GetById] Failed to get stock transfer by id")
+		return nil, err
+	}
+
+	return &transfer, nil
+}
+
+// LockById locks the transfer until the end of the transaction, it must be called inside a transaction
+func (s *StockTransferRepository) LockById(ctx context.Context, id *uuid.UUID) (*model.StockTransfer, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "StockTransferRepository.LockById")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx == nil {
+		return nil, ErrNoTransaction
+	}
+
+	var transfer model.StockTransfer
+	err := sqlTrx.GetContext(ctx, &transfer, lockStockTransfer, id)
+	if err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return nil, ErrNoResult
+		}
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[StockTransferRepository.LockById] Failed to lock stock transfer")
+		return nil, err
+	}
+
+	return &transfer, nil
+}
+
+func (s *StockTransferRepository) Get(ctx context.Context, filter *model.StockTransferFilter, page *model.Page) (*[]model.StockTransfer, *model.PageInfo, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "StockTransferRepository.Get")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		transfers []model.StockTransfer
+		pageInfo  model.PageInfo
+		err       error
+	)
+
+	whereQuery := ""
+	var args []any
+	if filter.WardrobeID != nil {
+		args = append(args, filter.WardrobeID)
+		whereQuery += fmt.Sprintf(" AND wardrobe_id = $%d", len(args))
+	}
+	if filter.LocationID != nil {
+		args = append(args, filter.LocationID)
+		whereQuery += fmt.Sprintf(" AND (from_location_id = $%d OR to_location_id = $%d)", len(args), len(args))
+	}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		whereQuery += fmt.Sprintf(" AND status = $%d", len(args))
+	}
+
+	pageWhere, pageArgs := keysetQuery(whereQuery, args, page, stockTransferSortColumns)
+	query := fmt.Sprintf(selectStockTransfer, pageWhere)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &transfers, query, pageArgs...)
+	} else {
+		err = s.db.GetMaster().SelectContext(ctx, &transfers, query, pageArgs...)
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[StockTransferRepository.Get] Failed to get stock transfers")
+		return nil, nil, err
+	}
+
+	if len(transfers) > page.Limit {
+		transfers = transfers[:page.Limit]
+		last := transfers[len(transfers)-1]
+
+		var values []string
+		for range page.Sort {
+			// created_at is the only sort field
+			values = append(values, last.CreatedAt.Format(time.RFC3339Nano))
+		}
+		pageInfo.Next = &model.PageCursor{Values: append(values, last.ID.String())}
+	}
+
+	if page.WithTotal {
+		var total int64
+		query = fmt.Sprintf(countStockTransfer, whereQuery)
+
+		if sqlTrx != nil {
+			err = sqlTrx.GetContext(ctx, &total, query, args...)
+		} else {
+			err = s.db.GetMaster().GetContext(ctx, &total, query, args...)
+		}
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error": err,
+			}).ErrorWithCtx(ctx, "[StockTransferRepository.Get] Failed to count stock transfers")
+			return nil, nil, err
+		}
+		pageInfo.Total = &total
+	}
+
+	return &transfers, &pageInfo, nil
+}
+
+// Update saves the status of the transfer and when it was received or cancelled
+func (s *StockTransferRepository) Update(ctx context.Context, transfer *model.StockTransfer) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "StockTransferRepository.Update")
+	defer span.End()
+
+	var (
+		result sql2.Result
+		err    error
+	)
+
+	transfer.UpdatedAt = time.Now()
+	args := []any{transfer.Status, transfer.ReceivedAt, transfer.CancelledAt, transfer.UpdatedAt, transfer.ID}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		result, err = sqlTrx.ExecContext(ctx, updateStockTransfer, args...)
+	} else {
+		result, err = s.db.GetMaster().ExecContext(ctx, updateStockTransfer, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    transfer.ID,
+		}).ErrorWithCtx(ctx, "[StockTransferRepository.Update] Failed to update stock transfer")
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNoResult
+	}
+
+	return nil
+}
+
+// GetInTransit sums the quantity of the wardrobe on its way between locations
+func (s *StockTransferRepository) GetInTransit(ctx context.Context, wardrobeId *uuid.UUID) (int, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "StockTransferRepository.GetInTransit")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		quantity int
+		err      error
+	)
+
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &quantity, sumInTransitStockTransfer, wardrobeId)
+	} else {
+		err = s.db.GetMaster().GetContext(ctx, &quantity, sumInTransitStockTransfer, wardrobeId)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"wardrobe_id": wardrobeId,
+		}).ErrorWithCtx(ctx, "[StockTransferRepository.GetInTransit] Failed to sum stock in transit")
+		return 0, err
+	}
+
+	return quantity, nil
+}
@@ -0,0 +1,243 @@
+package dao
+
+import (
+	"context"
+	sql2 "database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/lib/database/sql"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/lib/txmanager/utils"
+)
+
+type TagRepository struct {
+	db *sql.Store
+}
+
+type OptsTagRepository struct {
+	DB *sql.Store
+}
+
+const (
+	// upsertTag returns the existing tag when the name is already taken
+	upsertTag         = `INSERT INTO tags (id, name, created_at, updated_at) VALUES ($1, $2, $3, $4) ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name RETURNING id, name, created_at, updated_at`
+	selectTag         = `SELECT id, name, created_at, updated_at FROM tags WHERE TRUE %s`
+	selectAllTag      = `SELECT id, name, created_at, updated_at FROM tags ORDER BY name`
+	selectWardrobeTag = `SELECT t.id, t.name, t.created_at, t.updated_at FROM tags t JOIN wardrobe_tags wt ON wt.tag_id = t.id WHERE wt.wardrobe_id = $1 ORDER BY t.name`
+	deleteTag         = `DELETE FROM tags WHERE TRUE %s`
+	assignTag         = `INSERT INTO wardrobe_tags (wardrobe_id, tag_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+	unassignTag       = `DELETE FROM wardrobe_tags WHERE wardrobe_id = $1 AND tag_id = $2`
+)
+
+func NewTagRepository(opts *OptsTagRepository) repository.TagRepository {
+	return &TagRepository{db: opts.DB}
+}
+
+func (t *TagRepository) Upsert(ctx context.Context, tag *model.Tag) (*model.Tag, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "TagRepository.Upsert")
+	defer span.End()
+
+	var (
+		stored model.Tag
+		err    error
+	)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &stored, upsertTag, tag.ID, tag.Name, tag.CreatedAt, tag.UpdatedAt)
+	} else {
+		err = t.db.GetMaster().GetContext(ctx, &stored, upsertTag, tag.ID, tag.Name, tag.CreatedAt, tag.UpdatedAt)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"tag":   *tag,
+		}).ErrorWithCtx(ctx, "[TagRepository.Upsert] Failed to Upsert")
+		return nil, err
+	}
+
+	return &stored, nil
+}
+
+func (t *TagRepository) GetAll(ctx context.Context) (*[]model.Tag, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "TagRepository.GetAll")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		tags []model.Tag
+		err  error
+	)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &tags, selectAllTag)
+	} else {
+		err = t.db.GetMaster().SelectContext(ctx, &tags, selectAllTag)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[TagRepository.GetAll] Failed to get all tag")
+		return nil, err
+	}
+
+	return &tags, nil
+}
+
+func (t *TagRepository) GetById(ctx context.Context, id *uuid.UUID) (*model.Tag, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "TagRepository.GetById")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		args []any
+		tag  model.Tag
+		err  error
+	)
+
+	whereQuery := " AND id = $1"
+	args = append(args, id)
+
+	query := fmt.Sprintf(selectTag, whereQuery)
+
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &tag, query, args...)
+	} else {
+		err = t.db.GetMaster().GetContext(ctx, &tag, query, args...)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return nil, ErrNoResult
+		}
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[TagRepository.GetById] Failed to get tag by id")
+		return nil, err
+	}
+
+	return &tag, nil
+}
+
+func (t *TagRepository) Delete(ctx context.Context, id *uuid.UUID) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "TagRepository.Delete")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		args []any
+		err  error
+	)
+
+	whereQuery := " AND id = $1"
+	args = append(args, id)
+
+	query := fmt.Sprintf(deleteTag, whereQuery)
+
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = t.db.GetMaster().ExecContext(ctx, query, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[TagRepository.Delete] Failed to delete tag")
+		return err
+	}
+	return nil
+}
+
+func (t *TagRepository) GetByWardrobeId(ctx context.Context, wardrobeId *uuid.UUID) (*[]model.Tag, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "TagRepository.GetByWardrobeId")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		tags []model.Tag
+		err  error
+	)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &tags, selectWardrobeTag, wardrobeId)
+	} else {
+		err = t.db.GetMaster().SelectContext(ctx, &tags, selectWardrobeTag, wardrobeId)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"wardrobe_id": wardrobeId,
+		}).ErrorWithCtx(ctx, "[TagRepository.GetByWardrobeId] Failed to get wardrobe tags")
+		return nil, err
+	}
+
+	return &tags, nil
+}
+
+func (t *TagRepository) Assign(ctx context.Context, wardrobeId, tagId *uuid.UUID) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "TagRepository.Assign")
+	defer span.End()
+
+	var (
+		err error
+	)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, assignTag, wardrobeId, tagId)
+	} else {
+		_, err = t.db.GetMaster().ExecContext(ctx, assignTag, wardrobeId, tagId)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"wardrobe_id": wardrobeId,
+			"tag_id":      tagId,
+		}).ErrorWithCtx(ctx, "[TagRepository.Assign] Failed to assign tag")
+		return err
+	}
+
+	return nil
+}
+
+func (t *TagRepository) Unassign(ctx context.Context, wardrobeId, tagId *uuid.UUID) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "TagRepository.Unassign")
+	defer span.End()
+
+	var (
+		err error
+	)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, unassignTag, wardrobeId, tagId)
+	} else {
+		_, err = t.db.GetMaster().ExecContext(ctx, unassignTag, wardrobeId, tagId)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"wardrobe_id": wardrobeId,
+			"tag_id":      tagId,
+		}).ErrorWithCtx(ctx, "[TagRepository.Unassign] Failed to unassign tag")
+		return err
+	}
+
+	return nil
+}
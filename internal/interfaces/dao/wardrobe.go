@@ -6,13 +6,16 @@ import (
 	"errors"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
+	"regexp"
 	"sagara_backend_test/internal/domain/model"
 	"sagara_backend_test/internal/domain/repository"
 	"sagara_backend_test/lib/database/sql"
 	"sagara_backend_test/lib/log"
 	"sagara_backend_test/lib/tracing"
 	"sagara_backend_test/lib/txmanager/utils"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -26,13 +29,53 @@ type OptsWardrobeRepository struct {
 }
 
 const (
-	insertWardrobe    = `INSERT INTO wardrobe (id, name, color, size, price, stock, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
-	selectWardrobe    = `SELECT id, name, color, size, price, stock, created_at, updated_at FROM wardrobe WHERE TRUE %s`
-	selectAllWardrobe = `SELECT id, name, color, size, price, stock, created_at, updated_at FROM wardrobe`
-	updateWardrobe    = `UPDATE wardrobe SET %s WHERE TRUE %s`
-	deleteWardrobe    = `DELETE FROM wardrobe WHERE TRUE %s`
+	wardrobeColumns = `id, name, color, size, price, currency, stock, version, product_id, sku, price_overridden, category_id, deleted_at, created_at, updated_at`
+
+	// reservedStock sums the active reservations of the wardrobe row it is embedded in
+	reservedStock = `(SELECT COALESCE(SUM(r.quantity), 0) FROM stock_reservations r WHERE r.wardrobe_id = wardrobe.id AND r.status = 'active' AND r.expires_at > now())`
+
+	insertWardrobe = `INSERT INTO wardrobe (` + wardrobeColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`
+	// deleted wardrobes stay in the table until purged, only the trash queries see them
+	selectWardrobe  = `SELECT ` + wardrobeColumns + `, ` + reservedStock + ` AS reserved FROM wardrobe WHERE deleted_at IS NULL %s`
+	searchWardrobe  = `SELECT ` + wardrobeColumns + `, ` + reservedStock + ` AS reserved, %s AS rank FROM wardrobe WHERE deleted_at IS NULL %%s`
+	countWardrobe   = `SELECT COUNT(*) FROM wardrobe WHERE deleted_at IS NULL %s`
+	selectTrash     = `SELECT ` + wardrobeColumns + `, ` + reservedStock + ` AS reserved FROM wardrobe WHERE deleted_at IS NOT NULL %s`
+	countTrash      = `SELECT COUNT(*) FROM wardrobe WHERE deleted_at IS NOT NULL %s`
+	lockWardrobe    = `SELECT id FROM wardrobe WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`
+	bumpVersion     = `UPDATE wardrobe SET version = version + 1 WHERE id = $1`
+	updateWardrobe  = `UPDATE wardrobe SET %s WHERE TRUE %s`
+	adjustStock     = `UPDATE wardrobe SET stock = stock + $1, version = version + 1, updated_at = $2 WHERE id = $3 AND deleted_at IS NULL %s RETURNING ` + wardrobeColumns + `, ` + reservedStock + ` AS reserved`
+	setCategory     = `UPDATE wardrobe SET category_id = $1, version = version + 1, updated_at = $2 WHERE id = $3 AND deleted_at IS NULL RETURNING ` + wardrobeColumns + `, ` + reservedStock + ` AS reserved`
+	deleteWardrobe  = `UPDATE wardrobe SET deleted_at = $1, updated_at = $1, version = version + 1 WHERE id = $2 AND deleted_at IS NULL`
+	restoreWardrobe = `UPDATE wardrobe SET deleted_at = NULL, updated_at = $1, version = version + 1 WHERE id = $2 AND deleted_at IS NOT NULL RETURNING ` + wardrobeColumns + `, ` + reservedStock + ` AS reserved`
+	// purgeWardrobe keeps the wardrobes on purchase and sales orders, the orders are the paper trail of the stock moved
+	purgeWardrobe = `DELETE FROM wardrobe WHERE deleted_at IS NOT NULL AND deleted_at <= $1
+		AND NOT EXISTS (SELECT 1 FROM purchase_order_lines l WHERE l.wardrobe_id = wardrobe.id)
+		AND NOT EXISTS (SELECT 1 FROM sales_order_lines l WHERE l.wardrobe_id = wardrobe.id)`
+
+	suggestLimit = 10
+
+	// categoryTree selects the ids of a category and all of its descendants
+	categoryTree = `WITH RECURSIVE tree AS (SELECT id FROM categories WHERE id = $%d UNION ALL SELECT c.id FROM categories c JOIN tree ON c.parent_id = tree.id) SELECT id FROM tree`
+	// matchQuery and rankQuery take a prefix tsquery built by toPrefixTSQuery
+	matchQuery = ` AND search_vector @@ to_tsquery('simple', immutable_unaccent($%d))`
+	rankQuery  = `ts_rank(search_vector, to_tsquery('simple', immutable_unaccent($%d)))`
+	// wardrobeTagged counts the given tag names attached to the wardrobe row it is embedded in
+	wardrobeTagged = `(SELECT COUNT(*) FROM wardrobe_tags wt JOIN tags t ON t.id = wt.tag_id WHERE wt.wardrobe_id = wardrobe.id AND t.name = ANY($%d))`
 )
 
+var wardrobeSortColumns = map[string]sortColumn{
+	"name":       {name: "name", cast: "text"},
+	"price":      {name: "price", cast: "numeric"},
+	"stock":      {name: "stock", cast: "int"},
+	"created_at": {name: "created_at", cast: "timestamptz"},
+	"updated_at": {name: "updated_at", cast: "timestamptz"},
+	"deleted_at": {name: "deleted_at", cast: "timestamptz"},
+}
+
+// tsQueryTerm picks the words of a search query, everything else could break the tsquery syntax
+var tsQueryTerm = regexp.MustCompile(`[\pL\pN]+`)
+
 func NewWardrobeRepository(opts *OptsWardrobeRepository) repository.WardrobeRepository {
 	return &WardrobeRepository{db: opts.DB}
 }
@@ -48,10 +91,12 @@ func (w *WardrobeRepository) Insert(ctx context.Context, wardrobe *model.Wardrob
 	sqlTrx := utils.GetSqlTx(ctx)
 	if sqlTrx != nil {
 		_, err = sqlTrx.ExecContext(ctx, insertWardrobe, wardrobe.ID, wardrobe.Name, wardrobe.Color,
-			wardrobe.Size, wardrobe.Price, wardrobe.Stock, wardrobe.CreatedAt, wardrobe.UpdatedAt)
+			wardrobe.Size, wardrobe.Price, wardrobe.Currency, wardrobe.Stock, wardrobe.Version, wardrobe.ProductID, wardrobe.SKU, wardrobe.PriceOverridden,
+			wardrobe.CategoryID, wardrobe.DeletedAt, wardrobe.CreatedAt, wardrobe.UpdatedAt)
 	} else {
 		_, err = w.db.GetMaster().ExecContext(ctx, insertWardrobe, wardrobe.ID, wardrobe.Name, wardrobe.Color,
-			wardrobe.Size, wardrobe.Price, wardrobe.Stock, wardrobe.CreatedAt, wardrobe.UpdatedAt)
+			wardrobe.Size, wardrobe.Price, wardrobe.Currency, wardrobe.Stock, wardrobe.Version, wardrobe.ProductID, wardrobe.SKU, wardrobe.PriceOverridden,
+			wardrobe.CategoryID, wardrobe.DeletedAt, wardrobe.CreatedAt, wardrobe.UpdatedAt)
 	}
 
 	if err != nil {
@@ -75,6 +120,8 @@ func (w *WardrobeRepository) Insert(ctx context.Context, wardrobe *model.Wardrob
 	return nil
 }
 
+// Update overwrites the wardrobe only when its version still matches wardrobe.Version,
+// ErrNoUpdateHappened is returned when another writer already bumped it
 func (w *WardrobeRepository) Update(ctx context.Context, wardrobe *model.Wardrobe) error {
 	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeRepository.Update")
 	defer span.End()
@@ -82,60 +129,66 @@ func (w *WardrobeRepository) Update(ctx context.Context, wardrobe *model.Wardrob
 	sqlTrx := utils.GetSqlTx(ctx)
 
 	var (
-		args []any
-		err  error
+		args   []any
+		result sql2.Result
+		err    error
 	)
 
-	setQuery := "name = $1, color = $2, size = $3, price = $4, stock = $5, updated_at = $6"
-	whereQuery := " AND id = $7"
-	args = append(args, wardrobe.Name, wardrobe.Color, wardrobe.Size, wardrobe.Price, wardrobe.Stock, time.Now(), wardrobe.ID)
+	updatedAt := time.Now()
+	setQuery := "name = $1, color = $2, size = $3, price = $4, currency = $5, stock = $6, sku = $7, price_overridden = $8, updated_at = $9, version = version + 1"
+	whereQuery := " AND id = $10 AND version = $11 AND deleted_at IS NULL"
+	args = append(args, wardrobe.Name, wardrobe.Color, wardrobe.Size, wardrobe.Price, wardrobe.Currency, wardrobe.Stock, wardrobe.SKU,
+		wardrobe.PriceOverridden, updatedAt, wardrobe.ID, wardrobe.Version)
 
 	query := fmt.Sprintf(updateWardrobe, setQuery, whereQuery)
 
 	if sqlTrx != nil {
-		_, err = sqlTrx.ExecContext(ctx, query, args...)
+		result, err = sqlTrx.ExecContext(ctx, query, args...)
 	} else {
-		_, err = w.db.GetMaster().ExecContext(ctx, query, args...)
+		result, err = w.db.GetMaster().ExecContext(ctx, query, args...)
 	}
 
 	if err != nil {
+		if pqErr, valid := err.(*pq.Error); valid && pqErr.Code == "23505" {
+			return ErrDuplicate
+		}
 		log.WithFields(log.Fields{
 			"error":    err,
 			"wardrobe": wardrobe,
 		}).ErrorWithCtx(ctx, "[WardrobeRepository.Update] Failed to update wardrobe")
 		return err
 	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":    err,
+			"wardrobe": wardrobe,
+		}).ErrorWithCtx(ctx, "[WardrobeRepository.Update] Failed to get affected rows")
+		return err
+	}
+	if affected == 0 {
+		return ErrNoUpdateHappened
+	}
+
+	wardrobe.Version++
+	wardrobe.UpdatedAt = updatedAt
 	return nil
 }
 
-func (w *WardrobeRepository) GetAll(ctx context.Context) (*[]model.Wardrobe, error) {
+func (w *WardrobeRepository) GetAll(ctx context.Context, page *model.Page) (*[]model.Wardrobe, *model.PageInfo, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeRepository.GetAll")
 	defer span.End()
 
-	sqlTrx := utils.GetSqlTx(ctx)
-
-	var (
-		wardrobe []model.Wardrobe
-		err      error
-	)
-
-	if sqlTrx != nil {
-		err = sqlTrx.SelectContext(ctx, &wardrobe, selectAllWardrobe)
-	} else {
-		err = w.db.GetMaster().SelectContext(ctx, &wardrobe, selectAllWardrobe)
-	}
-
+	wardrobe, pageInfo, err := w.selectPage(ctx, "", nil, page)
 	if err != nil {
-		if errors.Is(err, sql2.ErrNoRows) {
-			return nil, ErrNoResult
-		}
 		log.WithFields(log.Fields{
 			"error": err,
 		}).ErrorWithCtx(ctx, "[WardrobeRepository.GetAll] Failed to get all wardrobe")
-		return nil, err
+		return nil, nil, err
 	}
 
-	return &wardrobe, nil
+	return wardrobe, pageInfo, nil
 }
 
 func (w *WardrobeRepository) GetById(ctx context.Context, id *uuid.UUID) (*model.Wardrobe, error) {
@@ -174,6 +227,63 @@ func (w *WardrobeRepository) GetById(ctx context.Context, id *uuid.UUID) (*model
 	return &wardrobe, nil
 }
 
+// LockById takes a row lock on the wardrobe until the surrounding transaction ends, so stock checks that
+// follow are not raced by other writers. It must be called inside a transaction.
+func (w *WardrobeRepository) LockById(ctx context.Context, id *uuid.UUID) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeRepository.LockById")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx == nil {
+		return ErrNoTransaction
+	}
+
+	var lockedId uuid.UUID
+	err := sqlTrx.GetContext(ctx, &lockedId, lockWardrobe, id)
+	if err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return ErrNoResult
+		}
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WardrobeRepository.LockById] Failed to lock wardrobe")
+		return err
+	}
+
+	return nil
+}
+
+// BumpVersion invalidates the ETag of a wardrobe whose response changed without the row itself changing,
+// like its reserved stock or its price schedules
+func (w *WardrobeRepository) BumpVersion(ctx context.Context, id *uuid.UUID) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeRepository.BumpVersion")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		err error
+	)
+
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, bumpVersion, id)
+	} else {
+		_, err = w.db.GetMaster().ExecContext(ctx, bumpVersion, id)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WardrobeRepository.BumpVersion] Failed to bump wardrobe version")
+		return err
+	}
+
+	return nil
+}
+
+// Delete moves the wardrobe to the trash, ErrNoResult is returned when it is missing or already deleted
 func (w *WardrobeRepository) Delete(ctx context.Context, id *uuid.UUID) error {
 	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeRepository.Delete")
 	defer span.End()
@@ -181,19 +291,17 @@ func (w *WardrobeRepository) Delete(ctx context.Context, id *uuid.UUID) error {
 	sqlTrx := utils.GetSqlTx(ctx)
 
 	var (
-		args []any
-		err  error
+		args   []any
+		result sql2.Result
+		err    error
 	)
 
-	whereQuery := " AND id = $1"
-	args = append(args, id)
-
-	query := fmt.Sprintf(deleteWardrobe, whereQuery)
+	args = append(args, time.Now(), id)
 
 	if sqlTrx != nil {
-		_, err = sqlTrx.ExecContext(ctx, query, args...)
+		result, err = sqlTrx.ExecContext(ctx, deleteWardrobe, args...)
 	} else {
-		_, err = w.db.GetMaster().ExecContext(ctx, query, args...)
+		result, err = w.db.GetMaster().ExecContext(ctx, deleteWardrobe, args...)
 	}
 
 	if err != nil {
@@ -203,227 +311,594 @@ func (w *WardrobeRepository) Delete(ctx context.Context, id *uuid.UUID) error {
 		}).ErrorWithCtx(ctx, "[WardrobeRepository.Delete] Failed to delete wardrobe")
 		return err
 	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNoResult
+	}
 	return nil
 }
 
-func (w *WardrobeRepository) Search(ctx context.Context, color, size string) (*[]model.Wardrobe, error) {
-	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeRepository.Search")
+// GetDeleted lists the wardrobes in the trash
+func (w *WardrobeRepository) GetDeleted(ctx context.Context, page *model.Page) (*[]model.Wardrobe, *model.PageInfo, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeRepository.GetDeleted")
 	defer span.End()
 
+	wardrobes, pageInfo, err := w.selectPageFrom(ctx, selectTrash, countTrash, wardrobeSortColumns, "", nil, page)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[WardrobeRepository.GetDeleted] Failed to get deleted wardrobe")
+		return nil, nil, err
+	}
+
+	return wardrobes, pageInfo, nil
+}
+
+// Restore takes the wardrobe out of the trash, ErrNoResult is returned when it is not in the trash
+// and ErrDuplicate when its sku has been taken by another wardrobe meanwhile
+func (w *WardrobeRepository) Restore(ctx context.Context, id *uuid.UUID) (*model.Wardrobe, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeRepository.Restore")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
 	var (
-		wardrobes  []model.Wardrobe
-		args       []any
-		whereQuery string
-		err        error
+		wardrobe model.Wardrobe
+		err      error
 	)
 
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &wardrobe, restoreWardrobe, time.Now(), id)
+	} else {
+		err = w.db.GetMaster().GetContext(ctx, &wardrobe, restoreWardrobe, time.Now(), id)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return nil, ErrNoResult
+		}
+		if pqErr, valid := err.(*pq.Error); valid && pqErr.Code == "23505" {
+			return nil, ErrDuplicate
+		}
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WardrobeRepository.Restore] Failed to restore wardrobe")
+		return nil, err
+	}
+
+	return &wardrobe, nil
+}
+
+// Purge permanently removes the wardrobes deleted at or before deletedBefore, with everything attached to them
+func (w *WardrobeRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeRepository.Purge")
+	defer span.End()
+
 	sqlTrx := utils.GetSqlTx(ctx)
 
-	color = strings.ToLower(color)
-	size = strings.ToLower(size)
+	var (
+		result sql2.Result
+		err    error
+	)
 
-	if color != "" && size != "" {
-		whereQuery = " AND color = $1 AND size = $2"
-		args = append(args, color, size)
-	} else if color != "" {
-		whereQuery = " AND color = $1"
-		args = append(args, color)
-	} else if size != "" {
-		whereQuery = " AND size = $1"
-		args = append(args, size)
+	if sqlTrx != nil {
+		result, err = sqlTrx.ExecContext(ctx, purgeWardrobe, deletedBefore)
 	} else {
-		whereQuery = ""
+		result, err = w.db.GetMaster().ExecContext(ctx, purgeWardrobe, deletedBefore)
 	}
 
-	query := fmt.Sprintf(selectWardrobe, whereQuery)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":          err,
+			"deleted_before": deletedBefore,
+		}).ErrorWithCtx(ctx, "[WardrobeRepository.Purge] Failed to purge wardrobe")
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+func (w *WardrobeRepository) Search(ctx context.Context, filter *model.WardrobeFilter, page *model.Page) (*[]model.Wardrobe, *model.PageInfo, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeRepository.Search")
+	defer span.End()
+
+	var (
+		selectQuery = selectWardrobe
+		sortColumns = wardrobeSortColumns
+	)
+
+	whereQuery, args := filterQuery(filter)
+	if filter.Query != "" {
+		// the query is always the first argument
+		rank := fmt.Sprintf(rankQuery, 1)
+		selectQuery = fmt.Sprintf(searchWardrobe, rank)
+		sortColumns = map[string]sortColumn{model.WardrobeRelevance: {name: rank, cast: "float4"}}
+		for field, column := range wardrobeSortColumns {
+			sortColumns[field] = column
+		}
+	}
+
+	wardrobes, pageInfo, err := w.selectPageFrom(ctx, selectQuery, countWardrobe, sortColumns, whereQuery, args, page)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":  err,
+			"filter": filter,
+		}).ErrorWithCtx(ctx, "[WardrobeRepository.Search] Failed to search wardrobe")
+		return nil, nil, err
+	}
+	return wardrobes, pageInfo, nil
+
+}
+
+// Stream calls fn with every wardrobe matching the filter by name, the rows are read one at a time
+// so the whole table is never held in memory. An error of fn stops the stream and is returned.
+func (w *WardrobeRepository) Stream(ctx context.Context, filter *model.WardrobeFilter, fn func(wardrobe *model.Wardrobe) error) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeRepository.Stream")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		rows *sqlx.Rows
+		err  error
+	)
+
+	whereQuery, args := filterQuery(filter)
+	query := fmt.Sprintf(selectWardrobe, whereQuery+" ORDER BY name, id")
 
 	if sqlTrx != nil {
-		err = sqlTrx.SelectContext(ctx, &wardrobes, query, args...)
+		rows, err = sqlTrx.QueryxContext(ctx, query, args...)
 	} else {
-		err = w.db.GetMaster().SelectContext(ctx, &wardrobes, query, args...)
+		rows, err = w.db.GetMaster().QueryxContext(ctx, query, args...)
 	}
 
-	fmt.Println(query)
 	if err != nil {
 		log.WithFields(log.Fields{
-			"error": err,
-			"color": color,
-			"size":  size,
-		}).ErrorWithCtx(ctx, "[WardrobeRepository.Delete] Failed to delete wardrobe")
-		return nil, err
+			"error":  err,
+			"filter": filter,
+		}).ErrorWithCtx(ctx, "[WardrobeRepository.Stream] Failed to stream wardrobe")
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var wardrobe model.Wardrobe
+		err = rows.StructScan(&wardrobe)
+		if err != nil {
+			return err
+		}
+
+		err = fn(&wardrobe)
+		if err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// filterQuery turns the filter into a where clause, the query text is the first argument when there is one
+func filterQuery(filter *model.WardrobeFilter) (string, []any) {
+	var (
+		args       []any
+		whereQuery string
+	)
+
+	if filter.Query != "" {
+		args = append(args, toPrefixTSQuery(filter.Query))
+		whereQuery += fmt.Sprintf(matchQuery, len(args))
+	}
+
+	color := strings.ToLower(filter.Color)
+	size := strings.ToLower(filter.Size)
+
+	if color != "" {
+		args = append(args, color)
+		whereQuery += fmt.Sprintf(" AND color = $%d", len(args))
+	}
+	if size != "" {
+		args = append(args, size)
+		whereQuery += fmt.Sprintf(" AND size = $%d", len(args))
+	}
+	if filter.CategoryID != nil {
+		args = append(args, filter.CategoryID)
+		whereQuery += " AND category_id IN (" + fmt.Sprintf(categoryTree, len(args)) + ")"
+	}
+	if len(filter.Tags) > 0 {
+		args = append(args, pq.Array(filter.Tags))
+		tagged := fmt.Sprintf(wardrobeTagged, len(args))
+		if filter.AllTags {
+			args = append(args, len(filter.Tags))
+			whereQuery += fmt.Sprintf(" AND %s = $%d", tagged, len(args))
+		} else {
+			whereQuery += fmt.Sprintf(" AND %s > 0", tagged)
+		}
 	}
-	return &wardrobes, nil
 
+	return whereQuery, args
 }
 
-func (w *WardrobeRepository) AddStock(ctx context.Context, id *uuid.UUID, addition int) error {
+func (w *WardrobeRepository) AddStock(ctx context.Context, id *uuid.UUID, addition int) (*model.Wardrobe, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeRepository.AddStock")
 	defer span.End()
 
 	sqlTrx := utils.GetSqlTx(ctx)
 
 	var (
-		args []any
-		err  error
+		args     []any
+		wardrobe model.Wardrobe
+		err      error
 	)
 
-	setQuery := "stock = $1"
-	whereQuery := " AND id = $2"
-	args = append(args, addition, id)
-
-	query := fmt.Sprintf(updateWardrobe, setQuery, whereQuery)
+	args = append(args, addition, time.Now(), id)
+	query := fmt.Sprintf(adjustStock, "")
 
 	if sqlTrx != nil {
-		_, err = sqlTrx.ExecContext(ctx, query, args...)
+		err = sqlTrx.GetContext(ctx, &wardrobe, query, args...)
 	} else {
-		_, err = w.db.GetMaster().ExecContext(ctx, query, args...)
+		err = w.db.GetMaster().GetContext(ctx, &wardrobe, query, args...)
 	}
 
 	if err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return nil, ErrNoResult
+		}
 		log.WithFields(log.Fields{
 			"error": err,
 			"id":    id,
 		}).ErrorWithCtx(ctx, "[WardrobeRepository.AddStock] Failed to add stock")
-		return err
+		return nil, err
 	}
-	return nil
+	return &wardrobe, nil
 }
 
-func (w *WardrobeRepository) SubStock(ctx context.Context, id *uuid.UUID, def int) error {
+// SubStock decrements the stock in a single statement, the row is left untouched when the stock that is not
+// reserved is not enough and ErrNoUpdateHappened is returned
+func (w *WardrobeRepository) SubStock(ctx context.Context, id *uuid.UUID, def int) (*model.Wardrobe, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeRepository.SubStock")
 	defer span.End()
 
 	sqlTrx := utils.GetSqlTx(ctx)
 
 	var (
-		args []any
-		err  error
+		args     []any
+		wardrobe model.Wardrobe
+		err      error
 	)
 
-	setQuery := "stock = $1"
-	whereQuery := " AND id = $2"
-	args = append(args, def, id)
-
-	query := fmt.Sprintf(updateWardrobe, setQuery, whereQuery)
+	args = append(args, -def, time.Now(), id, def)
+	query := fmt.Sprintf(adjustStock, "AND stock - "+reservedStock+" >= $4")
 
 	if sqlTrx != nil {
-		_, err = sqlTrx.ExecContext(ctx, query, args...)
+		err = sqlTrx.GetContext(ctx, &wardrobe, query, args...)
 	} else {
-		_, err = w.db.GetMaster().ExecContext(ctx, query, args...)
+		err = w.db.GetMaster().GetContext(ctx, &wardrobe, query, args...)
 	}
 
 	if err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return nil, ErrNoUpdateHappened
+		}
 		log.WithFields(log.Fields{
 			"error": err,
 			"id":    id,
 		}).ErrorWithCtx(ctx, "[WardrobeRepository.SubStock] Failed to sub stock")
-		return err
+		return nil, err
 	}
-	return nil
+	return &wardrobe, nil
 }
 
-func (w *WardrobeRepository) GetAvailable(ctx context.Context) (*[]model.Wardrobe, error) {
+func (w *WardrobeRepository) GetAvailable(ctx context.Context, page *model.Page) (*[]model.Wardrobe, *model.PageInfo, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeRepository.GetAvailable")
 	defer span.End()
 
+	whereQuery := " AND stock - " + reservedStock + " > 0"
+
+	wardrobe, pageInfo, err := w.selectPage(ctx, whereQuery, nil, page)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[WardrobeRepository.GetAvailable] Failed to get available wardrobe")
+		return nil, nil, err
+	}
+
+	return wardrobe, pageInfo, nil
+}
+
+func (w *WardrobeRepository) GetUnavailable(ctx context.Context, page *model.Page) (*[]model.Wardrobe, *model.PageInfo, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeRepository.GetUnavailable")
+	defer span.End()
+
+	whereQuery := " AND stock - " + reservedStock + " <= 0"
+
+	wardrobe, pageInfo, err := w.selectPage(ctx, whereQuery, nil, page)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[WardrobeRepository.GetUnavailable] Failed to unavailable wardrobe")
+		return nil, nil, err
+	}
+
+	return wardrobe, pageInfo, nil
+}
+
+func (w *WardrobeRepository) GetLessThan(ctx context.Context, amount int, page *model.Page) (*[]model.Wardrobe, *model.PageInfo, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeRepository.GetLessThan")
+	defer span.End()
+
+	whereQuery := " AND stock - " + reservedStock + " < $1 "
+	args := []any{amount}
+
+	wardrobe, pageInfo, err := w.selectPage(ctx, whereQuery, args, page)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":  err,
+			"amount": amount,
+		}).ErrorWithCtx(ctx, "[WardrobeRepository.GetLessThan] Failed to get wardrobe")
+		return nil, nil, err
+	}
+
+	return wardrobe, pageInfo, nil
+}
+
+func (w *WardrobeRepository) GetByProductId(ctx context.Context, productId *uuid.UUID) (*[]model.Wardrobe, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeRepository.GetByProductId")
+	defer span.End()
+
 	sqlTrx := utils.GetSqlTx(ctx)
 
 	var (
 		wardrobe []model.Wardrobe
+		args     []any
 		err      error
 	)
 
-	whereQuery := " AND stock != 0"
+	whereQuery := " AND product_id = $1 ORDER BY color, size"
+	args = append(args, productId)
 	query := fmt.Sprintf(selectWardrobe, whereQuery)
 
 	if sqlTrx != nil {
-		err = sqlTrx.SelectContext(ctx, &wardrobe, query)
+		err = sqlTrx.SelectContext(ctx, &wardrobe, query, args...)
 	} else {
-		err = w.db.GetMaster().SelectContext(ctx, &wardrobe, query)
+		err = w.db.GetMaster().SelectContext(ctx, &wardrobe, query, args...)
 	}
 
 	if err != nil {
-		if errors.Is(err, sql2.ErrNoRows) {
-			return nil, ErrNoResult
-		}
 		log.WithFields(log.Fields{
-			"error": err,
-		}).ErrorWithCtx(ctx, "[WardrobeRepository.GetAvailable] Failed to get available wardrobe")
+			"error":      err,
+			"product_id": productId,
+		}).ErrorWithCtx(ctx, "[WardrobeRepository.GetByProductId] Failed to get variants")
 		return nil, err
 	}
 
 	return &wardrobe, nil
 }
 
-func (w *WardrobeRepository) GetUnavailable(ctx context.Context) (*[]model.Wardrobe, error) {
-	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeRepository.GetUnavailable")
+func (w *WardrobeRepository) GetBySKUs(ctx context.Context, skus []string) (*[]model.Wardrobe, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeRepository.GetBySKUs")
 	defer span.End()
 
 	sqlTrx := utils.GetSqlTx(ctx)
 
 	var (
 		wardrobe []model.Wardrobe
+		args     []any
 		err      error
 	)
 
-	whereQuery := " AND stock = 0"
+	whereQuery := " AND sku = ANY($1)"
+	args = append(args, pq.Array(skus))
 	query := fmt.Sprintf(selectWardrobe, whereQuery)
 
 	if sqlTrx != nil {
-		err = sqlTrx.SelectContext(ctx, &wardrobe, query)
+		err = sqlTrx.SelectContext(ctx, &wardrobe, query, args...)
 	} else {
-		err = w.db.GetMaster().SelectContext(ctx, &wardrobe, query)
+		err = w.db.GetMaster().SelectContext(ctx, &wardrobe, query, args...)
 	}
 
 	if err != nil {
-		if errors.Is(err, sql2.ErrNoRows) {
-			return nil, ErrNoResult
-		}
 		log.WithFields(log.Fields{
 			"error": err,
-		}).ErrorWithCtx(ctx, "[WardrobeRepository.GetUnavailable] Failed to unavailable wardrobe")
+			"skus":  skus,
+		}).ErrorWithCtx(ctx, "[WardrobeRepository.GetBySKUs] Failed to get wardrobe by skus")
 		return nil, err
 	}
 
 	return &wardrobe, nil
 }
 
-func (w *WardrobeRepository) GetLessThan(ctx context.Context, amount int) (*[]model.Wardrobe, error) {
-	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeRepository.GetLessThan")
+// SyncProduct copies the product name to all of its variants and its price and currency to the variants without a price override
+func (w *WardrobeRepository) SyncProduct(ctx context.Context, product *model.Product) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeRepository.SyncProduct")
 	defer span.End()
 
 	sqlTrx := utils.GetSqlTx(ctx)
 
 	var (
-		wardrobe   []model.Wardrobe
-		err        error
-		whereQuery string
-		args       []any
+		args []any
+		err  error
 	)
 
-	if amount != 0 {
-		whereQuery = " AND stock < $1 "
-		args = append(args, amount)
+	setQuery := "name = $1, price = CASE WHEN price_overridden THEN price ELSE $2 END, " +
+		"currency = CASE WHEN price_overridden THEN currency ELSE $3 END, updated_at = $4, version = version + 1"
+	whereQuery := " AND product_id = $5"
+	args = append(args, product.Name, product.Price, product.Currency, time.Now(), product.ID)
+
+	query := fmt.Sprintf(updateWardrobe, setQuery, whereQuery)
+
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, query, args...)
 	} else {
-		whereQuery = " AND stock < 5"
+		_, err = w.db.GetMaster().ExecContext(ctx, query, args...)
 	}
-	query := fmt.Sprintf(selectWardrobe, whereQuery)
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":   err,
+			"product": product,
+		}).ErrorWithCtx(ctx, "[WardrobeRepository.SyncProduct] Failed to sync variants")
+		return err
+	}
+	return nil
+}
+
+func (w *WardrobeRepository) SetCategory(ctx context.Context, id, categoryId *uuid.UUID) (*model.Wardrobe, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeRepository.SetCategory")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		args     []any
+		wardrobe model.Wardrobe
+		err      error
+	)
+
+	args = append(args, categoryId, time.Now(), id)
 
 	if sqlTrx != nil {
-		err = sqlTrx.SelectContext(ctx, &wardrobe, query, args...)
+		err = sqlTrx.GetContext(ctx, &wardrobe, setCategory, args...)
 	} else {
-		err = w.db.GetMaster().SelectContext(ctx, &wardrobe, query, args...)
+		err = w.db.GetMaster().GetContext(ctx, &wardrobe, setCategory, args...)
 	}
 
 	if err != nil {
 		if errors.Is(err, sql2.ErrNoRows) {
 			return nil, ErrNoResult
 		}
+		log.WithFields(log.Fields{
+			"error":       err,
+			"id":          id,
+			"category_id": categoryId,
+		}).ErrorWithCtx(ctx, "[WardrobeRepository.SetCategory] Failed to set category")
+		return nil, err
+	}
+	return &wardrobe, nil
+}
+
+// selectPage runs a keyset paginated select of the wardrobes matching whereQuery
+func (w *WardrobeRepository) selectPage(ctx context.Context, whereQuery string, args []any, page *model.Page) (*[]model.Wardrobe, *model.PageInfo, error) {
+	return w.selectPageFrom(ctx, selectWardrobe, countWardrobe, wardrobeSortColumns, whereQuery, args, page)
+}
+
+// selectPageFrom is selectPage with its own select and count queries and sortable columns, both queries take the where clause
+func (w *WardrobeRepository) selectPageFrom(ctx context.Context, selectQuery, countQuery string, columns map[string]sortColumn,
+	whereQuery string, args []any, page *model.Page) (*[]model.Wardrobe, *model.PageInfo, error) {
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		wardrobes []model.Wardrobe
+		pageInfo  model.PageInfo
+		err       error
+	)
+
+	pageWhere, pageArgs := keysetQuery(whereQuery, args, page, columns)
+	query := fmt.Sprintf(selectQuery, pageWhere)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &wardrobes, query, pageArgs...)
+	} else {
+		err = w.db.GetMaster().SelectContext(ctx, &wardrobes, query, pageArgs...)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(wardrobes) > page.Limit {
+		wardrobes = wardrobes[:page.Limit]
+		last := wardrobes[len(wardrobes)-1]
+
+		var values []string
+		for _, sort := range page.Sort {
+			values = append(values, wardrobeSortValue(&last, sort.Field))
+		}
+		pageInfo.Next = &model.PageCursor{Values: append(values, last.ID.String())}
+	}
+
+	if page.WithTotal {
+		var total int64
+		query = fmt.Sprintf(countQuery, whereQuery)
+
+		if sqlTrx != nil {
+			err = sqlTrx.GetContext(ctx, &total, query, args...)
+		} else {
+			err = w.db.GetMaster().GetContext(ctx, &total, query, args...)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		pageInfo.Total = &total
+	}
+
+	return &wardrobes, &pageInfo, nil
+}
+
+func wardrobeSortValue(wardrobe *model.Wardrobe, field string) string {
+	switch field {
+	case "name":
+		return wardrobe.Name
+	case "price":
+		return wardrobe.Price.String()
+	case "stock":
+		return strconv.Itoa(wardrobe.Stock)
+	case "created_at":
+		return wardrobe.CreatedAt.Format(time.RFC3339Nano)
+	case "updated_at":
+		return wardrobe.UpdatedAt.Format(time.RFC3339Nano)
+	case "deleted_at":
+		if wardrobe.DeletedAt != nil {
+			return wardrobe.DeletedAt.Format(time.RFC3339Nano)
+		}
+	case model.WardrobeRelevance:
+		return strconv.FormatFloat(float64(wardrobe.Rank), 'f', -1, 32)
+	}
+	return ""
+}
+
+// Suggest returns the best matching wardrobes of a query typed so far, the last word may still be incomplete
+func (w *WardrobeRepository) Suggest(ctx context.Context, query string) (*[]model.Wardrobe, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeRepository.Suggest")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		wardrobes []model.Wardrobe
+		args      []any
+		err       error
+	)
+
+	args = append(args, toPrefixTSQuery(query), suggestLimit)
+	whereQuery := fmt.Sprintf(matchQuery, 1) + " ORDER BY rank DESC, name LIMIT $2"
+	selectQuery := fmt.Sprintf(fmt.Sprintf(searchWardrobe, fmt.Sprintf(rankQuery, 1)), whereQuery)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &wardrobes, selectQuery, args...)
+	} else {
+		err = w.db.GetMaster().SelectContext(ctx, &wardrobes, selectQuery, args...)
+	}
+
+	if err != nil {
 		log.WithFields(log.Fields{
 			"error": err,
-		}).ErrorWithCtx(ctx, "[WardrobeRepository.GetLessThan] Failed to get wardrobe")
+			"query": query,
+		}).ErrorWithCtx(ctx, "[WardrobeRepository.Suggest] Failed to suggest wardrobe")
 		return nil, err
 	}
 
-	return &wardrobe, nil
+	return &wardrobes, nil
+}
+
+// toPrefixTSQuery turns "Denim jack" into "denim:* & jack:*" so every word matches as a prefix
+func toPrefixTSQuery(query string) string {
+	terms := tsQueryTerm.FindAllString(strings.ToLower(query), -1)
+	for i := range terms {
+		terms[i] += ":*"
+	}
+	return strings.Join(terms, " & ")
 }
@@ -0,0 +1,298 @@
+package dao
+
+import (
+	"context"
+	sql2 "database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/lib/database/sql"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/lib/txmanager/utils"
+	"time"
+)
+
+type WebhookDeliveryRepository struct {
+	db *sql.Store
+}
+
+type OptsWebhookDeliveryRepository struct {
+	DB *sql.Store
+}
+
+const (
+	webhookDeliveryColumns = `id, subscription_id, event_id, event_type, payload, status, attempts, next_attempt_at, delivered_at, last_error, created_at, updated_at`
+
+	// insertWebhookDelivery ignores an event already queued for the subscription, the outbox may publish an event twice
+	insertWebhookDelivery = `INSERT INTO webhook_deliveries (` + webhookDeliveryColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (subscription_id, event_id) DO NOTHING`
+	selectWebhookDelivery = `SELECT ` + webhookDeliveryColumns + ` FROM webhook_deliveries WHERE TRUE %s`
+	countWebhookDelivery  = `SELECT COUNT(*) FROM webhook_deliveries WHERE TRUE %s`
+	// claimPendingWebhookDelivery skips the deliveries of disabled subscriptions and the ones another instance is claiming,
+	// the claimed ones are pushed back by the lease so they are not picked up again while they are sent
+	claimPendingWebhookDelivery = `UPDATE webhook_deliveries SET next_attempt_at = now() + $2 * interval '1 millisecond', updated_at = now()
+		WHERE id IN (SELECT d.id FROM webhook_deliveries d JOIN webhook_subscriptions s ON s.id = d.subscription_id
+			WHERE d.status = 'pending' AND d.next_attempt_at <= now() AND s.active
+			ORDER BY d.next_attempt_at LIMIT $1 FOR UPDATE OF d SKIP LOCKED)
+		RETURNING ` + webhookDeliveryColumns
+	updateWebhookDelivery = `UPDATE webhook_deliveries SET status = $1, attempts = $2, next_attempt_at = $3, delivered_at = $4, last_error = $5,
+		updated_at = $6 WHERE id = $7`
+
+	webhookDeliveryAttemptColumns = `id, delivery_id, attempt, status_code, response_body, error, duration_ms, created_at`
+
+	insertWebhookDeliveryAttempt = `INSERT INTO webhook_delivery_attempts (` + webhookDeliveryAttemptColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	selectWebhookDeliveryAttempt = `SELECT ` + webhookDeliveryAttemptColumns + ` FROM webhook_delivery_attempts WHERE delivery_id = $1 ORDER BY created_at`
+)
+
+var webhookDeliverySortColumns = map[string]sortColumn{
+	"created_at": {name: "created_at", cast: "timestamptz"},
+}
+
+func NewWebhookDeliveryRepository(opts *OptsWebhookDeliveryRepository) repository.WebhookDeliveryRepository {
+	return &WebhookDeliveryRepository{db: opts.DB}
+}
+
+func (w *WebhookDeliveryRepository) Insert(ctx context.Context, delivery *model.WebhookDelivery) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookDeliveryRepository.Insert")
+	defer span.End()
+
+	var (
+		err error
+	)
+
+	args := []any{delivery.ID, delivery.SubscriptionID, delivery.EventID, delivery.EventType, delivery.Payload,
+		delivery.Status, delivery.Attempts, delivery.NextAttemptAt, delivery.DeliveredAt, delivery.LastError,
+		delivery.CreatedAt, delivery.UpdatedAt}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, insertWebhookDelivery, args...)
+	} else {
+		_, err = w.db.GetMaster().ExecContext(ctx, insertWebhookDelivery, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":           err,
+			"subscription_id": delivery.SubscriptionID,
+			"event_id":        delivery.EventID,
+		}).ErrorWithCtx(ctx, "[WebhookDeliveryRepository.Insert] Failed to Insert")
+		return err
+	}
+
+	return nil
+}
+
+func (w *WebhookDeliveryRepository) GetById(ctx context.Context, id *uuid.UUID) (*model.WebhookDelivery, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookDeliveryRepository.GetById")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		delivery model.WebhookDelivery
+		err      error
+	)
+
+	query := fmt.Sprintf(selectWebhookDelivery, " AND id = $1")
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &delivery, query, id)
+	} else {
+		err = w.db.GetMaster().GetContext(ctx, &delivery, query, id)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return nil, ErrNoResult
+		}
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WebhookDeliveryRepository.GetById] Failed to get webhook delivery by id")
+		return nil, err
+	}
+
+	return &delivery, nil
+}
+
+func (w *WebhookDeliveryRepository) GetBySubscription(ctx context.Context, subscriptionId *uuid.UUID, page *model.Page) (*[]model.WebhookDelivery, *model.PageInfo, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookDeliveryRepository.GetBySubscription")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		deliveries []model.WebhookDelivery
+		pageInfo   model.PageInfo
+		err        error
+	)
+
+	whereQuery := " AND subscription_id = $1"
+	args := []any{subscriptionId}
+
+	pageWhere, pageArgs := keysetQuery(whereQuery, args, page, webhookDeliverySortColumns)
+	query := fmt.Sprintf(selectWebhookDelivery, pageWhere)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &deliveries, query, pageArgs...)
+	} else {
+		err = w.db.GetMaster().SelectContext(ctx, &deliveries, query, pageArgs...)
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":           err,
+			"subscription_id": subscriptionId,
+		}).ErrorWithCtx(ctx, "[WebhookDeliveryRepository.GetBySubscription] Failed to get webhook deliveries")
+		return nil, nil, err
+	}
+
+	if len(deliveries) > page.Limit {
+		deliveries = deliveries[:page.Limit]
+		last := deliveries[len(deliveries)-1]
+
+		var values []string
+		for range page.Sort {
+			// created_at is the only sort field
+			values = append(values, last.CreatedAt.Format(time.RFC3339Nano))
+		}
+		pageInfo.Next = &model.PageCursor{Values: append(values, last.ID.String())}
+	}
+
+	if page.WithTotal {
+		var total int64
+		query = fmt.Sprintf(countWebhookDelivery, whereQuery)
+
+		if sqlTrx != nil {
+			err = sqlTrx.GetContext(ctx, &total, query, args...)
+		} else {
+			err = w.db.GetMaster().GetContext(ctx, &total, query, args...)
+		}
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error":           err,
+				"subscription_id": subscriptionId,
+			}).ErrorWithCtx(ctx, "[WebhookDeliveryRepository.GetBySubscription] Failed to count webhook deliveries")
+			return nil, nil, err
+		}
+		pageInfo.Total = &total
+	}
+
+	return &deliveries, &pageInfo, nil
+}
+
+// ClaimPending takes the deliveries due for an attempt and moves their next attempt a lease ahead, a delivery whose
+// result is not recorded within the lease is claimed again
+func (w *WebhookDeliveryRepository) ClaimPending(ctx context.Context, limit int, lease time.Duration) (*[]model.WebhookDelivery, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookDeliveryRepository.ClaimPending")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		deliveries []model.WebhookDelivery
+		err        error
+	)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &deliveries, claimPendingWebhookDelivery, limit, lease.Milliseconds())
+	} else {
+		err = w.db.GetMaster().SelectContext(ctx, &deliveries, claimPendingWebhookDelivery, limit, lease.Milliseconds())
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[WebhookDeliveryRepository.ClaimPending] Failed to claim webhook deliveries")
+		return nil, err
+	}
+
+	return &deliveries, nil
+}
+
+func (w *WebhookDeliveryRepository) Update(ctx context.Context, delivery *model.WebhookDelivery) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookDeliveryRepository.Update")
+	defer span.End()
+
+	var (
+		err error
+	)
+
+	delivery.UpdatedAt = time.Now()
+	args := []any{delivery.Status, delivery.Attempts, delivery.NextAttemptAt, delivery.DeliveredAt, delivery.LastError,
+		delivery.UpdatedAt, delivery.ID}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, updateWebhookDelivery, args...)
+	} else {
+		_, err = w.db.GetMaster().ExecContext(ctx, updateWebhookDelivery, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    delivery.ID,
+		}).ErrorWithCtx(ctx, "[WebhookDeliveryRepository.Update] Failed to update webhook delivery")
+		return err
+	}
+
+	return nil
+}
+
+func (w *WebhookDeliveryRepository) InsertAttempt(ctx context.Context, attempt *model.WebhookDeliveryAttempt) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookDeliveryRepository.InsertAttempt")
+	defer span.End()
+
+	var (
+		err error
+	)
+
+	args := []any{attempt.ID, attempt.DeliveryID, attempt.Attempt, attempt.StatusCode, attempt.ResponseBody, attempt.Error,
+		attempt.DurationMs, attempt.CreatedAt}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, insertWebhookDeliveryAttempt, args...)
+	} else {
+		_, err = w.db.GetMaster().ExecContext(ctx, insertWebhookDeliveryAttempt, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"delivery_id": attempt.DeliveryID,
+		}).ErrorWithCtx(ctx, "[WebhookDeliveryRepository.InsertAttempt] Failed to Insert")
+		return err
+	}
+
+	return nil
+}
+
+func (w *WebhookDeliveryRepository) GetAttempts(ctx context.Context, deliveryId *uuid.UUID) (*[]model.WebhookDeliveryAttempt, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookDeliveryRepository.GetAttempts")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		attempts []model.WebhookDeliveryAttempt
+		err      error
+	)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &attempts, selectWebhookDeliveryAttempt, deliveryId)
+	} else {
+		err = w.db.GetMaster().SelectContext(ctx, &attempts, selectWebhookDeliveryAttempt, deliveryId)
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"delivery_id": deliveryId,
+		}).ErrorWithCtx(ctx, "[WebhookDeliveryRepository.GetAttempts] Failed to get webhook delivery attempts")
+		return nil, err
+	}
+
+	return &attempts, nil
+}
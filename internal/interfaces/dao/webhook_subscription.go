@@ -0,0 +1,300 @@
+package dao
+
+import (
+	"context"
+	sql2 "database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/lib/database/sql"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/lib/txmanager/utils"
+	"time"
+)
+
+type WebhookSubscriptionRepository struct {
+	db *sql.Store
+}
+
+type OptsWebhookSubscriptionRepository struct {
+	DB *sql.Store
+}
+
+const (
+	webhookSubscriptionColumns = `id, url, event_types, secret, active, consecutive_failures, disabled_at, disabled_reason, created_at, updated_at`
+
+	insertWebhookSubscription       = `INSERT INTO webhook_subscriptions (` + webhookSubscriptionColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+	selectAllWebhookSubscription    = `SELECT ` + webhookSubscriptionColumns + ` FROM webhook_subscriptions ORDER BY created_at`
+	selectActiveWebhookSubscription = `SELECT ` + webhookSubscriptionColumns + ` FROM webhook_subscriptions WHERE active ORDER BY created_at`
+	selectWebhookSubscriptionById   = `SELECT ` + webhookSubscriptionColumns + ` FROM webhook_subscriptions WHERE id = $1`
+	updateWebhookSubscription       = `UPDATE webhook_subscriptions SET url = $1, event_types = $2, secret = $3, active = $4, consecutive_failures = $5,
+		disabled_at = $6, disabled_reason = $7, updated_at = $8 WHERE id = $9`
+	deleteWebhookSubscription = `DELETE FROM webhook_subscriptions WHERE id = $1`
+	// recordWebhookSubscriptionFailure only touches the failure columns, an edit made while the delivery was sent is kept.
+	// It tells whether this failure disabled the subscription.
+	recordWebhookSubscriptionFailure = `WITH old AS (SELECT id, active FROM webhook_subscriptions WHERE id = $1 FOR UPDATE)
+		UPDATE webhook_subscriptions s SET consecutive_failures = s.consecutive_failures + 1,
+			active = s.active AND ($2 <= 0 OR s.consecutive_failures + 1 < $2),
+			disabled_at = CASE WHEN s.active AND $2 > 0 AND s.consecutive_failures + 1 >= $2 THEN $4 ELSE s.disabled_at END,
+			disabled_reason = CASE WHEN s.active AND $2 > 0 AND s.consecutive_failures + 1 >= $2
+				THEN (s.consecutive_failures + 1) || ' attempts failed in a row, the last one with: ' || $3 ELSE s.disabled_reason END,
+			updated_at = $4
+		FROM old WHERE s.id = old.id RETURNING old.active AND NOT s.active`
+	resetWebhookSubscriptionFailures = `UPDATE webhook_subscriptions SET consecutive_failures = 0, updated_at = $2 WHERE id = $1 AND consecutive_failures > 0`
+)
+
+func NewWebhookSubscriptionRepository(opts *OptsWebhookSubscriptionRepository) repository.WebhookSubscriptionRepository {
+	return &WebhookSubscriptionRepository{db: opts.DB}
+}
+
+func (w *WebhookSubscriptionRepository) GetAll(ctx context.Context) (*[]model.WebhookSubscription, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookSubscriptionRepository.GetAll")
+	defer span.End()
+
+	subscriptions, err := w.getMany(ctx, selectAllWebhookSubscription)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[WebhookSubscriptionRepository.GetAll] Failed to get all webhook subscription")
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+// GetActive returns the subscriptions the events are delivered to
+func (w *WebhookSubscriptionRepository) GetActive(ctx context.Context) (*[]model.WebhookSubscription, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookSubscriptionRepository.GetActive")
+	defer span.End()
+
+	subscriptions, err := w.getMany(ctx, selectActiveWebhookSubscription)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[WebhookSubscriptionRepository.GetActive] Failed to get active webhook subscription")
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+func (w *WebhookSubscriptionRepository) GetById(ctx context.Context, id *uuid.UUID) (*model.WebhookSubscription, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookSubscriptionRepository.GetById")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		subscription model.WebhookSubscription
+		err          error
+	)
+
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &subscription, selectWebhookSubscriptionById, id)
+	} else {
+		err = w.db.GetMaster().GetContext(ctx, &subscription, selectWebhookSubscriptionById, id)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return nil, ErrNoResult
+		}
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WebhookSubscriptionRepository.GetById] Failed to get webhook subscription by id")
+		return nil, err
+	}
+
+	return &subscription, nil
+}
+
+func (w *WebhookSubscriptionRepository) Insert(ctx context.Context, subscription *model.WebhookSubscription) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookSubscriptionRepository.Insert")
+	defer span.End()
+
+	var (
+		err error
+	)
+
+	args := []any{subscription.ID, subscription.URL, subscription.EventTypes, subscription.Secret, subscription.Active,
+		subscription.ConsecutiveFailures, subscription.DisabledAt, subscription.DisabledReason, subscription.CreatedAt,
+		subscription.UpdatedAt}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, insertWebhookSubscription, args...)
+	} else {
+		_, err = w.db.GetMaster().ExecContext(ctx, insertWebhookSubscription, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"url":   subscription.URL,
+		}).ErrorWithCtx(ctx, "[WebhookSubscriptionRepository.Insert] Failed to Insert")
+		return err
+	}
+
+	return nil
+}
+
+func (w *WebhookSubscriptionRepository) Update(ctx context.Context, subscription *model.WebhookSubscription) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookSubscriptionRepository.Update")
+	defer span.End()
+
+	var (
+		result sql2.Result
+		err    error
+	)
+
+	subscription.UpdatedAt = time.Now()
+	args := []any{subscription.URL, subscription.EventTypes, subscription.Secret, subscription.Active,
+		subscription.ConsecutiveFailures, subscription.DisabledAt, subscription.DisabledReason, subscription.UpdatedAt,
+		subscription.ID}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		result, err = sqlTrx.ExecContext(ctx, updateWebhookSubscription, args...)
+	} else {
+		result, err = w.db.GetMaster().ExecContext(ctx, updateWebhookSubscription, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    subscription.ID,
+		}).ErrorWithCtx(ctx, "[WebhookSubscriptionRepository.Update] Failed to update webhook subscription")
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNoResult
+	}
+
+	return nil
+}
+
+// RecordFailure counts a failed delivery and disables the subscription once disableAfter deliveries failed in a row,
+// it tells whether the subscription got disabled
+func (w *WebhookSubscriptionRepository) RecordFailure(ctx context.Context, id *uuid.UUID, disableAfter int, reason string) (bool, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookSubscriptionRepository.RecordFailure")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		disabled bool
+		err      error
+	)
+
+	args := []any{id, disableAfter, reason, time.Now()}
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &disabled, recordWebhookSubscriptionFailure, args...)
+	} else {
+		err = w.db.GetMaster().GetContext(ctx, &disabled, recordWebhookSubscriptionFailure, args...)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql2.ErrNoRows) {
+			return false, ErrNoResult
+		}
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WebhookSubscriptionRepository.RecordFailure] Failed to record webhook failure")
+		return false, err
+	}
+
+	return disabled, nil
+}
+
+// ResetFailures clears the failures in a row after a delivery went through
+func (w *WebhookSubscriptionRepository) ResetFailures(ctx context.Context, id *uuid.UUID) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookSubscriptionRepository.ResetFailures")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		err error
+	)
+
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, resetWebhookSubscriptionFailures, id, time.Now())
+	} else {
+		_, err = w.db.GetMaster().ExecContext(ctx, resetWebhookSubscriptionFailures, id, time.Now())
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WebhookSubscriptionRepository.ResetFailures] Failed to reset webhook failures")
+		return err
+	}
+
+	return nil
+}
+
+func (w *WebhookSubscriptionRepository) Delete(ctx context.Context, id *uuid.UUID) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookSubscriptionRepository.Delete")
+	defer span.End()
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		result sql2.Result
+		err    error
+	)
+
+	if sqlTrx != nil {
+		result, err = sqlTrx.ExecContext(ctx, deleteWebhookSubscription, id)
+	} else {
+		result, err = w.db.GetMaster().ExecContext(ctx, deleteWebhookSubscription, id)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WebhookSubscriptionRepository.Delete] Failed to delete webhook subscription")
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNoResult
+	}
+
+	return nil
+}
+
+func (w *WebhookSubscriptionRepository) getMany(ctx context.Context, query string) (*[]model.WebhookSubscription, error) {
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	var (
+		subscriptions []model.WebhookSubscription
+		err           error
+	)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &subscriptions, query)
+	} else {
+		err = w.db.GetMaster().SelectContext(ctx, &subscriptions, query)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscriptions, nil
+}
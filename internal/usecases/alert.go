@@ -0,0 +1,18 @@
+package usecases
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/response/rest"
+)
+
+type AlertUseCases interface {
+	GetRules(ctx context.Context) (*[]response.StockAlertRuleResponse, error)
+	SetRule(ctx context.Context, request *request.StockAlertRuleRequest) (*response.StockAlertRuleResponse, error)
+	DeleteRule(ctx context.Context, id *uuid.UUID) error
+	GetAlerts(ctx context.Context, status string, pageReq *request.PageRequest) (*[]response.StockAlertResponse, *rest.Pagination, error)
+	AcknowledgeAlert(ctx context.Context, id *uuid.UUID) (*response.StockAlertResponse, error)
+	NotifyAlerts(ctx context.Context) (int, error)
+}
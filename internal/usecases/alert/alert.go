@@ -0,0 +1,218 @@
+package alert
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/infrastructures/actor"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/log"
+	libResponse "sagara_backend_test/lib/response"
+	"sagara_backend_test/lib/response/rest"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/pkg/constants"
+	"sagara_backend_test/pkg/constants/errorcode"
+	"time"
+)
+
+func (m *Module) GetRules(ctx context.Context) (*[]response.StockAlertRuleResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "AlertUseCases.GetRules")
+	defer span.End()
+
+	rules, err := m.alertRuleRepo.GetAll(ctx)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[AlertUseCases.GetRules] Failed to get all rule")
+		return nil, err
+	}
+
+	ruleResponses := []response.StockAlertRuleResponse{}
+	for _, rule := range *rules {
+		ruleResponses = append(ruleResponses, *toRuleResponse(&rule))
+	}
+
+	return &ruleResponses, nil
+}
+
+// SetRule sets the threshold of the wardrobe or category, it replaces the threshold of a rule already set on it.
+// The new threshold is evaluated on the next stock change of the wardrobes.
+func (m *Module) SetRule(ctx context.Context, ruleReq *request.StockAlertRuleRequest) (*response.StockAlertRuleResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "AlertUseCases.SetRule")
+	defer span.End()
+
+	var err error
+	if ruleReq.WardrobeID != nil {
+		_, err = m.wardrobeRepo.GetById(ctx, ruleReq.WardrobeID)
+	} else {
+		_, err = m.categoryRepo.GetById(ctx, ruleReq.CategoryID)
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":   err,
+			"request": ruleReq,
+		}).ErrorWithCtx(ctx, "[AlertUseCases.SetRule] Failed to get rule target")
+		return nil, err
+	}
+
+	now := time.Now()
+	rule, err := m.alertRuleRepo.Upsert(ctx, &model.StockAlertRule{
+		BaseModel: model.BaseModel{
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		ID:         uuid.New(),
+		WardrobeID: ruleReq.WardrobeID,
+		CategoryID: ruleReq.CategoryID,
+		Threshold:  ruleReq.Threshold,
+	})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":   err,
+			"request": ruleReq,
+		}).ErrorWithCtx(ctx, "[AlertUseCases.SetRule] Failed to set rule")
+		return nil, err
+	}
+
+	return toRuleResponse(rule), nil
+}
+
+func (m *Module) DeleteRule(ctx context.Context, id *uuid.UUID) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "AlertUseCases.DeleteRule")
+	defer span.End()
+
+	err := m.alertRuleRepo.Delete(ctx, id)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[AlertUseCases.DeleteRule] Failed to delete rule")
+		return err
+	}
+
+	return nil
+}
+
+// GetAlerts lists the alerts in the status, the open ones when it is empty, the most recent first by default
+func (m *Module) GetAlerts(ctx context.Context, status string, pageReq *request.PageRequest) (*[]response.StockAlertResponse, *rest.Pagination, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "AlertUseCases.GetAlerts")
+	defer span.End()
+
+	if status == constants.EmptyString {
+		status = model.StockAlertOpen
+	}
+
+	if pageReq.Sort == constants.EmptyString {
+		recentReq := *pageReq
+		recentReq.Sort = "-created_at"
+		pageReq = &recentReq
+	}
+
+	page, err := pageReq.ToPage(model.StockAlertSortFields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	alerts, pageInfo, err := m.alertRepo.GetByStatus(ctx, status, page)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":  err,
+			"status": status,
+		}).ErrorWithCtx(ctx, "[AlertUseCases.GetAlerts] Failed to get alerts")
+		return nil, nil, err
+	}
+
+	alertResponses := []response.StockAlertResponse{}
+	for _, alert := range *alerts {
+		alertResponses = append(alertResponses, *toAlertResponse(&alert))
+	}
+
+	return &alertResponses, response.NewPagination(page, pageInfo), nil
+}
+
+// AcknowledgeAlert marks an open alert as seen by the actor, it stays unresolved until the stock is back
+func (m *Module) AcknowledgeAlert(ctx context.Context, id *uuid.UUID) (*response.StockAlertResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "AlertUseCases.AcknowledgeAlert")
+	defer span.End()
+
+	res, err := m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		alert, err := m.alertRepo.GetById(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if alert.Status != model.StockAlertOpen {
+			return nil, &custerr.ErrChain{
+				Message: errorcode.AlertNotOpen.Message,
+				Code:    errorcode.AlertNotOpen.Code,
+				Type:    libResponse.ErrConflict,
+			}
+		}
+
+		now := time.Now()
+		acknowledgedBy := actor.FromContext(ctx)
+		alert.Status = model.StockAlertAcknowledged
+		alert.AcknowledgedBy = &acknowledgedBy
+		alert.AcknowledgedAt = &now
+
+		err = m.alertRepo.Update(ctx, alert)
+		if err != nil {
+			return nil, err
+		}
+
+		return alert, nil
+	}, nil)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[AlertUseCases.AcknowledgeAlert] Failed to acknowledge alert")
+		return nil, err
+	}
+
+	return toAlertResponse(res.(*model.StockAlert)), nil
+}
+
+func toRuleResponse(rule *model.StockAlertRule) *response.StockAlertRuleResponse {
+	res := &response.StockAlertRuleResponse{
+		ID:        rule.ID.String(),
+		Threshold: rule.Threshold,
+		CreatedAt: rule.CreatedAt,
+		UpdatedAt: rule.UpdatedAt,
+	}
+	if rule.WardrobeID != nil {
+		res.WardrobeID = rule.WardrobeID.String()
+	}
+	if rule.CategoryID != nil {
+		res.CategoryID = rule.CategoryID.String()
+	}
+	return res
+}
+
+func toAlertResponse(alert *model.StockAlert) *response.StockAlertResponse {
+	res := &response.StockAlertResponse{
+		ID:               alert.ID.String(),
+		WardrobeID:       alert.WardrobeID.String(),
+		Threshold:        alert.Threshold,
+		Stock:            alert.Stock,
+		Status:           alert.Status,
+		NotifiedChannels: []string(alert.NotifiedChannels),
+		NotifiedAt:       alert.NotifiedAt,
+		NotifyError:      alert.NotifyError,
+		AcknowledgedAt:   alert.AcknowledgedAt,
+		ResolvedAt:       alert.ResolvedAt,
+		CreatedAt:        alert.CreatedAt,
+	}
+	if res.NotifiedChannels == nil {
+		res.NotifiedChannels = []string{}
+	}
+	if alert.RuleID != nil {
+		res.RuleID = alert.RuleID.String()
+	}
+	if alert.AcknowledgedBy != nil {
+		res.AcknowledgedBy = *alert.AcknowledgedBy
+	}
+	return res
+}
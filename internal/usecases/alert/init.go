@@ -0,0 +1,47 @@
+package alert
+
+import (
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/internal/infrastructures/notifier"
+	"sagara_backend_test/internal/usecases"
+	"sagara_backend_test/lib/txmanager"
+)
+
+type Module struct {
+	alertRuleRepo     repository.StockAlertRuleRepository
+	alertRepo         repository.StockAlertRepository
+	wardrobeRepo      repository.WardrobeRepository
+	categoryRepo      repository.CategoryRepository
+	wardrobeUc        usecases.WardrobeUseCases
+	txMgr             txmanager.TxManager
+	notifiers         []notifier.Notifier
+	notifyBatch       int
+	maxNotifyAttempts int
+}
+
+type Opts struct {
+	AlertRuleRepo repository.StockAlertRuleRepository
+	AlertRepo     repository.StockAlertRepository
+	WardrobeRepo  repository.WardrobeRepository
+	CategoryRepo  repository.CategoryRepository
+	WardrobeUc    usecases.WardrobeUseCases
+	TxMgr         txmanager.TxManager
+	// Notifiers are the channels every alert is delivered to, alerts are only recorded without any
+	Notifiers         []notifier.Notifier
+	NotifyBatch       int
+	MaxNotifyAttempts int
+}
+
+func New(opts *Opts) usecases.AlertUseCases {
+	return &Module{
+		alertRuleRepo:     opts.AlertRuleRepo,
+		alertRepo:         opts.AlertRepo,
+		wardrobeRepo:      opts.WardrobeRepo,
+		categoryRepo:      opts.CategoryRepo,
+		wardrobeUc:        opts.WardrobeUc,
+		txMgr:             opts.TxMgr,
+		notifiers:         opts.Notifiers,
+		notifyBatch:       opts.NotifyBatch,
+		maxNotifyAttempts: opts.MaxNotifyAttempts,
+	}
+}
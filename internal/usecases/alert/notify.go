@@ -0,0 +1,111 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/infrastructures/notifier"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"time"
+)
+
+const eventLowStock = "stock.low"
+
+// NotifyAlerts delivers the alerts not notified yet to every channel. A channel that got an alert is never sent it
+// again, the others are retried on the next run until MaxNotifyAttempts is reached. It returns how many alerts were
+// fully delivered.
+func (m *Module) NotifyAlerts(ctx context.Context) (int, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "AlertUseCases.NotifyAlerts")
+	defer span.End()
+
+	res, err := m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		alerts, err := m.alertRepo.LockUnnotified(ctx, m.notifyBatch, m.maxNotifyAttempts)
+		if err != nil {
+			return 0, err
+		}
+
+		delivered := 0
+		for _, alert := range *alerts {
+			m.notify(ctx, &alert)
+
+			err = m.alertRepo.Update(ctx, &alert)
+			if err != nil {
+				return 0, err
+			}
+
+			if alert.NotifiedAt != nil {
+				delivered++
+			}
+		}
+
+		return delivered, nil
+	}, nil)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[AlertUseCases.NotifyAlerts] Failed to notify alerts")
+		return 0, err
+	}
+
+	return res.(int), nil
+}
+
+// notify sends the alert to the channels that did not get it yet and records the outcome on the alert
+func (m *Module) notify(ctx context.Context, alert *model.StockAlert) {
+	notification := m.toNotification(ctx, alert)
+
+	var errs []error
+	for _, n := range m.notifiers {
+		if alert.NotifiedChannels.Contains(n.Channel()) {
+			continue
+		}
+
+		err := n.Notify(ctx, notification)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error":   err,
+				"id":      alert.ID,
+				"channel": n.Channel(),
+			}).WarnWithCtx(ctx, "[AlertUseCases.NotifyAlerts] Failed to notify alert")
+			errs = append(errs, fmt.Errorf("%s: %w", n.Channel(), err))
+			continue
+		}
+		alert.NotifiedChannels = append(alert.NotifiedChannels, n.Channel())
+	}
+
+	alert.NotifyAttempts++
+	if len(errs) > 0 {
+		alert.NotifyError = errors.Join(errs...).Error()
+		return
+	}
+
+	now := time.Now()
+	alert.NotifiedAt = &now
+	alert.NotifyError = ""
+}
+
+func (m *Module) toNotification(ctx context.Context, alert *model.StockAlert) *notifier.Notification {
+	data := toAlertResponse(alert)
+	name := alert.WardrobeID.String()
+
+	// the wardrobe may have gone to the trash since, the alert is still worth sending
+	wardrobe, err := m.wardrobeUc.GetWardrobe(ctx, &alert.WardrobeID)
+	if err == nil {
+		data.Wardrobe = wardrobe
+		name = wardrobe.Name
+		if wardrobe.SKU != "" {
+			name += " (" + wardrobe.SKU + ")"
+		}
+	}
+
+	return &notifier.Notification{
+		Event:   eventLowStock,
+		Subject: "Low stock: " + name,
+		Text: fmt.Sprintf("The available stock of %s fell to %d, under the alert threshold of %d.\nAlert %s was raised at %s.",
+			name, alert.Stock, alert.Threshold, alert.ID, alert.CreatedAt.Format(time.RFC3339)),
+		Data:   data,
+		SentAt: time.Now(),
+	}
+}
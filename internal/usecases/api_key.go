@@ -0,0 +1,16 @@
+package usecases
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+)
+
+type APIKeyUseCases interface {
+	GetAllAPIKey(ctx context.Context) (*[]response.APIKeyResponse, error)
+	CreateAPIKey(ctx context.Context, request *request.APIKeyRequest) (*response.APIKeyResponse, error)
+	RevokeAPIKey(ctx context.Context, id *uuid.UUID) (*response.APIKeyResponse, error)
+	// Authenticate returns the active key matching the secret given by a caller
+	Authenticate(ctx context.Context, key string) (*response.APIKeyResponse, error)
+}
@@ -0,0 +1,187 @@
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/infrastructures/actor"
+	"sagara_backend_test/internal/interfaces/dao"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/log"
+	libResponse "sagara_backend_test/lib/response"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/pkg/constants/errorcode"
+	"strings"
+	"time"
+)
+
+// keyPrefix starts every key so a leaked one is easy to recognize, the rest is the public prefix and the secret
+// separated by an underscore
+const keyPrefix = "wk_"
+
+func (m *Module) GetAllAPIKey(ctx context.Context) (*[]response.APIKeyResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "APIKeyUseCases.GetAllAPIKey")
+	defer span.End()
+
+	apiKeys, err := m.apiKeyRepo.GetAll(ctx)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[APIKeyUseCases.GetAllAPIKey] Failed to get all api key")
+		return nil, err
+	}
+
+	apiKeyResponses := []response.APIKeyResponse{}
+	for _, apiKey := range *apiKeys {
+		apiKeyResponses = append(apiKeyResponses, *toAPIKeyResponse(&apiKey))
+	}
+
+	return &apiKeyResponses, nil
+}
+
+// CreateAPIKey generates a key and keeps the hash of it, the key itself is only in the response
+func (m *Module) CreateAPIKey(ctx context.Context, request *request.APIKeyRequest) (*response.APIKeyResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "APIKeyUseCases.CreateAPIKey")
+	defer span.End()
+
+	prefix, err := randomHex(8)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := randomHex(32)
+	if err != nil {
+		return nil, err
+	}
+	key := keyPrefix + prefix + "_" + secret
+
+	now := time.Now()
+	newAPIKey := &model.APIKey{
+		ID:         uuid.New(),
+		Name:       request.Name,
+		Prefix:     prefix,
+		SecretHash: hashKey(key),
+		Scopes:     request.Scopes,
+		CreatedBy:  actor.FromContext(ctx),
+		ExpiresAt:  request.ExpiresAt,
+		BaseModel: model.BaseModel{
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+
+	err = m.apiKeyRepo.Insert(ctx, newAPIKey)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"name":  request.Name,
+		}).ErrorWithCtx(ctx, "[APIKeyUseCases.CreateAPIKey] Failed to insert api key")
+		return nil, err
+	}
+
+	res := toAPIKeyResponse(newAPIKey)
+	res.Key = key
+	return res, nil
+}
+
+func (m *Module) RevokeAPIKey(ctx context.Context, id *uuid.UUID) (*response.APIKeyResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "APIKeyUseCases.RevokeAPIKey")
+	defer span.End()
+
+	err := m.apiKeyRepo.Revoke(ctx, id, time.Now())
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[APIKeyUseCases.RevokeAPIKey] Failed to revoke api key")
+		return nil, err
+	}
+
+	apiKey, err := m.apiKeyRepo.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAPIKeyResponse(apiKey), nil
+}
+
+// Authenticate finds the key by its prefix and compares the hashes in constant time, the use is recorded on success
+func (m *Module) Authenticate(ctx context.Context, key string) (*response.APIKeyResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "APIKeyUseCases.Authenticate")
+	defer span.End()
+
+	errRejected := &custerr.ErrChain{
+		Message: errorcode.APIKeyRejected.Message,
+		Code:    errorcode.APIKeyRejected.Code,
+		Type:    libResponse.ErrUnauthorized,
+	}
+
+	prefix, _, found := strings.Cut(strings.TrimPrefix(key, keyPrefix), "_")
+	if !found || !strings.HasPrefix(key, keyPrefix) {
+		return nil, errRejected
+	}
+
+	apiKey, err := m.apiKeyRepo.GetByPrefix(ctx, prefix)
+	if errors.Is(err, dao.ErrNoResult) {
+		return nil, errRejected
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if subtle.ConstantTimeCompare([]byte(hashKey(key)), []byte(apiKey.SecretHash)) != 1 || !apiKey.IsActive(now) {
+		return nil, errRejected
+	}
+
+	// failing to record the use must not refuse a valid key
+	err = m.apiKeyRepo.Touch(ctx, &apiKey.ID, now)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    apiKey.ID,
+		}).WarnWithCtx(ctx, "[APIKeyUseCases.Authenticate] Failed to record api key use")
+	}
+
+	return toAPIKeyResponse(apiKey), nil
+}
+
+// hashKey is enough for keys of 256 random bits, a slow hash only matters for secrets that can be guessed
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomHex(size int) (string, error) {
+	b := make([]byte, size)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func toAPIKeyResponse(apiKey *model.APIKey) *response.APIKeyResponse {
+	scopes := []string(apiKey.Scopes)
+	if scopes == nil {
+		scopes = []string{}
+	}
+	return &response.APIKeyResponse{
+		ID:         apiKey.ID.String(),
+		Name:       apiKey.Name,
+		Prefix:     apiKey.Prefix,
+		Subject:    apiKey.Subject(),
+		Scopes:     scopes,
+		CreatedBy:  apiKey.CreatedBy,
+		ExpiresAt:  apiKey.ExpiresAt,
+		LastUsedAt: apiKey.LastUsedAt,
+		RevokedAt:  apiKey.RevokedAt,
+		CreatedAt:  apiKey.CreatedAt,
+	}
+}
@@ -0,0 +1,20 @@
+package apikey
+
+import (
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/internal/usecases"
+)
+
+type Module struct {
+	apiKeyRepo repository.APIKeyRepository
+}
+
+type Opts struct {
+	APIKeyRepo repository.APIKeyRepository
+}
+
+func New(opts *Opts) usecases.APIKeyUseCases {
+	return &Module{
+		apiKeyRepo: opts.APIKeyRepo,
+	}
+}
@@ -0,0 +1,12 @@
+package usecases
+
+import (
+	"context"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/response/rest"
+)
+
+type AuditUseCases interface {
+	GetAuditLogs(ctx context.Context, request *request.AuditRequest, pageReq *request.PageRequest) (*[]response.AuditLogResponse, *rest.Pagination, error)
+}
@@ -0,0 +1,64 @@
+package audit
+
+import (
+	"context"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/response/rest"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/pkg/constants"
+)
+
+// GetAuditLogs lists the recorded changes, the most recent first by default
+func (m *Module) GetAuditLogs(ctx context.Context, auditReq *request.AuditRequest, pageReq *request.PageRequest) (*[]response.AuditLogResponse, *rest.Pagination, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "AuditUseCases.GetAuditLogs")
+	defer span.End()
+
+	if pageReq.Sort == constants.EmptyString {
+		recentReq := *pageReq
+		recentReq.Sort = "-created_at"
+		pageReq = &recentReq
+	}
+
+	page, err := pageReq.ToPage(model.AuditSortFields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	auditLogs, pageInfo, err := m.auditRepo.Get(ctx, &model.AuditFilter{
+		Entity:   auditReq.Entity,
+		EntityID: auditReq.ID,
+		Actor:    auditReq.Actor,
+		From:     auditReq.From,
+		To:       auditReq.To,
+	}, page)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":   err,
+			"request": auditReq,
+		}).ErrorWithCtx(ctx, "[AuditUseCases.GetAuditLogs] Failed to get audit logs")
+		return nil, nil, err
+	}
+
+	auditResponses := []response.AuditLogResponse{}
+	for _, auditLog := range *auditLogs {
+		auditResponses = append(auditResponses, *toAuditLogResponse(&auditLog))
+	}
+
+	return &auditResponses, response.NewPagination(page, pageInfo), nil
+}
+
+func toAuditLogResponse(auditLog *model.AuditLog) *response.AuditLogResponse {
+	return &response.AuditLogResponse{
+		ID:        auditLog.ID.String(),
+		Entity:    auditLog.Entity,
+		EntityID:  auditLog.EntityID.String(),
+		Action:    auditLog.Action,
+		Actor:     auditLog.Actor,
+		RequestID: auditLog.RequestID,
+		Diff:      auditLog.Diff,
+		CreatedAt: auditLog.CreatedAt,
+	}
+}
@@ -0,0 +1,20 @@
+package audit
+
+import (
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/internal/usecases"
+)
+
+type Module struct {
+	auditRepo repository.AuditRepository
+}
+
+type Opts struct {
+	AuditRepo repository.AuditRepository
+}
+
+func New(opts *Opts) usecases.AuditUseCases {
+	return &Module{
+		auditRepo: opts.AuditRepo,
+	}
+}
@@ -0,0 +1,16 @@
+package usecases
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+)
+
+type CategoryUseCases interface {
+	GetCategoryTree(ctx context.Context) (*[]response.CategoryResponse, error)
+	GetCategory(ctx context.Context, id *uuid.UUID) (*response.CategoryResponse, error)
+	InsertCategory(ctx context.Context, request *request.CategoryRequest) (*response.CategoryResponse, error)
+	UpdateCategory(ctx context.Context, id *uuid.UUID, request *request.CategoryRequest) (*response.CategoryResponse, error)
+	DeleteCategory(ctx context.Context, id *uuid.UUID) error
+}
@@ -0,0 +1,219 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/interfaces/dao"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/log"
+	libResponse "sagara_backend_test/lib/response"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/pkg/constants/errorcode"
+	"time"
+)
+
+// GetCategoryTree returns the root categories with their descendants nested as children
+func (m *Module) GetCategoryTree(ctx context.Context) (*[]response.CategoryResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "CategoryUseCases.GetCategoryTree")
+	defer span.End()
+
+	categories, err := m.categoryRepo.GetAll(ctx)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[CategoryUseCases.GetCategoryTree] Failed to get all category")
+		return nil, err
+	}
+
+	children := map[uuid.UUID][]model.Category{}
+	var roots []model.Category
+	for _, category := range *categories {
+		if category.ParentID == nil {
+			roots = append(roots, category)
+			continue
+		}
+		children[*category.ParentID] = append(children[*category.ParentID], category)
+	}
+
+	categoryResponses := []response.CategoryResponse{}
+	for _, root := range roots {
+		categoryResponses = append(categoryResponses, *toCategoryTree(&root, children))
+	}
+
+	return &categoryResponses, nil
+}
+
+func (m *Module) GetCategory(ctx context.Context, id *uuid.UUID) (*response.CategoryResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "CategoryUseCases.GetCategory")
+	defer span.End()
+
+	category, err := m.categoryRepo.GetById(ctx, id)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[CategoryUseCases.GetCategory] Failed to get category by ID")
+		return nil, err
+	}
+
+	return toCategoryResponse(category), nil
+}
+
+func (m *Module) InsertCategory(ctx context.Context, request *request.CategoryRequest) (*response.CategoryResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "CategoryUseCases.InsertCategory")
+	defer span.End()
+
+	if request.ParentID != nil {
+		_, err := m.categoryRepo.GetById(ctx, request.ParentID)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error":   err,
+				"request": request,
+			}).ErrorWithCtx(ctx, "[CategoryUseCases.InsertCategory] Failed to get parent category")
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	newCategory := &model.Category{
+		BaseModel: model.BaseModel{
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		ID:       uuid.New(),
+		ParentID: request.ParentID,
+		Name:     request.Name,
+	}
+
+	err := m.categoryRepo.Insert(ctx, newCategory)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":   err,
+			"request": request,
+		}).ErrorWithCtx(ctx, "[CategoryUseCases.InsertCategory] Failed to insert category")
+		return nil, err
+	}
+
+	return toCategoryResponse(newCategory), nil
+}
+
+// UpdateCategory renames or moves a category, moving it under itself or one of its descendants is rejected
+func (m *Module) UpdateCategory(ctx context.Context, id *uuid.UUID, request *request.CategoryRequest) (*response.CategoryResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "CategoryUseCases.UpdateCategory")
+	defer span.End()
+
+	existingCategory, err := m.categoryRepo.GetById(ctx, id)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[CategoryUseCases.UpdateCategory] Failed to get category by ID")
+		return nil, err
+	}
+
+	if request.ParentID != nil {
+		_, err = m.categoryRepo.GetById(ctx, request.ParentID)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error":   err,
+				"request": request,
+			}).ErrorWithCtx(ctx, "[CategoryUseCases.UpdateCategory] Failed to get parent category")
+			return nil, err
+		}
+
+		categories, err := m.categoryRepo.GetAll(ctx)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error": err,
+				"id":    id,
+			}).ErrorWithCtx(ctx, "[CategoryUseCases.UpdateCategory] Failed to get all category")
+			return nil, err
+		}
+
+		if createsCycle(*categories, *id, *request.ParentID) {
+			return nil, &custerr.ErrChain{
+				Message: errorcode.CategoryCycle.Message,
+				Code:    errorcode.CategoryCycle.Code,
+				Type:    libResponse.ErrBadRequest,
+			}
+		}
+	}
+
+	existingCategory.ParentID = request.ParentID
+	existingCategory.Name = request.Name
+
+	err = m.categoryRepo.Update(ctx, existingCategory)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[CategoryUseCases.UpdateCategory] Failed to update category")
+		return nil, err
+	}
+
+	return toCategoryResponse(existingCategory), nil
+}
+
+func (m *Module) DeleteCategory(ctx context.Context, id *uuid.UUID) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "CategoryUseCases.DeleteCategory")
+	defer span.End()
+
+	err := m.categoryRepo.Delete(ctx, id)
+	if errors.Is(err, dao.ErrReferenced) {
+		return &custerr.ErrChain{
+			Message: errorcode.CategoryInUse.Message,
+			Code:    errorcode.CategoryInUse.Code,
+			Type:    libResponse.ErrConflict,
+		}
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[CategoryUseCases.DeleteCategory] Failed to delete category")
+		return err
+	}
+
+	return nil
+}
+
+// createsCycle walks up from the new parent, reaching the category itself means the move would create a cycle
+func createsCycle(categories []model.Category, id, parentId uuid.UUID) bool {
+	parents := map[uuid.UUID]*uuid.UUID{}
+	for _, category := range categories {
+		parents[category.ID] = category.ParentID
+	}
+
+	for current := &parentId; current != nil; current = parents[*current] {
+		if *current == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func toCategoryTree(category *model.Category, children map[uuid.UUID][]model.Category) *response.CategoryResponse {
+	res := toCategoryResponse(category)
+	for _, child := range children[category.ID] {
+		res.Children = append(res.Children, *toCategoryTree(&child, children))
+	}
+	return res
+}
+
+func toCategoryResponse(category *model.Category) *response.CategoryResponse {
+	res := &response.CategoryResponse{
+		ID:        category.ID.String(),
+		Name:      category.Name,
+		CreatedAt: category.CreatedAt,
+		UpdatedAt: category.UpdatedAt,
+	}
+	if category.ParentID != nil {
+		res.ParentID = category.ParentID.String()
+	}
+	return res
+}
@@ -0,0 +1,20 @@
+package category
+
+import (
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/internal/usecases"
+)
+
+type Module struct {
+	categoryRepo repository.CategoryRepository
+}
+
+type Opts struct {
+	CategoryRepo repository.CategoryRepository
+}
+
+func New(opts *Opts) usecases.CategoryUseCases {
+	return &Module{
+		categoryRepo: opts.CategoryRepo,
+	}
+}
@@ -0,0 +1,14 @@
+package usecases
+
+import (
+	"context"
+	"sagara_backend_test/internal/usecases/response"
+)
+
+type IdempotencyUseCases interface {
+	// Begin claims the key for the request of the fingerprint, the stored response is returned when it was answered
+	Begin(ctx context.Context, key, fingerprint string) (*response.IdempotentResponse, error)
+	Complete(ctx context.Context, key string, res *response.IdempotentResponse) error
+	Release(ctx context.Context, key string) error
+	PurgeExpired(ctx context.Context) (int64, error)
+}
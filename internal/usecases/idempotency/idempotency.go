@@ -0,0 +1,119 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/interfaces/dao"
+	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/log"
+	libResponse "sagara_backend_test/lib/response"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/pkg/constants/errorcode"
+	"time"
+)
+
+// Begin claims the key, a key that is held by another request is refused with a conflict: either the request is
+// still being processed, or the key was used for a request with another fingerprint
+func (m *Module) Begin(ctx context.Context, key, fingerprint string) (*response.IdempotentResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "IdempotencyUseCases.Begin")
+	defer span.End()
+
+	errInProgress := &custerr.ErrChain{
+		Message: errorcode.IdempotencyKeyInProgress.Message,
+		Code:    errorcode.IdempotencyKeyInProgress.Code,
+		Type:    libResponse.ErrConflict,
+	}
+
+	now := time.Now()
+	claimed, err := m.idempotencyKeyRepo.Claim(ctx, &model.IdempotencyKey{
+		Key:         key,
+		Fingerprint: fingerprint,
+		LockedUntil: now.Add(m.lockTimeout),
+		ExpiresAt:   now.Add(m.ttl),
+		CreatedAt:   now,
+	})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"key":   key,
+		}).ErrorWithCtx(ctx, "[IdempotencyUseCases.Begin] Failed to claim idempotency key")
+		return nil, err
+	}
+	if claimed {
+		return nil, nil
+	}
+
+	existing, err := m.idempotencyKeyRepo.GetByKey(ctx, key)
+	if errors.Is(err, dao.ErrNoResult) {
+		// the holder released the key in between, the caller can retry right away
+		return nil, errInProgress
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if existing.Fingerprint != fingerprint {
+		return nil, &custerr.ErrChain{
+			Message: errorcode.IdempotencyKeyReused.Message,
+			Code:    errorcode.IdempotencyKeyReused.Code,
+			Type:    libResponse.ErrConflict,
+		}
+	}
+	if existing.StatusCode == nil {
+		return nil, errInProgress
+	}
+
+	return &response.IdempotentResponse{
+		StatusCode:  *existing.StatusCode,
+		ContentType: existing.ContentType,
+		Body:        existing.Body,
+	}, nil
+}
+
+func (m *Module) Complete(ctx context.Context, key string, res *response.IdempotentResponse) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "IdempotencyUseCases.Complete")
+	defer span.End()
+
+	now := time.Now()
+	err := m.idempotencyKeyRepo.Complete(ctx, &model.IdempotencyKey{
+		Key:         key,
+		StatusCode:  &res.StatusCode,
+		ContentType: res.ContentType,
+		Body:        res.Body,
+		CompletedAt: &now,
+	})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"key":   key,
+		}).ErrorWithCtx(ctx, "[IdempotencyUseCases.Complete] Failed to store the response of idempotency key")
+		return err
+	}
+
+	return nil
+}
+
+func (m *Module) Release(ctx context.Context, key string) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "IdempotencyUseCases.Release")
+	defer span.End()
+
+	return m.idempotencyKeyRepo.Release(ctx, key)
+}
+
+// PurgeExpired deletes the keys past their TTL, they would be claimed again anyway
+func (m *Module) PurgeExpired(ctx context.Context) (int64, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "IdempotencyUseCases.PurgeExpired")
+	defer span.End()
+
+	purged, err := m.idempotencyKeyRepo.DeleteExpired(ctx, time.Now())
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[IdempotencyUseCases.PurgeExpired] Failed to purge expired idempotency key")
+		return 0, err
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,29 @@
+package idempotency
+
+import (
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/internal/usecases"
+	"time"
+)
+
+type Module struct {
+	idempotencyKeyRepo repository.IdempotencyKeyRepository
+	ttl                time.Duration
+	lockTimeout        time.Duration
+}
+
+type Opts struct {
+	IdempotencyKeyRepo repository.IdempotencyKeyRepository
+	// TTL is how long a key is remembered, a retry after it runs the request again
+	TTL time.Duration
+	// LockTimeout is how long a request holds its key before a retry may take over, when it never answered
+	LockTimeout time.Duration
+}
+
+func New(opts *Opts) usecases.IdempotencyUseCases {
+	return &Module{
+		idempotencyKeyRepo: opts.IdempotencyKeyRepo,
+		ttl:                opts.TTL,
+		lockTimeout:        opts.LockTimeout,
+	}
+}
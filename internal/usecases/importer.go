@@ -0,0 +1,13 @@
+package usecases
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+)
+
+type ImportUseCases interface {
+	ImportWardrobe(ctx context.Context, request *request.WardrobeImportRequest) (*response.ImportJobResponse, error)
+	GetImportJob(ctx context.Context, id *uuid.UUID) (*response.ImportJobResponse, error)
+}
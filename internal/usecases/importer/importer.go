@@ -0,0 +1,464 @@
+package importer
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"runtime/debug"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/infrastructures/actor"
+	"sagara_backend_test/internal/infrastructures/spreadsheet"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/log"
+	libResponse "sagara_backend_test/lib/response"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/pkg/constants"
+	"sagara_backend_test/pkg/constants/errorcode"
+	"strconv"
+	"time"
+)
+
+// importPriceReason is recorded in the price history of the wardrobes an import reprices
+const importPriceReason = "import"
+
+// plannedRow is a row of the file turned into the insert or update it stands for
+type plannedRow struct {
+	result  model.ImportRowResult
+	insert  *request.WardrobeInsertRequest
+	update  *request.WardrobeUpdateRequest
+	id      uuid.UUID
+	version int
+}
+
+func (p *plannedRow) fail(err error) {
+	p.result.Action = model.ImportActionError
+	p.result.Errors = append(p.result.Errors, rowError(err))
+}
+
+// ImportWardrobe creates the rows whose sku is new and updates the wardrobes whose sku is known,
+// empty cells keep the current value of an update. Nothing is written unless every row is valid.
+// Files with more rows than asyncRows are imported in the background and their job is returned while pending.
+func (m *Module) ImportWardrobe(ctx context.Context, importReq *request.WardrobeImportRequest) (*response.ImportJobResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ImportUseCases.ImportWardrobe")
+	defer span.End()
+
+	rows, err := spreadsheet.Read(importReq.Format, importReq.Data)
+	if err != nil {
+		return nil, &custerr.ErrChain{
+			Message: errorcode.ImportFileInvalid.Message,
+			Cause:   err,
+			Code:    errorcode.ImportFileInvalid.Code,
+			Type:    libResponse.ErrBadRequest,
+		}
+	}
+
+	records, err := readRecords(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.maxRows > 0 && len(records) > m.maxRows {
+		return nil, &custerr.ErrChain{
+			Message: errorcode.ImportTooManyRows.Message,
+			Code:    errorcode.ImportTooManyRows.Code,
+			Type:    libResponse.ErrRequestTooLarge,
+		}
+	}
+
+	now := time.Now()
+	job := &model.ImportJob{
+		BaseModel: model.BaseModel{
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		ID:        uuid.New(),
+		FileName:  importReq.FileName,
+		Format:    importReq.Format,
+		DryRun:    importReq.DryRun,
+		Status:    model.ImportJobPending,
+		TotalRows: len(records),
+		CreatedBy: actor.FromContext(ctx),
+	}
+
+	err = m.importJobRepo.Insert(ctx, job)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":     err,
+			"file_name": importReq.FileName,
+		}).ErrorWithCtx(ctx, "[ImportUseCases.ImportWardrobe] Failed to insert import job")
+		return nil, err
+	}
+
+	if len(records) > m.asyncRows {
+		res := toImportJobResponse(job)
+		// the job outlives the request, only the values of its context are kept
+		go m.runJob(context.WithoutCancel(ctx), job, records)
+		return res, nil
+	}
+
+	m.runJob(ctx, job, records)
+	return toImportJobResponse(job), nil
+}
+
+func (m *Module) GetImportJob(ctx context.Context, id *uuid.UUID) (*response.ImportJobResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ImportUseCases.GetImportJob")
+	defer span.End()
+
+	job, err := m.importJobRepo.GetById(ctx, id)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[ImportUseCases.GetImportJob] Failed to get import job by ID")
+		return nil, err
+	}
+
+	return toImportJobResponse(job), nil
+}
+
+// runJob imports the records and saves the outcome on the job, failures end up in the job instead of being returned
+func (m *Module) runJob(ctx context.Context, job *model.ImportJob, records []importRecord) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ImportUseCases.runJob")
+	defer span.End()
+
+	// the job may run in a goroutine of its own, a panic must neither take the server down nor leave the job running
+	defer func() {
+		if r := recover(); r != nil {
+			log.WithFields(log.Fields{
+				"panic": r,
+				"id":    job.ID,
+				"stack": string(debug.Stack()),
+			}).ErrorWithCtx(ctx, "[ImportUseCases.runJob] Import job panicked")
+
+			job.Status = model.ImportJobFailed
+			job.Error = fmt.Sprintf("import failed unexpectedly: %v", r)
+			finishedAt := time.Now()
+			job.FinishedAt = &finishedAt
+			err := m.importJobRepo.Update(ctx, job)
+			if err != nil {
+				log.WithFields(log.Fields{
+					"error": err,
+					"id":    job.ID,
+				}).ErrorWithCtx(ctx, "[ImportUseCases.runJob] Failed to finish import job")
+			}
+		}
+	}()
+
+	job.Status = model.ImportJobRunning
+	err := m.importJobRepo.Update(ctx, job)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    job.ID,
+		}).ErrorWithCtx(ctx, "[ImportUseCases.runJob] Failed to start import job")
+	}
+
+	planned, err := m.importRecords(ctx, job.DryRun, records)
+
+	job.Report = model.ImportReport{}
+	job.Created, job.Updated, job.Failed = 0, 0, 0
+	for _, row := range planned {
+		job.Report = append(job.Report, row.result)
+		switch row.result.Action {
+		case model.ImportActionCreate:
+			job.Created++
+		case model.ImportActionUpdate:
+			job.Updated++
+		default:
+			job.Failed++
+		}
+	}
+
+	job.Status = model.ImportJobSucceeded
+	if err != nil {
+		job.Status = model.ImportJobFailed
+		job.Error = rowError(err)
+	}
+	finishedAt := time.Now()
+	job.FinishedAt = &finishedAt
+
+	err = m.importJobRepo.Update(ctx, job)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    job.ID,
+		}).ErrorWithCtx(ctx, "[ImportUseCases.runJob] Failed to finish import job")
+	}
+}
+
+// importRecords plans every row and, unless it is a dry run, applies them in a single transaction.
+// A row failing while being applied rolls the whole file back.
+func (m *Module) importRecords(ctx context.Context, dryRun bool, records []importRecord) ([]plannedRow, error) {
+	planned, err := m.planRecords(ctx, records)
+	if err != nil {
+		return nil, err
+	}
+
+	invalid := 0
+	for _, row := range planned {
+		if row.result.Action == model.ImportActionError {
+			invalid++
+		}
+	}
+	if dryRun {
+		return planned, nil
+	}
+	if invalid > 0 {
+		return planned, fmt.Errorf("%d of %d rows are invalid, nothing was imported", invalid, len(planned))
+	}
+
+	_, err = m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		for i := range planned {
+			row := &planned[i]
+			switch row.result.Action {
+			case model.ImportActionCreate:
+				res, err := m.wardrobeUc.InsertWardrobe(ctx, row.insert)
+				if err != nil {
+					row.fail(err)
+					return nil, fmt.Errorf("row %d: %s, nothing was imported", row.result.Row, rowError(err))
+				}
+				id := uuid.MustParse(res.ID)
+				row.result.WardrobeID = &id
+			case model.ImportActionUpdate:
+				_, err := m.wardrobeUc.UpdateWardrobe(ctx, &row.id, row.version, row.update)
+				if err != nil {
+					row.fail(err)
+					return nil, fmt.Errorf("row %d: %s, nothing was imported", row.result.Row, rowError(err))
+				}
+			}
+		}
+		return nil, nil
+	}, nil)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[ImportUseCases.importRecords] Failed to import wardrobe")
+		return planned, err
+	}
+
+	return planned, nil
+}
+
+// planRecords validates every row against the file and the database without writing anything
+func (m *Module) planRecords(ctx context.Context, records []importRecord) ([]plannedRow, error) {
+	var (
+		skus       []string
+		productIds []uuid.UUID
+	)
+	for _, record := range records {
+		if sku, found := record.cells[columnSKU]; found {
+			skus = append(skus, sku)
+		}
+		if productId, err := uuid.Parse(record.cells[columnProductID]); err == nil {
+			productIds = append(productIds, productId)
+		}
+	}
+
+	existing := map[string]model.Wardrobe{}
+	if len(skus) > 0 {
+		wardrobes, err := m.wardrobeRepo.GetBySKUs(ctx, skus)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error": err,
+			}).ErrorWithCtx(ctx, "[ImportUseCases.planRecords] Failed to get wardrobe by skus")
+			return nil, err
+		}
+		for _, wardrobe := range *wardrobes {
+			existing[*wardrobe.SKU] = wardrobe
+		}
+	}
+
+	products := map[uuid.UUID]bool{}
+	if len(productIds) > 0 {
+		found, err := m.productRepo.GetByIds(ctx, productIds)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error": err,
+			}).ErrorWithCtx(ctx, "[ImportUseCases.planRecords] Failed to get products")
+			return nil, err
+		}
+		for _, product := range *found {
+			products[product.ID] = true
+		}
+	}
+
+	seen := map[string]bool{}
+	planned := make([]plannedRow, 0, len(records))
+	for _, record := range records {
+		row := plannedRow{
+			result: model.ImportRowResult{
+				Row: record.line,
+				SKU: record.cells[columnSKU],
+			},
+		}
+
+		if row.result.SKU != constants.EmptyString {
+			if seen[row.result.SKU] {
+				row.fail(errSKURepeated)
+				planned = append(planned, row)
+				continue
+			}
+			seen[row.result.SKU] = true
+		}
+
+		var productId *uuid.UUID
+		if record.has(columnProductID) {
+			id, err := uuid.Parse(record.cells[columnProductID])
+			switch {
+			case err != nil:
+				row.fail(errProductIDInvalid)
+			case !products[id]:
+				row.fail(errProductNotFound)
+			default:
+				productId = &id
+			}
+		}
+
+		if wardrobe, found := existing[row.result.SKU]; found {
+			planUpdate(&row, &record, &wardrobe, productId)
+		} else {
+			planInsert(&row, &record, productId)
+		}
+
+		planned = append(planned, row)
+	}
+
+	return planned, nil
+}
+
+func planInsert(row *plannedRow, record *importRecord, productId *uuid.UUID) {
+	insertReq := &request.WardrobeInsertRequest{
+		ProductID: productId,
+		SKU:       record.cells[columnSKU],
+		Name:      record.cells[columnName],
+		Color:     record.cells[columnColor],
+		Size:      record.cells[columnSize],
+		Currency:  record.cells[columnCurrency],
+	}
+
+	if record.has(columnPrice) {
+		price, err := model.ParseMoney(record.cells[columnPrice])
+		if err != nil {
+			row.fail(err)
+		} else {
+			insertReq.Price = &price
+		}
+	}
+
+	if record.has(columnStock) {
+		stock, err := parseStock(record.cells[columnStock])
+		if err != nil {
+			row.fail(err)
+		}
+		insertReq.Stock = stock
+	}
+
+	if err := insertReq.ValidateInsertWardrobe(); err != nil {
+		row.fail(err)
+	}
+
+	if row.result.Action != model.ImportActionError {
+		row.result.Action = model.ImportActionCreate
+		row.insert = insertReq
+	}
+}
+
+func planUpdate(row *plannedRow, record *importRecord, wardrobe *model.Wardrobe, productId *uuid.UUID) {
+	updateReq := &request.WardrobeUpdateRequest{
+		SKU:         *wardrobe.SKU,
+		Name:        wardrobe.Name,
+		Color:       wardrobe.Color,
+		Size:        wardrobe.Size,
+		Price:       wardrobe.Price,
+		Currency:    wardrobe.Currency,
+		PriceReason: importPriceReason,
+		Stock:       wardrobe.Stock,
+	}
+
+	if productId != nil && (wardrobe.ProductID == nil || *productId != *wardrobe.ProductID) {
+		row.fail(errProductChanged)
+	}
+	if record.has(columnName) {
+		updateReq.Name = record.cells[columnName]
+	}
+	if record.has(columnColor) {
+		updateReq.Color = record.cells[columnColor]
+	}
+	if record.has(columnSize) {
+		updateReq.Size = record.cells[columnSize]
+	}
+	if record.has(columnCurrency) {
+		updateReq.Currency = record.cells[columnCurrency]
+	}
+
+	if record.has(columnPrice) {
+		price, err := model.ParseMoney(record.cells[columnPrice])
+		if err != nil {
+			row.fail(err)
+		}
+		updateReq.Price = price
+	}
+
+	if record.has(columnStock) {
+		stock, err := parseStock(record.cells[columnStock])
+		if err != nil {
+			row.fail(err)
+		} else if stock < wardrobe.Reserved {
+			row.fail(errReservedStockTaken)
+		}
+		updateReq.Stock = stock
+	}
+
+	if err := updateReq.ValidateUpdateWardrobe(); err != nil {
+		row.fail(err)
+	}
+
+	row.result.WardrobeID = &wardrobe.ID
+	if row.result.Action != model.ImportActionError {
+		row.result.Action = model.ImportActionUpdate
+		row.update = updateReq
+		row.id = wardrobe.ID
+		row.version = wardrobe.Version
+	}
+}
+
+func parseStock(cell string) (int, error) {
+	stock, err := strconv.Atoi(cell)
+	if err != nil || stock < 0 {
+		return 0, errStockInvalid
+	}
+	return stock, nil
+}
+
+func toImportJobResponse(job *model.ImportJob) *response.ImportJobResponse {
+	res := &response.ImportJobResponse{
+		ID:         job.ID.String(),
+		FileName:   job.FileName,
+		Format:     job.Format,
+		DryRun:     job.DryRun,
+		Status:     job.Status,
+		TotalRows:  job.TotalRows,
+		Created:    job.Created,
+		Updated:    job.Updated,
+		Failed:     job.Failed,
+		Error:      job.Error,
+		CreatedBy:  job.CreatedBy,
+		CreatedAt:  job.CreatedAt,
+		FinishedAt: job.FinishedAt,
+	}
+	for _, result := range job.Report {
+		row := response.ImportRowResponse{
+			Row:    result.Row,
+			Action: result.Action,
+			SKU:    result.SKU,
+			Errors: result.Errors,
+		}
+		if result.WardrobeID != nil {
+			row.WardrobeID = result.WardrobeID.String()
+		}
+		res.Rows = append(res.Rows, row)
+	}
+	return res
+}
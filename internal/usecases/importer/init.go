@@ -0,0 +1,40 @@
+package importer
+
+import (
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/internal/usecases"
+	"sagara_backend_test/lib/txmanager"
+)
+
+type Module struct {
+	importJobRepo repository.ImportJobRepository
+	wardrobeRepo  repository.WardrobeRepository
+	productRepo   repository.ProductRepository
+	wardrobeUc    usecases.WardrobeUseCases
+	txMgr         txmanager.TxManager
+	asyncRows     int
+	maxRows       int
+}
+
+type Opts struct {
+	ImportJobRepo repository.ImportJobRepository
+	WardrobeRepo  repository.WardrobeRepository
+	ProductRepo   repository.ProductRepository
+	WardrobeUc    usecases.WardrobeUseCases
+	TxMgr         txmanager.TxManager
+	// AsyncRows is the number of rows above which a file is imported in the background
+	AsyncRows int
+	MaxRows   int
+}
+
+func New(opts *Opts) usecases.ImportUseCases {
+	return &Module{
+		importJobRepo: opts.ImportJobRepo,
+		wardrobeRepo:  opts.WardrobeRepo,
+		productRepo:   opts.ProductRepo,
+		wardrobeUc:    opts.WardrobeUc,
+		txMgr:         opts.TxMgr,
+		asyncRows:     opts.AsyncRows,
+		maxRows:       opts.MaxRows,
+	}
+}
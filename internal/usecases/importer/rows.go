@@ -0,0 +1,113 @@
+package importer
+
+import (
+	"errors"
+	"sagara_backend_test/lib/custerr"
+	libResponse "sagara_backend_test/lib/response"
+	"sagara_backend_test/pkg/constants"
+	"sagara_backend_test/pkg/constants/errorcode"
+	"slices"
+	"strings"
+)
+
+const (
+	columnSKU       = "sku"
+	columnName      = "name"
+	columnColor     = "color"
+	columnSize      = "size"
+	columnPrice     = "price"
+	columnCurrency  = "currency"
+	columnStock     = "stock"
+	columnProductID = "product_id"
+)
+
+var importColumns = []string{columnSKU, columnName, columnColor, columnSize, columnPrice, columnCurrency, columnStock, columnProductID}
+
+// exportOnlyColumns are written by the export but can not be imported, they are skipped so an export can be imported back
+var exportOnlyColumns = []string{"id", "category_id", "reserved", "available"}
+
+var (
+	errStockInvalid       = errors.New("stock must be a whole number that is not negative")
+	errProductIDInvalid   = errors.New("product_id must be a uuid")
+	errProductNotFound    = errors.New("product_id does not match any product")
+	errProductChanged     = errors.New("product_id of an existing wardrobe can not be changed")
+	errSKURepeated        = errors.New("sku is repeated in the file")
+	errReservedStockTaken = errors.New("stock is below the reserved stock")
+)
+
+// importRecord is a row of the file with its non empty cells by column
+type importRecord struct {
+	line  int
+	cells map[string]string
+}
+
+func (r *importRecord) has(column string) bool {
+	_, found := r.cells[column]
+	return found
+}
+
+// readRecords maps the cells to the columns of the header, blank rows are skipped.
+// Header names ignore case and spaces so "Product ID" is product_id.
+func readRecords(rows [][]string) ([]importRecord, error) {
+	if len(rows) == 0 {
+		return nil, errFileEmpty()
+	}
+
+	var columns []string
+	for _, name := range rows[0] {
+		column := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), " ", "_")
+		if slices.Contains(exportOnlyColumns, column) {
+			// an empty column name is never read
+			columns = append(columns, constants.EmptyString)
+			continue
+		}
+		if !slices.Contains(importColumns, column) || slices.Contains(columns, column) {
+			return nil, &custerr.ErrChain{
+				Message: errorcode.ImportColumnInvalid.Message,
+				Code:    errorcode.ImportColumnInvalid.Code,
+				Type:    libResponse.ErrBadRequest,
+			}
+		}
+		columns = append(columns, column)
+	}
+
+	var records []importRecord
+	for i, row := range rows[1:] {
+		record := importRecord{
+			// the header is line 1
+			line:  i + 2,
+			cells: map[string]string{},
+		}
+		for idx, cell := range row {
+			cell = strings.TrimSpace(cell)
+			if idx < len(columns) && columns[idx] != constants.EmptyString && cell != constants.EmptyString {
+				record.cells[columns[idx]] = cell
+			}
+		}
+		if len(record.cells) > 0 {
+			records = append(records, record)
+		}
+	}
+
+	if len(records) == 0 {
+		return nil, errFileEmpty()
+	}
+	return records, nil
+}
+
+func errFileEmpty() error {
+	return &custerr.ErrChain{
+		Message: errorcode.ImportFileEmpty.Message,
+		Code:    errorcode.ImportFileEmpty.Code,
+		Type:    libResponse.ErrBadRequest,
+	}
+}
+
+// rowError is the message of an error as the client would see it
+func rowError(err error) string {
+	var e *custerr.ErrChain
+	if errors.As(err, &e) && e.Message != constants.EmptyString {
+		return e.Message
+	}
+	return err.Error()
+}
@@ -0,0 +1,16 @@
+package usecases
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+)
+
+type LocationUseCases interface {
+	GetAllLocation(ctx context.Context) (*[]response.LocationResponse, error)
+	GetLocation(ctx context.Context, id *uuid.UUID) (*response.LocationResponse, error)
+	InsertLocation(ctx context.Context, request *request.LocationRequest) (*response.LocationResponse, error)
+	UpdateLocation(ctx context.Context, id *uuid.UUID, request *request.LocationRequest) (*response.LocationResponse, error)
+	DeleteLocation(ctx context.Context, id *uuid.UUID) error
+}
@@ -0,0 +1,20 @@
+package location
+
+import (
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/internal/usecases"
+)
+
+type Module struct {
+	locationRepo repository.LocationRepository
+}
+
+type Opts struct {
+	LocationRepo repository.LocationRepository
+}
+
+func New(opts *Opts) usecases.LocationUseCases {
+	return &Module{
+		locationRepo: opts.LocationRepo,
+	}
+}
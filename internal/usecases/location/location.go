@@ -0,0 +1,175 @@
+package location
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/interfaces/dao"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/log"
+	libResponse "sagara_backend_test/lib/response"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/pkg/constants/errorcode"
+	"time"
+)
+
+func (m *Module) GetAllLocation(ctx context.Context) (*[]response.LocationResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "LocationUseCases.GetAllLocation")
+	defer span.End()
+
+	locations, err := m.locationRepo.GetAll(ctx)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[LocationUseCases.GetAllLocation] Failed to get all location")
+		return nil, err
+	}
+
+	locationResponses := []response.LocationResponse{}
+	for _, location := range *locations {
+		locationResponses = append(locationResponses, *toLocationResponse(&location))
+	}
+
+	return &locationResponses, nil
+}
+
+func (m *Module) GetLocation(ctx context.Context, id *uuid.UUID) (*response.LocationResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "LocationUseCases.GetLocation")
+	defer span.End()
+
+	location, err := m.locationRepo.GetById(ctx, id)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[LocationUseCases.GetLocation] Failed to get location by ID")
+		return nil, err
+	}
+
+	return toLocationResponse(location), nil
+}
+
+func (m *Module) InsertLocation(ctx context.Context, request *request.LocationRequest) (*response.LocationResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "LocationUseCases.InsertLocation")
+	defer span.End()
+
+	now := time.Now()
+	newLocation := &model.Location{
+		ID:   uuid.New(),
+		Code: request.Code,
+		Name: request.Name,
+		Kind: request.Kind,
+		BaseModel: model.BaseModel{
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+
+	err := m.locationRepo.Insert(ctx, newLocation)
+	if errors.Is(err, dao.ErrDuplicate) {
+		return nil, errCodeDuplicate()
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":   err,
+			"request": request,
+		}).ErrorWithCtx(ctx, "[LocationUseCases.InsertLocation] Failed to insert location")
+		return nil, err
+	}
+
+	return toLocationResponse(newLocation), nil
+}
+
+func (m *Module) UpdateLocation(ctx context.Context, id *uuid.UUID, request *request.LocationRequest) (*response.LocationResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "LocationUseCases.UpdateLocation")
+	defer span.End()
+
+	location, err := m.locationRepo.GetById(ctx, id)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[LocationUseCases.UpdateLocation] Failed to get location by ID")
+		return nil, err
+	}
+
+	location.Code = request.Code
+	location.Name = request.Name
+	location.Kind = request.Kind
+
+	err = m.locationRepo.Update(ctx, location)
+	if errors.Is(err, dao.ErrDuplicate) {
+		return nil, errCodeDuplicate()
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":   err,
+			"id":      id,
+			"request": request,
+		}).ErrorWithCtx(ctx, "[LocationUseCases.UpdateLocation] Failed to update location")
+		return nil, err
+	}
+
+	return toLocationResponse(location), nil
+}
+
+// DeleteLocation removes a location without stock or transfers, the default location can not be removed
+func (m *Module) DeleteLocation(ctx context.Context, id *uuid.UUID) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "LocationUseCases.DeleteLocation")
+	defer span.End()
+
+	errInUse := &custerr.ErrChain{
+		Message: errorcode.LocationInUse.Message,
+		Code:    errorcode.LocationInUse.Code,
+		Type:    libResponse.ErrConflict,
+	}
+
+	location, err := m.locationRepo.GetById(ctx, id)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[LocationUseCases.DeleteLocation] Failed to get location by ID")
+		return err
+	}
+	if location.IsDefault {
+		return errInUse
+	}
+
+	err = m.locationRepo.Delete(ctx, id)
+	if errors.Is(err, dao.ErrReferenced) {
+		return errInUse
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[LocationUseCases.DeleteLocation] Failed to delete location")
+		return err
+	}
+
+	return nil
+}
+
+func errCodeDuplicate() error {
+	return &custerr.ErrChain{
+		Message: errorcode.LocationCodeDuplicate.Message,
+		Code:    errorcode.LocationCodeDuplicate.Code,
+		Type:    libResponse.ErrConflict,
+	}
+}
+
+func toLocationResponse(location *model.Location) *response.LocationResponse {
+	return &response.LocationResponse{
+		ID:        location.ID.String(),
+		Code:      location.Code,
+		Name:      location.Name,
+		Kind:      location.Kind,
+		IsDefault: location.IsDefault,
+		CreatedAt: location.CreatedAt,
+		UpdatedAt: location.UpdatedAt,
+	}
+}
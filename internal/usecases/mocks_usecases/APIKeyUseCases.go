@@ -0,0 +1,137 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_usecases
+
+import (
+	context "context"
+	request "sagara_backend_test/internal/usecases/request"
+
+	mock "github.com/stretchr/testify/mock"
+
+	response "sagara_backend_test/internal/usecases/response"
+
+	uuid "github.com/google/uuid"
+)
+
+// APIKeyUseCases is an autogenerated mock type for the APIKeyUseCases type
+type APIKeyUseCases struct {
+	mock.Mock
+}
+
+// Authenticate provides a mock function with given fields: ctx, key
+func (_m *APIKeyUseCases) Authenticate(ctx context.Context, key string) (*response.APIKeyResponse, error) {
+	ret := _m.Called(ctx, key)
+
+	var r0 *response.APIKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*response.APIKeyResponse, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *response.APIKeyResponse); ok {
+		r0 = rf(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.APIKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateAPIKey provides a mock function with given fields: ctx, _a1
+func (_m *APIKeyUseCases) CreateAPIKey(ctx context.Context, _a1 *request.APIKeyRequest) (*response.APIKeyResponse, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 *response.APIKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *request.APIKeyRequest) (*response.APIKeyResponse, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *request.APIKeyRequest) *response.APIKeyResponse); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.APIKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *request.APIKeyRequest) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllAPIKey provides a mock function with given fields: ctx
+func (_m *APIKeyUseCases) GetAllAPIKey(ctx context.Context) (*[]response.APIKeyResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *[]response.APIKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*[]response.APIKeyResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *[]response.APIKeyResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]response.APIKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeAPIKey provides a mock function with given fields: ctx, id
+func (_m *APIKeyUseCases) RevokeAPIKey(ctx context.Context, id *uuid.UUID) (*response.APIKeyResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *response.APIKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*response.APIKeyResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *response.APIKeyResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.APIKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewAPIKeyUseCases creates a new instance of APIKeyUseCases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAPIKeyUseCases(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *APIKeyUseCases {
+	mock := &APIKeyUseCases{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,186 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_usecases
+
+import (
+	context "context"
+	request "sagara_backend_test/internal/usecases/request"
+
+	mock "github.com/stretchr/testify/mock"
+
+	response "sagara_backend_test/internal/usecases/response"
+
+	rest "sagara_backend_test/lib/response/rest"
+
+	uuid "github.com/google/uuid"
+)
+
+// AlertUseCases is an autogenerated mock type for the AlertUseCases type
+type AlertUseCases struct {
+	mock.Mock
+}
+
+// AcknowledgeAlert provides a mock function with given fields: ctx, id
+func (_m *AlertUseCases) AcknowledgeAlert(ctx context.Context, id *uuid.UUID) (*response.StockAlertResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *response.StockAlertResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*response.StockAlertResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *response.StockAlertResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.StockAlertResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRule provides a mock function with given fields: ctx, id
+func (_m *AlertUseCases) DeleteRule(ctx context.Context, id *uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAlerts provides a mock function with given fields: ctx, status, pageReq
+func (_m *AlertUseCases) GetAlerts(ctx context.Context, status string, pageReq *request.PageRequest) (*[]response.StockAlertResponse, *rest.Pagination, error) {
+	ret := _m.Called(ctx, status, pageReq)
+
+	var r0 *[]response.StockAlertResponse
+	var r1 *rest.Pagination
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *request.PageRequest) (*[]response.StockAlertResponse, *rest.Pagination, error)); ok {
+		return rf(ctx, status, pageReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *request.PageRequest) *[]response.StockAlertResponse); ok {
+		r0 = rf(ctx, status, pageReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]response.StockAlertResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *request.PageRequest) *rest.Pagination); ok {
+		r1 = rf(ctx, status, pageReq)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rest.Pagination)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, *request.PageRequest) error); ok {
+		r2 = rf(ctx, status, pageReq)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetRules provides a mock function with given fields: ctx
+func (_m *AlertUseCases) GetRules(ctx context.Context) (*[]response.StockAlertRuleResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *[]response.StockAlertRuleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*[]response.StockAlertRuleResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *[]response.StockAlertRuleResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]response.StockAlertRuleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotifyAlerts provides a mock function with given fields: ctx
+func (_m *AlertUseCases) NotifyAlerts(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetRule provides a mock function with given fields: ctx, _a1
+func (_m *AlertUseCases) SetRule(ctx context.Context, _a1 *request.StockAlertRuleRequest) (*response.StockAlertRuleResponse, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 *response.StockAlertRuleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *request.StockAlertRuleRequest) (*response.StockAlertRuleResponse, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *request.StockAlertRuleRequest) *response.StockAlertRuleResponse); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.StockAlertRuleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *request.StockAlertRuleRequest) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewAlertUseCases creates a new instance of AlertUseCases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAlertUseCases(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AlertUseCases {
+	mock := &AlertUseCases{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,68 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_usecases
+
+import (
+	context "context"
+	request "sagara_backend_test/internal/usecases/request"
+
+	mock "github.com/stretchr/testify/mock"
+
+	response "sagara_backend_test/internal/usecases/response"
+
+	rest "sagara_backend_test/lib/response/rest"
+)
+
+// AuditUseCases is an autogenerated mock type for the AuditUseCases type
+type AuditUseCases struct {
+	mock.Mock
+}
+
+// GetAuditLogs provides a mock function with given fields: ctx, _a1, pageReq
+func (_m *AuditUseCases) GetAuditLogs(ctx context.Context, _a1 *request.AuditRequest, pageReq *request.PageRequest) (*[]response.AuditLogResponse, *rest.Pagination, error) {
+	ret := _m.Called(ctx, _a1, pageReq)
+
+	var r0 *[]response.AuditLogResponse
+	var r1 *rest.Pagination
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *request.AuditRequest, *request.PageRequest) (*[]response.AuditLogResponse, *rest.Pagination, error)); ok {
+		return rf(ctx, _a1, pageReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *request.AuditRequest, *request.PageRequest) *[]response.AuditLogResponse); ok {
+		r0 = rf(ctx, _a1, pageReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]response.AuditLogResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *request.AuditRequest, *request.PageRequest) *rest.Pagination); ok {
+		r1 = rf(ctx, _a1, pageReq)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rest.Pagination)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *request.AuditRequest, *request.PageRequest) error); ok {
+		r2 = rf(ctx, _a1, pageReq)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// NewAuditUseCases creates a new instance of AuditUseCases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuditUseCases(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuditUseCases {
+	mock := &AuditUseCases{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,151 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_usecases
+
+import (
+	context "context"
+	request "sagara_backend_test/internal/usecases/request"
+
+	mock "github.com/stretchr/testify/mock"
+
+	response "sagara_backend_test/internal/usecases/response"
+
+	uuid "github.com/google/uuid"
+)
+
+// CategoryUseCases is an autogenerated mock type for the CategoryUseCases type
+type CategoryUseCases struct {
+	mock.Mock
+}
+
+// DeleteCategory provides a mock function with given fields: ctx, id
+func (_m *CategoryUseCases) DeleteCategory(ctx context.Context, id *uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetCategory provides a mock function with given fields: ctx, id
+func (_m *CategoryUseCases) GetCategory(ctx context.Context, id *uuid.UUID) (*response.CategoryResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *response.CategoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*response.CategoryResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *response.CategoryResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.CategoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCategoryTree provides a mock function with given fields: ctx
+func (_m *CategoryUseCases) GetCategoryTree(ctx context.Context) (*[]response.CategoryResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *[]response.CategoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*[]response.CategoryResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *[]response.CategoryResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]response.CategoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertCategory provides a mock function with given fields: ctx, _a1
+func (_m *CategoryUseCases) InsertCategory(ctx context.Context, _a1 *request.CategoryRequest) (*response.CategoryResponse, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 *response.CategoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *request.CategoryRequest) (*response.CategoryResponse, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *request.CategoryRequest) *response.CategoryResponse); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.CategoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *request.CategoryRequest) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateCategory provides a mock function with given fields: ctx, id, _a2
+func (_m *CategoryUseCases) UpdateCategory(ctx context.Context, id *uuid.UUID, _a2 *request.CategoryRequest) (*response.CategoryResponse, error) {
+	ret := _m.Called(ctx, id, _a2)
+
+	var r0 *response.CategoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.CategoryRequest) (*response.CategoryResponse, error)); ok {
+		return rf(ctx, id, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.CategoryRequest) *response.CategoryResponse); ok {
+		r0 = rf(ctx, id, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.CategoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *request.CategoryRequest) error); ok {
+		r1 = rf(ctx, id, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCategoryUseCases creates a new instance of CategoryUseCases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCategoryUseCases(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CategoryUseCases {
+	mock := &CategoryUseCases{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
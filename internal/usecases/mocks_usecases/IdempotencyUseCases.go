@@ -0,0 +1,107 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_usecases
+
+import (
+	context "context"
+	response "sagara_backend_test/internal/usecases/response"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IdempotencyUseCases is an autogenerated mock type for the IdempotencyUseCases type
+type IdempotencyUseCases struct {
+	mock.Mock
+}
+
+// Begin provides a mock function with given fields: ctx, key, fingerprint
+func (_m *IdempotencyUseCases) Begin(ctx context.Context, key string, fingerprint string) (*response.IdempotentResponse, error) {
+	ret := _m.Called(ctx, key, fingerprint)
+
+	var r0 *response.IdempotentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*response.IdempotentResponse, error)); ok {
+		return rf(ctx, key, fingerprint)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *response.IdempotentResponse); ok {
+		r0 = rf(ctx, key, fingerprint)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.IdempotentResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, key, fingerprint)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Complete provides a mock function with given fields: ctx, key, res
+func (_m *IdempotencyUseCases) Complete(ctx context.Context, key string, res *response.IdempotentResponse) error {
+	ret := _m.Called(ctx, key, res)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *response.IdempotentResponse) error); ok {
+		r0 = rf(ctx, key, res)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PurgeExpired provides a mock function with given fields: ctx
+func (_m *IdempotencyUseCases) PurgeExpired(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Release provides a mock function with given fields: ctx, key
+func (_m *IdempotencyUseCases) Release(ctx context.Context, key string) error {
+	ret := _m.Called(ctx, key)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewIdempotencyUseCases creates a new instance of IdempotencyUseCases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIdempotencyUseCases(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IdempotencyUseCases {
+	mock := &IdempotencyUseCases{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,85 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_usecases
+
+import (
+	context "context"
+	request "sagara_backend_test/internal/usecases/request"
+
+	mock "github.com/stretchr/testify/mock"
+
+	response "sagara_backend_test/internal/usecases/response"
+
+	uuid "github.com/google/uuid"
+)
+
+// ImportUseCases is an autogenerated mock type for the ImportUseCases type
+type ImportUseCases struct {
+	mock.Mock
+}
+
+// GetImportJob provides a mock function with given fields: ctx, id
+func (_m *ImportUseCases) GetImportJob(ctx context.Context, id *uuid.UUID) (*response.ImportJobResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *response.ImportJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*response.ImportJobResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *response.ImportJobResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ImportJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportWardrobe provides a mock function with given fields: ctx, _a1
+func (_m *ImportUseCases) ImportWardrobe(ctx context.Context, _a1 *request.WardrobeImportRequest) (*response.ImportJobResponse, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 *response.ImportJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *request.WardrobeImportRequest) (*response.ImportJobResponse, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *request.WardrobeImportRequest) *response.ImportJobResponse); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ImportJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *request.WardrobeImportRequest) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewImportUseCases creates a new instance of ImportUseCases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewImportUseCases(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ImportUseCases {
+	mock := &ImportUseCases{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
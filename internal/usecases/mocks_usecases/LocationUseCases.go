@@ -0,0 +1,151 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_usecases
+
+import (
+	context "context"
+	request "sagara_backend_test/internal/usecases/request"
+
+	mock "github.com/stretchr/testify/mock"
+
+	response "sagara_backend_test/internal/usecases/response"
+
+	uuid "github.com/google/uuid"
+)
+
+// LocationUseCases is an autogenerated mock type for the LocationUseCases type
+type LocationUseCases struct {
+	mock.Mock
+}
+
+// DeleteLocation provides a mock function with given fields: ctx, id
+func (_m *LocationUseCases) DeleteLocation(ctx context.Context, id *uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllLocation provides a mock function with given fields: ctx
+func (_m *LocationUseCases) GetAllLocation(ctx context.Context) (*[]response.LocationResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *[]response.LocationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*[]response.LocationResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *[]response.LocationResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]response.LocationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLocation provides a mock function with given fields: ctx, id
+func (_m *LocationUseCases) GetLocation(ctx context.Context, id *uuid.UUID) (*response.LocationResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *response.LocationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*response.LocationResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *response.LocationResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.LocationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertLocation provides a mock function with given fields: ctx, _a1
+func (_m *LocationUseCases) InsertLocation(ctx context.Context, _a1 *request.LocationRequest) (*response.LocationResponse, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 *response.LocationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *request.LocationRequest) (*response.LocationResponse, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *request.LocationRequest) *response.LocationResponse); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.LocationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *request.LocationRequest) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateLocation provides a mock function with given fields: ctx, id, _a2
+func (_m *LocationUseCases) UpdateLocation(ctx context.Context, id *uuid.UUID, _a2 *request.LocationRequest) (*response.LocationResponse, error) {
+	ret := _m.Called(ctx, id, _a2)
+
+	var r0 *response.LocationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.LocationRequest) (*response.LocationResponse, error)); ok {
+		return rf(ctx, id, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.LocationRequest) *response.LocationResponse); ok {
+		r0 = rf(ctx, id, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.LocationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *request.LocationRequest) error); ok {
+		r1 = rf(ctx, id, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewLocationUseCases creates a new instance of LocationUseCases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLocationUseCases(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LocationUseCases {
+	mock := &LocationUseCases{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
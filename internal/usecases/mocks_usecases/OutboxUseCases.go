@@ -0,0 +1,52 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_usecases
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OutboxUseCases is an autogenerated mock type for the OutboxUseCases type
+type OutboxUseCases struct {
+	mock.Mock
+}
+
+// RelayEvents provides a mock function with given fields: ctx
+func (_m *OutboxUseCases) RelayEvents(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewOutboxUseCases creates a new instance of OutboxUseCases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOutboxUseCases(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OutboxUseCases {
+	mock := &OutboxUseCases{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,240 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_usecases
+
+import (
+	context "context"
+	request "sagara_backend_test/internal/usecases/request"
+
+	mock "github.com/stretchr/testify/mock"
+
+	response "sagara_backend_test/internal/usecases/response"
+
+	rest "sagara_backend_test/lib/response/rest"
+
+	uuid "github.com/google/uuid"
+)
+
+// ProductUseCases is an autogenerated mock type for the ProductUseCases type
+type ProductUseCases struct {
+	mock.Mock
+}
+
+// AddVariant provides a mock function with given fields: ctx, id, _a2
+func (_m *ProductUseCases) AddVariant(ctx context.Context, id *uuid.UUID, _a2 *request.VariantRequest) (*response.WardrobeResponse, error) {
+	ret := _m.Called(ctx, id, _a2)
+
+	var r0 *response.WardrobeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.VariantRequest) (*response.WardrobeResponse, error)); ok {
+		return rf(ctx, id, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.VariantRequest) *response.WardrobeResponse); ok {
+		r0 = rf(ctx, id, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.WardrobeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *request.VariantRequest) error); ok {
+		r1 = rf(ctx, id, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteProduct provides a mock function with given fields: ctx, id
+func (_m *ProductUseCases) DeleteProduct(ctx context.Context, id *uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllProduct provides a mock function with given fields: ctx
+func (_m *ProductUseCases) GetAllProduct(ctx context.Context) (*[]response.ProductResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *[]response.ProductResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*[]response.ProductResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *[]response.ProductResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]response.ProductResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProduct provides a mock function with given fields: ctx, id
+func (_m *ProductUseCases) GetProduct(ctx context.Context, id *uuid.UUID) (*response.ProductResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *response.ProductResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*response.ProductResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *response.ProductResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ProductResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVariants provides a mock function with given fields: ctx, id
+func (_m *ProductUseCases) GetVariants(ctx context.Context, id *uuid.UUID) (*response.ProductVariantsResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *response.ProductVariantsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*response.ProductVariantsResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *response.ProductVariantsResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ProductVariantsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertProduct provides a mock function with given fields: ctx, _a1
+func (_m *ProductUseCases) InsertProduct(ctx context.Context, _a1 *request.ProductRequest) (*response.ProductResponse, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 *response.ProductResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *request.ProductRequest) (*response.ProductResponse, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *request.ProductRequest) *response.ProductResponse); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ProductResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *request.ProductRequest) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Search provides a mock function with given fields: ctx, _a1, page
+func (_m *ProductUseCases) Search(ctx context.Context, _a1 *request.WardrobeSearchRequest, page *request.PageRequest) (*[]response.ProductVariantsResponse, *rest.Pagination, error) {
+	ret := _m.Called(ctx, _a1, page)
+
+	var r0 *[]response.ProductVariantsResponse
+	var r1 *rest.Pagination
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *request.WardrobeSearchRequest, *request.PageRequest) (*[]response.ProductVariantsResponse, *rest.Pagination, error)); ok {
+		return rf(ctx, _a1, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *request.WardrobeSearchRequest, *request.PageRequest) *[]response.ProductVariantsResponse); ok {
+		r0 = rf(ctx, _a1, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]response.ProductVariantsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *request.WardrobeSearchRequest, *request.PageRequest) *rest.Pagination); ok {
+		r1 = rf(ctx, _a1, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rest.Pagination)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *request.WardrobeSearchRequest, *request.PageRequest) error); ok {
+		r2 = rf(ctx, _a1, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// UpdateProduct provides a mock function with given fields: ctx, id, _a2
+func (_m *ProductUseCases) UpdateProduct(ctx context.Context, id *uuid.UUID, _a2 *request.ProductRequest) (*response.ProductResponse, error) {
+	ret := _m.Called(ctx, id, _a2)
+
+	var r0 *response.ProductResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.ProductRequest) (*response.ProductResponse, error)); ok {
+		return rf(ctx, id, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.ProductRequest) *response.ProductResponse); ok {
+		r0 = rf(ctx, id, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ProductResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *request.ProductRequest) error); ok {
+		r1 = rf(ctx, id, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewProductUseCases creates a new instance of ProductUseCases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewProductUseCases(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ProductUseCases {
+	mock := &ProductUseCases{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
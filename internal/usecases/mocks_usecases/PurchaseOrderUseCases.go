@@ -0,0 +1,226 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_usecases
+
+import (
+	context "context"
+	request "sagara_backend_test/internal/usecases/request"
+
+	mock "github.com/stretchr/testify/mock"
+
+	response "sagara_backend_test/internal/usecases/response"
+
+	rest "sagara_backend_test/lib/response/rest"
+
+	uuid "github.com/google/uuid"
+)
+
+// PurchaseOrderUseCases is an autogenerated mock type for the PurchaseOrderUseCases type
+type PurchaseOrderUseCases struct {
+	mock.Mock
+}
+
+// CancelPurchaseOrder provides a mock function with given fields: ctx, id
+func (_m *PurchaseOrderUseCases) CancelPurchaseOrder(ctx context.Context, id *uuid.UUID) (*response.PurchaseOrderResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *response.PurchaseOrderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*response.PurchaseOrderResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *response.PurchaseOrderResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.PurchaseOrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreatePurchaseOrder provides a mock function with given fields: ctx, _a1
+func (_m *PurchaseOrderUseCases) CreatePurchaseOrder(ctx context.Context, _a1 *request.PurchaseOrderRequest) (*response.PurchaseOrderResponse, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 *response.PurchaseOrderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *request.PurchaseOrderRequest) (*response.PurchaseOrderResponse, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *request.PurchaseOrderRequest) *response.PurchaseOrderResponse); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.PurchaseOrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *request.PurchaseOrderRequest) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPurchaseOrder provides a mock function with given fields: ctx, id
+func (_m *PurchaseOrderUseCases) GetPurchaseOrder(ctx context.Context, id *uuid.UUID) (*response.PurchaseOrderResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *response.PurchaseOrderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*response.PurchaseOrderResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *response.PurchaseOrderResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.PurchaseOrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPurchaseOrders provides a mock function with given fields: ctx, filter, page
+func (_m *PurchaseOrderUseCases) GetPurchaseOrders(ctx context.Context, filter *request.PurchaseOrderFilterRequest, page *request.PageRequest) (*[]response.PurchaseOrderResponse, *rest.Pagination, error) {
+	ret := _m.Called(ctx, filter, page)
+
+	var r0 *[]response.PurchaseOrderResponse
+	var r1 *rest.Pagination
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *request.PurchaseOrderFilterRequest, *request.PageRequest) (*[]response.PurchaseOrderResponse, *rest.Pagination, error)); ok {
+		return rf(ctx, filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *request.PurchaseOrderFilterRequest, *request.PageRequest) *[]response.PurchaseOrderResponse); ok {
+		r0 = rf(ctx, filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]response.PurchaseOrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *request.PurchaseOrderFilterRequest, *request.PageRequest) *rest.Pagination); ok {
+		r1 = rf(ctx, filter, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rest.Pagination)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *request.PurchaseOrderFilterRequest, *request.PageRequest) error); ok {
+		r2 = rf(ctx, filter, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ReceivePurchaseOrder provides a mock function with given fields: ctx, id, _a2
+func (_m *PurchaseOrderUseCases) ReceivePurchaseOrder(ctx context.Context, id *uuid.UUID, _a2 *request.PurchaseReceiveRequest) (*response.PurchaseOrderResponse, error) {
+	ret := _m.Called(ctx, id, _a2)
+
+	var r0 *response.PurchaseOrderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.PurchaseReceiveRequest) (*response.PurchaseOrderResponse, error)); ok {
+		return rf(ctx, id, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.PurchaseReceiveRequest) *response.PurchaseOrderResponse); ok {
+		r0 = rf(ctx, id, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.PurchaseOrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *request.PurchaseReceiveRequest) error); ok {
+		r1 = rf(ctx, id, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SendPurchaseOrder provides a mock function with given fields: ctx, id
+func (_m *PurchaseOrderUseCases) SendPurchaseOrder(ctx context.Context, id *uuid.UUID) (*response.PurchaseOrderResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *response.PurchaseOrderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*response.PurchaseOrderResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *response.PurchaseOrderResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.PurchaseOrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdatePurchaseOrder provides a mock function with given fields: ctx, id, _a2
+func (_m *PurchaseOrderUseCases) UpdatePurchaseOrder(ctx context.Context, id *uuid.UUID, _a2 *request.PurchaseOrderRequest) (*response.PurchaseOrderResponse, error) {
+	ret := _m.Called(ctx, id, _a2)
+
+	var r0 *response.PurchaseOrderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.PurchaseOrderRequest) (*response.PurchaseOrderResponse, error)); ok {
+		return rf(ctx, id, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.PurchaseOrderRequest) *response.PurchaseOrderResponse); ok {
+		r0 = rf(ctx, id, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.PurchaseOrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *request.PurchaseOrderRequest) error); ok {
+		r1 = rf(ctx, id, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPurchaseOrderUseCases creates a new instance of PurchaseOrderUseCases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPurchaseOrderUseCases(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PurchaseOrderUseCases {
+	mock := &PurchaseOrderUseCases{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
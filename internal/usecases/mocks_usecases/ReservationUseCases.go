@@ -0,0 +1,161 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_usecases
+
+import (
+	context "context"
+	request "sagara_backend_test/internal/usecases/request"
+
+	mock "github.com/stretchr/testify/mock"
+
+	response "sagara_backend_test/internal/usecases/response"
+
+	uuid "github.com/google/uuid"
+)
+
+// ReservationUseCases is an autogenerated mock type for the ReservationUseCases type
+type ReservationUseCases struct {
+	mock.Mock
+}
+
+// Commit provides a mock function with given fields: ctx, id
+func (_m *ReservationUseCases) Commit(ctx context.Context, id *uuid.UUID) (*response.ReservationResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *response.ReservationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*response.ReservationResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *response.ReservationResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ReservationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExpireReservations provides a mock function with given fields: ctx
+func (_m *ReservationUseCases) ExpireReservations(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReservation provides a mock function with given fields: ctx, id
+func (_m *ReservationUseCases) GetReservation(ctx context.Context, id *uuid.UUID) (*response.ReservationResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *response.ReservationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*response.ReservationResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *response.ReservationResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ReservationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Release provides a mock function with given fields: ctx, id
+func (_m *ReservationUseCases) Release(ctx context.Context, id *uuid.UUID) (*response.ReservationResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *response.ReservationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*response.ReservationResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *response.ReservationResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ReservationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reserve provides a mock function with given fields: ctx, wardrobeId, _a2
+func (_m *ReservationUseCases) Reserve(ctx context.Context, wardrobeId *uuid.UUID, _a2 *request.ReservationRequest) (*response.ReservationResponse, error) {
+	ret := _m.Called(ctx, wardrobeId, _a2)
+
+	var r0 *response.ReservationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.ReservationRequest) (*response.ReservationResponse, error)); ok {
+		return rf(ctx, wardrobeId, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.ReservationRequest) *response.ReservationResponse); ok {
+		r0 = rf(ctx, wardrobeId, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ReservationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *request.ReservationRequest) error); ok {
+		r1 = rf(ctx, wardrobeId, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewReservationUseCases creates a new instance of ReservationUseCases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReservationUseCases(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReservationUseCases {
+	mock := &ReservationUseCases{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,149 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_usecases
+
+import (
+	context "context"
+	request "sagara_backend_test/internal/usecases/request"
+
+	mock "github.com/stretchr/testify/mock"
+
+	response "sagara_backend_test/internal/usecases/response"
+)
+
+// RoleUseCases is an autogenerated mock type for the RoleUseCases type
+type RoleUseCases struct {
+	mock.Mock
+}
+
+// AssignRole provides a mock function with given fields: ctx, _a1
+func (_m *RoleUseCases) AssignRole(ctx context.Context, _a1 *request.RoleAssignmentRequest) (*response.RoleAssignmentResponse, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 *response.RoleAssignmentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *request.RoleAssignmentRequest) (*response.RoleAssignmentResponse, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *request.RoleAssignmentRequest) *response.RoleAssignmentResponse); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.RoleAssignmentResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *request.RoleAssignmentRequest) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllRoleAssignment provides a mock function with given fields: ctx
+func (_m *RoleUseCases) GetAllRoleAssignment(ctx context.Context) (*[]response.RoleAssignmentResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *[]response.RoleAssignmentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*[]response.RoleAssignmentResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *[]response.RoleAssignmentResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]response.RoleAssignmentResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRoles provides a mock function with given fields: ctx
+func (_m *RoleUseCases) GetRoles(ctx context.Context) (*[]response.RoleResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *[]response.RoleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*[]response.RoleResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *[]response.RoleResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]response.RoleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResolveRole provides a mock function with given fields: ctx, subject
+func (_m *RoleUseCases) ResolveRole(ctx context.Context, subject string) (*response.RoleResponse, error) {
+	ret := _m.Called(ctx, subject)
+
+	var r0 *response.RoleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*response.RoleResponse, error)); ok {
+		return rf(ctx, subject)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *response.RoleResponse); ok {
+		r0 = rf(ctx, subject)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.RoleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnassignRole provides a mock function with given fields: ctx, subject
+func (_m *RoleUseCases) UnassignRole(ctx context.Context, subject string) error {
+	ret := _m.Called(ctx, subject)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, subject)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewRoleUseCases creates a new instance of RoleUseCases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRoleUseCases(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RoleUseCases {
+	mock := &RoleUseCases{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,174 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_usecases
+
+import (
+	context "context"
+	request "sagara_backend_test/internal/usecases/request"
+
+	mock "github.com/stretchr/testify/mock"
+
+	response "sagara_backend_test/internal/usecases/response"
+
+	rest "sagara_backend_test/lib/response/rest"
+
+	uuid "github.com/google/uuid"
+)
+
+// SalesOrderUseCases is an autogenerated mock type for the SalesOrderUseCases type
+type SalesOrderUseCases struct {
+	mock.Mock
+}
+
+// CancelSalesOrder provides a mock function with given fields: ctx, id
+func (_m *SalesOrderUseCases) CancelSalesOrder(ctx context.Context, id *uuid.UUID) (*response.SalesOrderResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *response.SalesOrderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*response.SalesOrderResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *response.SalesOrderResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.SalesOrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FulfillSalesOrder provides a mock function with given fields: ctx, id
+func (_m *SalesOrderUseCases) FulfillSalesOrder(ctx context.Context, id *uuid.UUID) (*response.SalesOrderResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *response.SalesOrderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*response.SalesOrderResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *response.SalesOrderResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.SalesOrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSalesOrder provides a mock function with given fields: ctx, id
+func (_m *SalesOrderUseCases) GetSalesOrder(ctx context.Context, id *uuid.UUID) (*response.SalesOrderResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *response.SalesOrderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*response.SalesOrderResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *response.SalesOrderResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.SalesOrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSalesOrders provides a mock function with given fields: ctx, filter, page
+func (_m *SalesOrderUseCases) GetSalesOrders(ctx context.Context, filter *request.SalesOrderFilterRequest, page *request.PageRequest) (*[]response.SalesOrderResponse, *rest.Pagination, error) {
+	ret := _m.Called(ctx, filter, page)
+
+	var r0 *[]response.SalesOrderResponse
+	var r1 *rest.Pagination
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *request.SalesOrderFilterRequest, *request.PageRequest) (*[]response.SalesOrderResponse, *rest.Pagination, error)); ok {
+		return rf(ctx, filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *request.SalesOrderFilterRequest, *request.PageRequest) *[]response.SalesOrderResponse); ok {
+		r0 = rf(ctx, filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]response.SalesOrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *request.SalesOrderFilterRequest, *request.PageRequest) *rest.Pagination); ok {
+		r1 = rf(ctx, filter, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rest.Pagination)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *request.SalesOrderFilterRequest, *request.PageRequest) error); ok {
+		r2 = rf(ctx, filter, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// PlaceSalesOrder provides a mock function with given fields: ctx, _a1
+func (_m *SalesOrderUseCases) PlaceSalesOrder(ctx context.Context, _a1 *request.SalesOrderRequest) (*response.SalesOrderResponse, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 *response.SalesOrderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *request.SalesOrderRequest) (*response.SalesOrderResponse, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *request.SalesOrderRequest) *response.SalesOrderResponse); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.SalesOrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *request.SalesOrderRequest) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewSalesOrderUseCases creates a new instance of SalesOrderUseCases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSalesOrderUseCases(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SalesOrderUseCases {
+	mock := &SalesOrderUseCases{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,165 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_usecases
+
+import (
+	context "context"
+	request "sagara_backend_test/internal/usecases/request"
+
+	mock "github.com/stretchr/testify/mock"
+
+	response "sagara_backend_test/internal/usecases/response"
+
+	uuid "github.com/google/uuid"
+)
+
+// TagUseCases is an autogenerated mock type for the TagUseCases type
+type TagUseCases struct {
+	mock.Mock
+}
+
+// AddWardrobeTags provides a mock function with given fields: ctx, wardrobeId, _a2
+func (_m *TagUseCases) AddWardrobeTags(ctx context.Context, wardrobeId *uuid.UUID, _a2 *request.WardrobeTagsRequest) (*[]response.TagResponse, error) {
+	ret := _m.Called(ctx, wardrobeId, _a2)
+
+	var r0 *[]response.TagResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.WardrobeTagsRequest) (*[]response.TagResponse, error)); ok {
+		return rf(ctx, wardrobeId, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.WardrobeTagsRequest) *[]response.TagResponse); ok {
+		r0 = rf(ctx, wardrobeId, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]response.TagResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *request.WardrobeTagsRequest) error); ok {
+		r1 = rf(ctx, wardrobeId, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteTag provides a mock function with given fields: ctx, id
+func (_m *TagUseCases) DeleteTag(ctx context.Context, id *uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllTag provides a mock function with given fields: ctx
+func (_m *TagUseCases) GetAllTag(ctx context.Context) (*[]response.TagResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *[]response.TagResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*[]response.TagResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *[]response.TagResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]response.TagResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWardrobeTags provides a mock function with given fields: ctx, wardrobeId
+func (_m *TagUseCases) GetWardrobeTags(ctx context.Context, wardrobeId *uuid.UUID) (*[]response.TagResponse, error) {
+	ret := _m.Called(ctx, wardrobeId)
+
+	var r0 *[]response.TagResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*[]response.TagResponse, error)); ok {
+		return rf(ctx, wardrobeId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *[]response.TagResponse); ok {
+		r0 = rf(ctx, wardrobeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]response.TagResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, wardrobeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertTag provides a mock function with given fields: ctx, _a1
+func (_m *TagUseCases) InsertTag(ctx context.Context, _a1 *request.TagRequest) (*response.TagResponse, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 *response.TagResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *request.TagRequest) (*response.TagResponse, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *request.TagRequest) *response.TagResponse); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.TagResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *request.TagRequest) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveWardrobeTag provides a mock function with given fields: ctx, wardrobeId, tagId
+func (_m *TagUseCases) RemoveWardrobeTag(ctx context.Context, wardrobeId *uuid.UUID, tagId *uuid.UUID) error {
+	ret := _m.Called(ctx, wardrobeId, tagId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *uuid.UUID) error); ok {
+		r0 = rf(ctx, wardrobeId, tagId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewTagUseCases creates a new instance of TagUseCases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTagUseCases(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TagUseCases {
+	mock := &TagUseCases{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -10,6 +10,8 @@ import (
 
 	response "sagara_backend_test/internal/usecases/response"
 
+	rest "sagara_backend_test/lib/response/rest"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -18,25 +20,115 @@ type WardrobeUseCases struct {
 	mock.Mock
 }
 
-// AddStock provides a mock function with given fields: ctx, id, add
-func (_m *WardrobeUseCases) AddStock(ctx context.Context, id *uuid.UUID, add int) (*response.WardrobeResponse, error) {
-	ret := _m.Called(ctx, id, add)
+// AddStock provides a mock function with given fields: ctx, id, _a2
+func (_m *WardrobeUseCases) AddStock(ctx context.Context, id *uuid.UUID, _a2 *request.WardrobeAddSubRequest) (*response.WardrobeResponse, error) {
+	ret := _m.Called(ctx, id, _a2)
 
 	var r0 *response.WardrobeResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, int) (*response.WardrobeResponse, error)); ok {
-		return rf(ctx, id, add)
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.WardrobeAddSubRequest) (*response.WardrobeResponse, error)); ok {
+		return rf(ctx, id, _a2)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, int) *response.WardrobeResponse); ok {
-		r0 = rf(ctx, id, add)
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.WardrobeAddSubRequest) *response.WardrobeResponse); ok {
+		r0 = rf(ctx, id, _a2)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*response.WardrobeResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, int) error); ok {
-		r1 = rf(ctx, id, add)
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *request.WardrobeAddSubRequest) error); ok {
+		r1 = rf(ctx, id, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApplyScheduledPrices provides a mock function with given fields: ctx
+func (_m *WardrobeUseCases) ApplyScheduledPrices(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Batch provides a mock function with given fields: ctx, _a1
+func (_m *WardrobeUseCases) Batch(ctx context.Context, _a1 *request.WardrobeBatchRequest) (*response.WardrobeBatchResponse, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 *response.WardrobeBatchResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *request.WardrobeBatchRequest) (*response.WardrobeBatchResponse, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *request.WardrobeBatchRequest) *response.WardrobeBatchResponse); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.WardrobeBatchResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *request.WardrobeBatchRequest) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelPriceSchedule provides a mock function with given fields: ctx, id, scheduleId
+func (_m *WardrobeUseCases) CancelPriceSchedule(ctx context.Context, id *uuid.UUID, scheduleId *uuid.UUID) error {
+	ret := _m.Called(ctx, id, scheduleId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *uuid.UUID) error); ok {
+		r0 = rf(ctx, id, scheduleId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CancelTransfer provides a mock function with given fields: ctx, id
+func (_m *WardrobeUseCases) CancelTransfer(ctx context.Context, id *uuid.UUID) (*response.StockTransferResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *response.StockTransferResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*response.StockTransferResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *response.StockTransferResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.StockTransferResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -58,25 +150,25 @@ func (_m *WardrobeUseCases) DeleteWardrobe(ctx context.Context, id *uuid.UUID) e
 	return r0
 }
 
-// GetAllWardrobe provides a mock function with given fields: ctx
-func (_m *WardrobeUseCases) GetAllWardrobe(ctx context.Context) (*[]response.WardrobeResponse, error) {
-	ret := _m.Called(ctx)
+// Export provides a mock function with given fields: ctx, _a1
+func (_m *WardrobeUseCases) Export(ctx context.Context, _a1 *request.WardrobeExportRequest) (*response.ExportResponse, error) {
+	ret := _m.Called(ctx, _a1)
 
-	var r0 *[]response.WardrobeResponse
+	var r0 *response.ExportResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (*[]response.WardrobeResponse, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *request.WardrobeExportRequest) (*response.ExportResponse, error)); ok {
+		return rf(ctx, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) *[]response.WardrobeResponse); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *request.WardrobeExportRequest) *response.ExportResponse); ok {
+		r0 = rf(ctx, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*[]response.WardrobeResponse)
+			r0 = ret.Get(0).(*response.ExportResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, *request.WardrobeExportRequest) error); ok {
+		r1 = rf(ctx, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -84,25 +176,95 @@ func (_m *WardrobeUseCases) GetAllWardrobe(ctx context.Context) (*[]response.War
 	return r0, r1
 }
 
-// GetAvailable provides a mock function with given fields: ctx
-func (_m *WardrobeUseCases) GetAvailable(ctx context.Context) (*[]response.WardrobeResponse, error) {
-	ret := _m.Called(ctx)
+// GetAllWardrobe provides a mock function with given fields: ctx, page
+func (_m *WardrobeUseCases) GetAllWardrobe(ctx context.Context, page *request.PageRequest) (*[]response.WardrobeResponse, *rest.Pagination, error) {
+	ret := _m.Called(ctx, page)
+
+	var r0 *[]response.WardrobeResponse
+	var r1 *rest.Pagination
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *request.PageRequest) (*[]response.WardrobeResponse, *rest.Pagination, error)); ok {
+		return rf(ctx, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *request.PageRequest) *[]response.WardrobeResponse); ok {
+		r0 = rf(ctx, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]response.WardrobeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *request.PageRequest) *rest.Pagination); ok {
+		r1 = rf(ctx, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rest.Pagination)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *request.PageRequest) error); ok {
+		r2 = rf(ctx, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetAvailable provides a mock function with given fields: ctx, page
+func (_m *WardrobeUseCases) GetAvailable(ctx context.Context, page *request.PageRequest) (*[]response.WardrobeResponse, *rest.Pagination, error) {
+	ret := _m.Called(ctx, page)
+
+	var r0 *[]response.WardrobeResponse
+	var r1 *rest.Pagination
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *request.PageRequest) (*[]response.WardrobeResponse, *rest.Pagination, error)); ok {
+		return rf(ctx, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *request.PageRequest) *[]response.WardrobeResponse); ok {
+		r0 = rf(ctx, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]response.WardrobeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *request.PageRequest) *rest.Pagination); ok {
+		r1 = rf(ctx, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rest.Pagination)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *request.PageRequest) error); ok {
+		r2 = rf(ctx, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetByProduct provides a mock function with given fields: ctx, productId
+func (_m *WardrobeUseCases) GetByProduct(ctx context.Context, productId *uuid.UUID) (*[]response.WardrobeResponse, error) {
+	ret := _m.Called(ctx, productId)
 
 	var r0 *[]response.WardrobeResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (*[]response.WardrobeResponse, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*[]response.WardrobeResponse, error)); ok {
+		return rf(ctx, productId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) *[]response.WardrobeResponse); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *[]response.WardrobeResponse); ok {
+		r0 = rf(ctx, productId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*[]response.WardrobeResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, productId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -110,25 +272,60 @@ func (_m *WardrobeUseCases) GetAvailable(ctx context.Context) (*[]response.Wardr
 	return r0, r1
 }
 
-// GetLessThan provides a mock function with given fields: ctx, amount
-func (_m *WardrobeUseCases) GetLessThan(ctx context.Context, amount int) (*[]response.WardrobeResponse, error) {
-	ret := _m.Called(ctx, amount)
+// GetLessThan provides a mock function with given fields: ctx, amount, page
+func (_m *WardrobeUseCases) GetLessThan(ctx context.Context, amount int, page *request.PageRequest) (*[]response.WardrobeResponse, *rest.Pagination, error) {
+	ret := _m.Called(ctx, amount, page)
 
 	var r0 *[]response.WardrobeResponse
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) (*[]response.WardrobeResponse, error)); ok {
-		return rf(ctx, amount)
+	var r1 *rest.Pagination
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *request.PageRequest) (*[]response.WardrobeResponse, *rest.Pagination, error)); ok {
+		return rf(ctx, amount, page)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int) *[]response.WardrobeResponse); ok {
-		r0 = rf(ctx, amount)
+	if rf, ok := ret.Get(0).(func(context.Context, int, *request.PageRequest) *[]response.WardrobeResponse); ok {
+		r0 = rf(ctx, amount, page)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*[]response.WardrobeResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = rf(ctx, amount)
+	if rf, ok := ret.Get(1).(func(context.Context, int, *request.PageRequest) *rest.Pagination); ok {
+		r1 = rf(ctx, amount, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rest.Pagination)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int, *request.PageRequest) error); ok {
+		r2 = rf(ctx, amount, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetPriceHistory provides a mock function with given fields: ctx, id
+func (_m *WardrobeUseCases) GetPriceHistory(ctx context.Context, id *uuid.UUID) (*[]response.PriceChangeResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *[]response.PriceChangeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*[]response.PriceChangeResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *[]response.PriceChangeResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]response.PriceChangeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -136,25 +333,103 @@ func (_m *WardrobeUseCases) GetLessThan(ctx context.Context, amount int) (*[]res
 	return r0, r1
 }
 
-// GetUnavailable provides a mock function with given fields: ctx
-func (_m *WardrobeUseCases) GetUnavailable(ctx context.Context) (*[]response.WardrobeResponse, error) {
-	ret := _m.Called(ctx)
+// GetPriceSchedules provides a mock function with given fields: ctx, id
+func (_m *WardrobeUseCases) GetPriceSchedules(ctx context.Context, id *uuid.UUID) (*[]response.PriceScheduleResponse, error) {
+	ret := _m.Called(ctx, id)
 
-	var r0 *[]response.WardrobeResponse
+	var r0 *[]response.PriceScheduleResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (*[]response.WardrobeResponse, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*[]response.PriceScheduleResponse, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) *[]response.WardrobeResponse); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *[]response.PriceScheduleResponse); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*[]response.WardrobeResponse)
+			r0 = ret.Get(0).(*[]response.PriceScheduleResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStockLevels provides a mock function with given fields: ctx, id
+func (_m *WardrobeUseCases) GetStockLevels(ctx context.Context, id *uuid.UUID) (*response.StockLevelResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *response.StockLevelResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*response.StockLevelResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *response.StockLevelResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.StockLevelResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStockMovements provides a mock function with given fields: ctx, id
+func (_m *WardrobeUseCases) GetStockMovements(ctx context.Context, id *uuid.UUID) (*[]response.StockMovementResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *[]response.StockMovementResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*[]response.StockMovementResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *[]response.StockMovementResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]response.StockMovementResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransfer provides a mock function with given fields: ctx, id
+func (_m *WardrobeUseCases) GetTransfer(ctx context.Context, id *uuid.UUID) (*response.StockTransferResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *response.StockTransferResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*response.StockTransferResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *response.StockTransferResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.StockTransferResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -162,6 +437,111 @@ func (_m *WardrobeUseCases) GetUnavailable(ctx context.Context) (*[]response.War
 	return r0, r1
 }
 
+// GetTransfers provides a mock function with given fields: ctx, filter, page
+func (_m *WardrobeUseCases) GetTransfers(ctx context.Context, filter *request.StockTransferFilterRequest, page *request.PageRequest) (*[]response.StockTransferResponse, *rest.Pagination, error) {
+	ret := _m.Called(ctx, filter, page)
+
+	var r0 *[]response.StockTransferResponse
+	var r1 *rest.Pagination
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *request.StockTransferFilterRequest, *request.PageRequest) (*[]response.StockTransferResponse, *rest.Pagination, error)); ok {
+		return rf(ctx, filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *request.StockTransferFilterRequest, *request.PageRequest) *[]response.StockTransferResponse); ok {
+		r0 = rf(ctx, filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]response.StockTransferResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *request.StockTransferFilterRequest, *request.PageRequest) *rest.Pagination); ok {
+		r1 = rf(ctx, filter, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rest.Pagination)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *request.StockTransferFilterRequest, *request.PageRequest) error); ok {
+		r2 = rf(ctx, filter, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetTrash provides a mock function with given fields: ctx, page
+func (_m *WardrobeUseCases) GetTrash(ctx context.Context, page *request.PageRequest) (*[]response.WardrobeResponse, *rest.Pagination, error) {
+	ret := _m.Called(ctx, page)
+
+	var r0 *[]response.WardrobeResponse
+	var r1 *rest.Pagination
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *request.PageRequest) (*[]response.WardrobeResponse, *rest.Pagination, error)); ok {
+		return rf(ctx, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *request.PageRequest) *[]response.WardrobeResponse); ok {
+		r0 = rf(ctx, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]response.WardrobeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *request.PageRequest) *rest.Pagination); ok {
+		r1 = rf(ctx, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rest.Pagination)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *request.PageRequest) error); ok {
+		r2 = rf(ctx, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetUnavailable provides a mock function with given fields: ctx, page
+func (_m *WardrobeUseCases) GetUnavailable(ctx context.Context, page *request.PageRequest) (*[]response.WardrobeResponse, *rest.Pagination, error) {
+	ret := _m.Called(ctx, page)
+
+	var r0 *[]response.WardrobeResponse
+	var r1 *rest.Pagination
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *request.PageRequest) (*[]response.WardrobeResponse, *rest.Pagination, error)); ok {
+		return rf(ctx, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *request.PageRequest) *[]response.WardrobeResponse); ok {
+		r0 = rf(ctx, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]response.WardrobeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *request.PageRequest) *rest.Pagination); ok {
+		r1 = rf(ctx, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rest.Pagination)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *request.PageRequest) error); ok {
+		r2 = rf(ctx, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetWardrobe provides a mock function with given fields: ctx, id
 func (_m *WardrobeUseCases) GetWardrobe(ctx context.Context, id *uuid.UUID) (*response.WardrobeResponse, error) {
 	ret := _m.Called(ctx, id)
@@ -214,25 +594,51 @@ func (_m *WardrobeUseCases) InsertWardrobe(ctx context.Context, _a1 *request.War
 	return r0, r1
 }
 
-// Search provides a mock function with given fields: ctx, color, size
-func (_m *WardrobeUseCases) Search(ctx context.Context, color string, size string) (*[]response.WardrobeResponse, error) {
-	ret := _m.Called(ctx, color, size)
+// PurgeTrash provides a mock function with given fields: ctx
+func (_m *WardrobeUseCases) PurgeTrash(ctx context.Context) (*response.PurgeResponse, error) {
+	ret := _m.Called(ctx)
 
-	var r0 *[]response.WardrobeResponse
+	var r0 *response.PurgeResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*[]response.WardrobeResponse, error)); ok {
-		return rf(ctx, color, size)
+	if rf, ok := ret.Get(0).(func(context.Context) (*response.PurgeResponse, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *[]response.WardrobeResponse); ok {
-		r0 = rf(ctx, color, size)
+	if rf, ok := ret.Get(0).(func(context.Context) *response.PurgeResponse); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*[]response.WardrobeResponse)
+			r0 = ret.Get(0).(*response.PurgeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReceiveTransfer provides a mock function with given fields: ctx, id
+func (_m *WardrobeUseCases) ReceiveTransfer(ctx context.Context, id *uuid.UUID) (*response.StockTransferResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *response.StockTransferResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*response.StockTransferResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *response.StockTransferResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.StockTransferResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, color, size)
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -240,25 +646,25 @@ func (_m *WardrobeUseCases) Search(ctx context.Context, color string, size strin
 	return r0, r1
 }
 
-// SubStock provides a mock function with given fields: ctx, id, def
-func (_m *WardrobeUseCases) SubStock(ctx context.Context, id *uuid.UUID, def int) (*response.WardrobeResponse, error) {
-	ret := _m.Called(ctx, id, def)
+// RestoreWardrobe provides a mock function with given fields: ctx, id
+func (_m *WardrobeUseCases) RestoreWardrobe(ctx context.Context, id *uuid.UUID) (*response.WardrobeResponse, error) {
+	ret := _m.Called(ctx, id)
 
 	var r0 *response.WardrobeResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, int) (*response.WardrobeResponse, error)); ok {
-		return rf(ctx, id, def)
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*response.WardrobeResponse, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, int) *response.WardrobeResponse); ok {
-		r0 = rf(ctx, id, def)
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *response.WardrobeResponse); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*response.WardrobeResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, int) error); ok {
-		r1 = rf(ctx, id, def)
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -266,16 +672,103 @@ func (_m *WardrobeUseCases) SubStock(ctx context.Context, id *uuid.UUID, def int
 	return r0, r1
 }
 
-// UpdateWardrobe provides a mock function with given fields: ctx, id, _a2
-func (_m *WardrobeUseCases) UpdateWardrobe(ctx context.Context, id *uuid.UUID, _a2 *request.WardrobeUpdateRequest) (*response.WardrobeResponse, error) {
+// SchedulePrice provides a mock function with given fields: ctx, id, _a2
+func (_m *WardrobeUseCases) SchedulePrice(ctx context.Context, id *uuid.UUID, _a2 *request.PriceScheduleRequest) (*response.PriceScheduleResponse, error) {
+	ret := _m.Called(ctx, id, _a2)
+
+	var r0 *response.PriceScheduleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.PriceScheduleRequest) (*response.PriceScheduleResponse, error)); ok {
+		return rf(ctx, id, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.PriceScheduleRequest) *response.PriceScheduleResponse); ok {
+		r0 = rf(ctx, id, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.PriceScheduleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *request.PriceScheduleRequest) error); ok {
+		r1 = rf(ctx, id, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Search provides a mock function with given fields: ctx, _a1, page
+func (_m *WardrobeUseCases) Search(ctx context.Context, _a1 *request.WardrobeSearchRequest, page *request.PageRequest) (*[]response.WardrobeResponse, *rest.Pagination, error) {
+	ret := _m.Called(ctx, _a1, page)
+
+	var r0 *[]response.WardrobeResponse
+	var r1 *rest.Pagination
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *request.WardrobeSearchRequest, *request.PageRequest) (*[]response.WardrobeResponse, *rest.Pagination, error)); ok {
+		return rf(ctx, _a1, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *request.WardrobeSearchRequest, *request.PageRequest) *[]response.WardrobeResponse); ok {
+		r0 = rf(ctx, _a1, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]response.WardrobeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *request.WardrobeSearchRequest, *request.PageRequest) *rest.Pagination); ok {
+		r1 = rf(ctx, _a1, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rest.Pagination)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *request.WardrobeSearchRequest, *request.PageRequest) error); ok {
+		r2 = rf(ctx, _a1, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SetCategory provides a mock function with given fields: ctx, id, _a2
+func (_m *WardrobeUseCases) SetCategory(ctx context.Context, id *uuid.UUID, _a2 *request.WardrobeCategoryRequest) (*response.WardrobeResponse, error) {
+	ret := _m.Called(ctx, id, _a2)
+
+	var r0 *response.WardrobeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.WardrobeCategoryRequest) (*response.WardrobeResponse, error)); ok {
+		return rf(ctx, id, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.WardrobeCategoryRequest) *response.WardrobeResponse); ok {
+		r0 = rf(ctx, id, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.WardrobeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *request.WardrobeCategoryRequest) error); ok {
+		r1 = rf(ctx, id, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubStock provides a mock function with given fields: ctx, id, _a2
+func (_m *WardrobeUseCases) SubStock(ctx context.Context, id *uuid.UUID, _a2 *request.WardrobeAddSubRequest) (*response.WardrobeResponse, error) {
 	ret := _m.Called(ctx, id, _a2)
 
 	var r0 *response.WardrobeResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.WardrobeUpdateRequest) (*response.WardrobeResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.WardrobeAddSubRequest) (*response.WardrobeResponse, error)); ok {
 		return rf(ctx, id, _a2)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.WardrobeUpdateRequest) *response.WardrobeResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.WardrobeAddSubRequest) *response.WardrobeResponse); ok {
 		r0 = rf(ctx, id, _a2)
 	} else {
 		if ret.Get(0) != nil {
@@ -283,7 +776,7 @@ func (_m *WardrobeUseCases) UpdateWardrobe(ctx context.Context, id *uuid.UUID, _
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *request.WardrobeUpdateRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *request.WardrobeAddSubRequest) error); ok {
 		r1 = rf(ctx, id, _a2)
 	} else {
 		r1 = ret.Error(1)
@@ -292,6 +785,84 @@ func (_m *WardrobeUseCases) UpdateWardrobe(ctx context.Context, id *uuid.UUID, _
 	return r0, r1
 }
 
+// Suggest provides a mock function with given fields: ctx, query
+func (_m *WardrobeUseCases) Suggest(ctx context.Context, query string) (*[]response.WardrobeSuggestionResponse, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 *[]response.WardrobeSuggestionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*[]response.WardrobeSuggestionResponse, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *[]response.WardrobeSuggestionResponse); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]response.WardrobeSuggestionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransferStock provides a mock function with given fields: ctx, id, _a2
+func (_m *WardrobeUseCases) TransferStock(ctx context.Context, id *uuid.UUID, _a2 *request.StockTransferRequest) (*response.StockTransferResponse, error) {
+	ret := _m.Called(ctx, id, _a2)
+
+	var r0 *response.StockTransferResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.StockTransferRequest) (*response.StockTransferResponse, error)); ok {
+		return rf(ctx, id, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.StockTransferRequest) *response.StockTransferResponse); ok {
+		r0 = rf(ctx, id, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.StockTransferResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *request.StockTransferRequest) error); ok {
+		r1 = rf(ctx, id, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateWardrobe provides a mock function with given fields: ctx, id, version, _a3
+func (_m *WardrobeUseCases) UpdateWardrobe(ctx context.Context, id *uuid.UUID, version int, _a3 *request.WardrobeUpdateRequest) (*response.WardrobeResponse, error) {
+	ret := _m.Called(ctx, id, version, _a3)
+
+	var r0 *response.WardrobeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, int, *request.WardrobeUpdateRequest) (*response.WardrobeResponse, error)); ok {
+		return rf(ctx, id, version, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, int, *request.WardrobeUpdateRequest) *response.WardrobeResponse); ok {
+		r0 = rf(ctx, id, version, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.WardrobeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, int, *request.WardrobeUpdateRequest) error); ok {
+		r1 = rf(ctx, id, version, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewWardrobeUseCases creates a new instance of WardrobeUseCases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewWardrobeUseCases(t interface {
@@ -0,0 +1,280 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks_usecases
+
+import (
+	context "context"
+	publisher "sagara_backend_test/internal/infrastructures/publisher"
+
+	mock "github.com/stretchr/testify/mock"
+
+	request "sagara_backend_test/internal/usecases/request"
+
+	response "sagara_backend_test/internal/usecases/response"
+
+	rest "sagara_backend_test/lib/response/rest"
+
+	uuid "github.com/google/uuid"
+)
+
+// WebhookUseCases is an autogenerated mock type for the WebhookUseCases type
+type WebhookUseCases struct {
+	mock.Mock
+}
+
+// DeleteWebhook provides a mock function with given fields: ctx, id
+func (_m *WebhookUseCases) DeleteWebhook(ctx context.Context, id *uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeliverWebhooks provides a mock function with given fields: ctx
+func (_m *WebhookUseCases) DeliverWebhooks(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnqueueEvent provides a mock function with given fields: ctx, event
+func (_m *WebhookUseCases) EnqueueEvent(ctx context.Context, event *publisher.Event) error {
+	ret := _m.Called(ctx, event)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *publisher.Event) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetDeliveries provides a mock function with given fields: ctx, id, pageReq
+func (_m *WebhookUseCases) GetDeliveries(ctx context.Context, id *uuid.UUID, pageReq *request.PageRequest) (*[]response.WebhookDeliveryResponse, *rest.Pagination, error) {
+	ret := _m.Called(ctx, id, pageReq)
+
+	var r0 *[]response.WebhookDeliveryResponse
+	var r1 *rest.Pagination
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.PageRequest) (*[]response.WebhookDeliveryResponse, *rest.Pagination, error)); ok {
+		return rf(ctx, id, pageReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.PageRequest) *[]response.WebhookDeliveryResponse); ok {
+		r0 = rf(ctx, id, pageReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]response.WebhookDeliveryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *request.PageRequest) *rest.Pagination); ok {
+		r1 = rf(ctx, id, pageReq)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rest.Pagination)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *uuid.UUID, *request.PageRequest) error); ok {
+		r2 = rf(ctx, id, pageReq)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetDelivery provides a mock function with given fields: ctx, id, deliveryId
+func (_m *WebhookUseCases) GetDelivery(ctx context.Context, id *uuid.UUID, deliveryId *uuid.UUID) (*response.WebhookDeliveryResponse, error) {
+	ret := _m.Called(ctx, id, deliveryId)
+
+	var r0 *response.WebhookDeliveryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *uuid.UUID) (*response.WebhookDeliveryResponse, error)); ok {
+		return rf(ctx, id, deliveryId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *uuid.UUID) *response.WebhookDeliveryResponse); ok {
+		r0 = rf(ctx, id, deliveryId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.WebhookDeliveryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id, deliveryId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWebhook provides a mock function with given fields: ctx, id
+func (_m *WebhookUseCases) GetWebhook(ctx context.Context, id *uuid.UUID) (*response.WebhookResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *response.WebhookResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*response.WebhookResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *response.WebhookResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.WebhookResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWebhooks provides a mock function with given fields: ctx
+func (_m *WebhookUseCases) GetWebhooks(ctx context.Context) (*[]response.WebhookResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *[]response.WebhookResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*[]response.WebhookResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *[]response.WebhookResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]response.WebhookResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertWebhook provides a mock function with given fields: ctx, _a1
+func (_m *WebhookUseCases) InsertWebhook(ctx context.Context, _a1 *request.WebhookRequest) (*response.WebhookResponse, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 *response.WebhookResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *request.WebhookRequest) (*response.WebhookResponse, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *request.WebhookRequest) *response.WebhookResponse); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.WebhookResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *request.WebhookRequest) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Redeliver provides a mock function with given fields: ctx, id, deliveryId
+func (_m *WebhookUseCases) Redeliver(ctx context.Context, id *uuid.UUID, deliveryId *uuid.UUID) (*response.WebhookDeliveryResponse, error) {
+	ret := _m.Called(ctx, id, deliveryId)
+
+	var r0 *response.WebhookDeliveryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *uuid.UUID) (*response.WebhookDeliveryResponse, error)); ok {
+		return rf(ctx, id, deliveryId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *uuid.UUID) *response.WebhookDeliveryResponse); ok {
+		r0 = rf(ctx, id, deliveryId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.WebhookDeliveryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *uuid.UUID) error); ok {
+		r1 = rf(ctx, id, deliveryId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateWebhook provides a mock function with given fields: ctx, id, _a2
+func (_m *WebhookUseCases) UpdateWebhook(ctx context.Context, id *uuid.UUID, _a2 *request.WebhookRequest) (*response.WebhookResponse, error) {
+	ret := _m.Called(ctx, id, _a2)
+
+	var r0 *response.WebhookResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.WebhookRequest) (*response.WebhookResponse, error)); ok {
+		return rf(ctx, id, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *request.WebhookRequest) *response.WebhookResponse); ok {
+		r0 = rf(ctx, id, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.WebhookResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *request.WebhookRequest) error); ok {
+		r1 = rf(ctx, id, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewWebhookUseCases creates a new instance of WebhookUseCases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookUseCases(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookUseCases {
+	mock := &WebhookUseCases{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,7 @@
+package usecases
+
+import "context"
+
+type OutboxUseCases interface {
+	RelayEvents(ctx context.Context) (int, error)
+}
@@ -0,0 +1,50 @@
+package outbox
+
+import (
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/internal/infrastructures/publisher"
+	"sagara_backend_test/internal/usecases"
+	"sagara_backend_test/lib/txmanager"
+	"time"
+)
+
+type Module struct {
+	outboxRepo     repository.OutboxRepository
+	publisher      publisher.Publisher
+	txMgr          txmanager.TxManager
+	batchSize      int
+	lease          time.Duration
+	publishTimeout time.Duration
+	maxAttempts    int
+	retryDelay     time.Duration
+	maxRetryDelay  time.Duration
+}
+
+type Opts struct {
+	OutboxRepo repository.OutboxRepository
+	Publisher  publisher.Publisher
+	TxMgr      txmanager.TxManager
+	BatchSize  int
+	// Lease is how long a claimed batch is kept from the other instances, it must be longer than PublishTimeout
+	Lease time.Duration
+	// PublishTimeout bounds the publishing of a single event
+	PublishTimeout time.Duration
+	// MaxAttempts is how many times an event is published before it is dead lettered
+	MaxAttempts   int
+	RetryDelay    time.Duration
+	MaxRetryDelay time.Duration
+}
+
+func New(opts *Opts) usecases.OutboxUseCases {
+	return &Module{
+		outboxRepo:     opts.OutboxRepo,
+		publisher:      opts.Publisher,
+		txMgr:          opts.TxMgr,
+		batchSize:      opts.BatchSize,
+		lease:          opts.Lease,
+		publishTimeout: opts.PublishTimeout,
+		maxAttempts:    opts.MaxAttempts,
+		retryDelay:     opts.RetryDelay,
+		maxRetryDelay:  opts.MaxRetryDelay,
+	}
+}
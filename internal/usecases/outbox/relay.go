@@ -0,0 +1,118 @@
+package outbox
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/infrastructures/publisher"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"time"
+)
+
+// RelayEvents publishes the pending events in order and returns how many were published. A failed event is retried
+// with an exponential delay and holds back the later events of its aggregate until it is published or dead lettered.
+// The events are claimed in a short transaction and published outside of it, each within the publish timeout, so a slow
+// publisher never holds a transaction or the rows of the outbox.
+func (m *Module) RelayEvents(ctx context.Context) (int, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "OutboxUseCases.RelayEvents")
+	defer span.End()
+
+	claimedAt := time.Now()
+	res, err := m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		return m.outboxRepo.ClaimPending(ctx, m.batchSize, m.lease)
+	}, nil)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[OutboxUseCases.RelayEvents] Failed to claim events")
+		return 0, err
+	}
+
+	// an event is only published while its claim is sure to outlast it, another instance takes over after the lease
+	deadline := claimedAt.Add(m.lease - m.publishTimeout)
+
+	published := 0
+	blocked := make(map[uuid.UUID]bool)
+	for _, event := range *res.(*[]model.OutboxEvent) {
+		switch {
+		case blocked[event.AggregateID] || time.Now().After(deadline):
+			// handed back as it was, the events behind a failed one still wait for its retry
+			event.NextAttemptAt = time.Now()
+		case m.publish(ctx, &event):
+			published++
+		case event.Status == model.OutboxPending:
+			blocked[event.AggregateID] = true
+		}
+
+		err = m.outboxRepo.Update(ctx, &event)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error": err,
+				"id":    event.ID,
+			}).ErrorWithCtx(ctx, "[OutboxUseCases.RelayEvents] Failed to record event")
+			return published, err
+		}
+	}
+
+	return published, nil
+}
+
+// publish hands the event to the publisher and records the outcome on the event, it tells whether it was published
+func (m *Module) publish(ctx context.Context, event *model.OutboxEvent) bool {
+	event.Attempts++
+
+	publishCtx, cancel := context.WithTimeout(ctx, m.publishTimeout)
+	defer cancel()
+
+	err := m.publisher.Publish(publishCtx, toPublisherEvent(event))
+	if err == nil {
+		now := time.Now()
+		event.Status = model.OutboxPublished
+		event.PublishedAt = &now
+		event.LastError = ""
+		return true
+	}
+
+	event.LastError = err.Error()
+	if event.Attempts >= m.maxAttempts {
+		event.Status = model.OutboxDead
+		log.WithFields(log.Fields{
+			"error":    err,
+			"id":       event.ID,
+			"attempts": event.Attempts,
+		}).ErrorWithCtx(ctx, "[OutboxUseCases.RelayEvents] Event dead lettered")
+		return false
+	}
+
+	event.NextAttemptAt = time.Now().Add(m.backoff(event.Attempts))
+	log.WithFields(log.Fields{
+		"error":    err,
+		"id":       event.ID,
+		"attempts": event.Attempts,
+	}).WarnWithCtx(ctx, "[OutboxUseCases.RelayEvents] Failed to publish event")
+	return false
+}
+
+// backoff doubles the retry delay after every attempt up to the max retry delay
+func (m *Module) backoff(attempts int) time.Duration {
+	delay := m.retryDelay
+	for i := 1; i < attempts && delay < m.maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, m.maxRetryDelay)
+}
+
+func toPublisherEvent(event *model.OutboxEvent) *publisher.Event {
+	return &publisher.Event{
+		ID:            event.ID.String(),
+		Sequence:      event.Seq,
+		Type:          event.EventType,
+		AggregateType: event.AggregateType,
+		AggregateID:   event.AggregateID.String(),
+		Actor:         event.Actor,
+		RequestID:     event.RequestID,
+		Data:          event.Payload,
+		OccurredAt:    event.CreatedAt,
+	}
+}
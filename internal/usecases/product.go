@@ -0,0 +1,20 @@
+package usecases
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/response/rest"
+)
+
+type ProductUseCases interface {
+	GetAllProduct(ctx context.Context) (*[]response.ProductResponse, error)
+	GetProduct(ctx context.Context, id *uuid.UUID) (*response.ProductResponse, error)
+	InsertProduct(ctx context.Context, request *request.ProductRequest) (*response.ProductResponse, error)
+	UpdateProduct(ctx context.Context, id *uuid.UUID, request *request.ProductRequest) (*response.ProductResponse, error)
+	DeleteProduct(ctx context.Context, id *uuid.UUID) error
+	GetVariants(ctx context.Context, id *uuid.UUID) (*response.ProductVariantsResponse, error)
+	AddVariant(ctx context.Context, id *uuid.UUID, request *request.VariantRequest) (*response.WardrobeResponse, error)
+	Search(ctx context.Context, request *request.WardrobeSearchRequest, page *request.PageRequest) (*[]response.ProductVariantsResponse, *rest.Pagination, error)
+}
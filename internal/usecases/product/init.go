@@ -0,0 +1,30 @@
+package product
+
+import (
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/internal/usecases"
+	"sagara_backend_test/lib/txmanager"
+)
+
+type Module struct {
+	productRepo  repository.ProductRepository
+	wardrobeRepo repository.WardrobeRepository
+	wardrobeUc   usecases.WardrobeUseCases
+	txMgr        txmanager.TxManager
+}
+
+type Opts struct {
+	ProductRepo  repository.ProductRepository
+	WardrobeRepo repository.WardrobeRepository
+	WardrobeUc   usecases.WardrobeUseCases
+	TxMgr        txmanager.TxManager
+}
+
+func New(opts *Opts) usecases.ProductUseCases {
+	return &Module{
+		productRepo:  opts.ProductRepo,
+		wardrobeRepo: opts.WardrobeRepo,
+		wardrobeUc:   opts.WardrobeUc,
+		txMgr:        opts.TxMgr,
+	}
+}
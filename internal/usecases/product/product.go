@@ -0,0 +1,263 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/interfaces/dao"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/log"
+	libResponse "sagara_backend_test/lib/response"
+	"sagara_backend_test/lib/response/rest"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/pkg/constants"
+	"sagara_backend_test/pkg/constants/errorcode"
+	"time"
+)
+
+func (m *Module) GetAllProduct(ctx context.Context) (*[]response.ProductResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ProductUseCases.GetAllProduct")
+	defer span.End()
+
+	products, err := m.productRepo.GetAll(ctx)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[ProductUseCases.GetAllProduct] Failed to get all product")
+		return nil, err
+	}
+
+	var productResponses []response.ProductResponse
+	for _, product := range *products {
+		productResponses = append(productResponses, *toProductResponse(&product))
+	}
+
+	return &productResponses, nil
+}
+
+func (m *Module) GetProduct(ctx context.Context, id *uuid.UUID) (*response.ProductResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ProductUseCases.GetProduct")
+	defer span.End()
+
+	product, err := m.productRepo.GetById(ctx, id)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[ProductUseCases.GetProduct] Failed to get product by ID")
+		return nil, err
+	}
+
+	return toProductResponse(product), nil
+}
+
+func (m *Module) InsertProduct(ctx context.Context, request *request.ProductRequest) (*response.ProductResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ProductUseCases.InsertProduct")
+	defer span.End()
+
+	now := time.Now()
+	newProduct := &model.Product{
+		BaseModel: model.BaseModel{
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		ID:          uuid.New(),
+		Name:        request.Name,
+		Description: request.Description,
+		Price:       request.Price,
+		Currency:    toCurrency(request.Currency),
+	}
+
+	err := m.productRepo.Insert(ctx, newProduct)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":   err,
+			"request": request,
+		}).ErrorWithCtx(ctx, "[ProductUseCases.InsertProduct] Failed to insert product")
+		return nil, err
+	}
+
+	return toProductResponse(newProduct), nil
+}
+
+// UpdateProduct updates the product and carries the new name and price over to its variants
+func (m *Module) UpdateProduct(ctx context.Context, id *uuid.UUID, request *request.ProductRequest) (*response.ProductResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ProductUseCases.UpdateProduct")
+	defer span.End()
+
+	res, err := m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		existingProduct, err := m.productRepo.GetById(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		existingProduct.Name = request.Name
+		existingProduct.Description = request.Description
+		existingProduct.Price = request.Price
+		existingProduct.Currency = toCurrency(request.Currency)
+
+		err = m.productRepo.Update(ctx, existingProduct)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.wardrobeRepo.SyncProduct(ctx, existingProduct)
+		if err != nil {
+			return nil, err
+		}
+
+		return existingProduct, nil
+	}, nil)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[ProductUseCases.UpdateProduct] Failed to update product")
+		return nil, err
+	}
+
+	return toProductResponse(res.(*model.Product)), nil
+}
+
+func (m *Module) DeleteProduct(ctx context.Context, id *uuid.UUID) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ProductUseCases.DeleteProduct")
+	defer span.End()
+
+	err := m.productRepo.Delete(ctx, id)
+	if errors.Is(err, dao.ErrReferenced) {
+		return &custerr.ErrChain{
+			Message: errorcode.ProductInUse.Message,
+			Code:    errorcode.ProductInUse.Code,
+			Type:    libResponse.ErrConflict,
+		}
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[ProductUseCases.DeleteProduct] Failed to delete product")
+		return err
+	}
+
+	return nil
+}
+
+func (m *Module) GetVariants(ctx context.Context, id *uuid.UUID) (*response.ProductVariantsResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ProductUseCases.GetVariants")
+	defer span.End()
+
+	product, err := m.productRepo.GetById(ctx, id)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[ProductUseCases.GetVariants] Failed to get product by ID")
+		return nil, err
+	}
+
+	variants, err := m.wardrobeUc.GetByProduct(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.ProductVariantsResponse{
+		Product:  toProductResponse(product),
+		Variants: *variants,
+	}, nil
+}
+
+// AddVariant creates a wardrobe under the product, the wardrobe use case takes care of inheriting name and price
+func (m *Module) AddVariant(ctx context.Context, id *uuid.UUID, variantReq *request.VariantRequest) (*response.WardrobeResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ProductUseCases.AddVariant")
+	defer span.End()
+
+	res, err := m.wardrobeUc.InsertWardrobe(ctx, &request.WardrobeInsertRequest{
+		ProductID: id,
+		SKU:       variantReq.SKU,
+		Color:     variantReq.Color,
+		Size:      variantReq.Size,
+		Price:     variantReq.Price,
+		Stock:     variantReq.Stock,
+	})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":   err,
+			"id":      id,
+			"request": variantReq,
+		}).ErrorWithCtx(ctx, "[ProductUseCases.AddVariant] Failed to add variant")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Search looks variants up like the wardrobe search does and groups them by their product,
+// wardrobes without a product are returned in a group of their own. The page counts variants, not groups.
+func (m *Module) Search(ctx context.Context, searchReq *request.WardrobeSearchRequest, pageReq *request.PageRequest) (*[]response.ProductVariantsResponse, *rest.Pagination, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ProductUseCases.Search")
+	defer span.End()
+
+	variants, pagination, err := m.wardrobeUc.Search(ctx, searchReq, pageReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		groups     []response.ProductVariantsResponse
+		groupIndex = map[string]int{}
+		productIds []uuid.UUID
+	)
+	for _, variant := range *variants {
+		if variant.ProductID == "" {
+			groups = append(groups, response.ProductVariantsResponse{Variants: []response.WardrobeResponse{variant}})
+			continue
+		}
+
+		idx, found := groupIndex[variant.ProductID]
+		if !found {
+			idx = len(groups)
+			groupIndex[variant.ProductID] = idx
+			groups = append(groups, response.ProductVariantsResponse{})
+			productIds = append(productIds, uuid.MustParse(variant.ProductID))
+		}
+		groups[idx].Variants = append(groups[idx].Variants, variant)
+	}
+
+	if len(productIds) > 0 {
+		products, err := m.productRepo.GetByIds(ctx, productIds)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error": err,
+			}).ErrorWithCtx(ctx, "[ProductUseCases.Search] Failed to get products")
+			return nil, nil, err
+		}
+
+		for _, product := range *products {
+			groups[groupIndex[product.ID.String()]].Product = toProductResponse(&product)
+		}
+	}
+
+	return &groups, pagination, nil
+}
+
+func toProductResponse(product *model.Product) *response.ProductResponse {
+	return &response.ProductResponse{
+		ID:          product.ID.String(),
+		Name:        product.Name,
+		Description: product.Description,
+		Price:       product.Price,
+		Currency:    product.Currency,
+		CreatedAt:   product.CreatedAt,
+		UpdatedAt:   product.UpdatedAt,
+	}
+}
+
+func toCurrency(currency string) string {
+	if currency == constants.EmptyString {
+		return constants.DefaultCurrency
+	}
+	return model.NormalizeCurrency(currency)
+}
@@ -0,0 +1,37 @@
+package purchase
+
+import (
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/internal/usecases"
+	"sagara_backend_test/lib/txmanager"
+)
+
+type Module struct {
+	purchaseOrderRepo repository.PurchaseOrderRepository
+	receiptRepo       repository.PurchaseReceiptRepository
+	wardrobeRepo      repository.WardrobeRepository
+	locationRepo      repository.LocationRepository
+	wardrobeUc        usecases.WardrobeUseCases
+	txMgr             txmanager.TxManager
+}
+
+type Opts struct {
+	PurchaseOrderRepo repository.PurchaseOrderRepository
+	ReceiptRepo       repository.PurchaseReceiptRepository
+	WardrobeRepo      repository.WardrobeRepository
+	LocationRepo      repository.LocationRepository
+	// WardrobeUc adds the stock received, in the transaction of the receipt
+	WardrobeUc usecases.WardrobeUseCases
+	TxMgr      txmanager.TxManager
+}
+
+func New(opts *Opts) usecases.PurchaseOrderUseCases {
+	return &Module{
+		purchaseOrderRepo: opts.PurchaseOrderRepo,
+		receiptRepo:       opts.ReceiptRepo,
+		wardrobeRepo:      opts.WardrobeRepo,
+		locationRepo:      opts.LocationRepo,
+		wardrobeUc:        opts.WardrobeUc,
+		txMgr:             opts.TxMgr,
+	}
+}
@@ -0,0 +1,488 @@
+package purchase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/infrastructures/actor"
+	"sagara_backend_test/internal/interfaces/dao"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/log"
+	libResponse "sagara_backend_test/lib/response"
+	"sagara_backend_test/lib/response/rest"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/pkg/constants"
+	"sagara_backend_test/pkg/constants/errorcode"
+	"time"
+)
+
+func (m *Module) GetPurchaseOrders(ctx context.Context, filterReq *request.PurchaseOrderFilterRequest, pageReq *request.PageRequest) (*[]response.PurchaseOrderResponse, *rest.Pagination, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PurchaseOrderUseCases.GetPurchaseOrders")
+	defer span.End()
+
+	if pageReq.Sort == constants.EmptyString {
+		recentReq := *pageReq
+		recentReq.Sort = "-created_at"
+		pageReq = &recentReq
+	}
+
+	page, err := pageReq.ToPage(model.PurchaseOrderSortFields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	orders, pageInfo, err := m.purchaseOrderRepo.Get(ctx, &model.PurchaseOrderFilter{
+		Status:   filterReq.Status,
+		Supplier: filterReq.Supplier,
+	}, page)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":  err,
+			"filter": filterReq,
+		}).ErrorWithCtx(ctx, "[PurchaseOrderUseCases.GetPurchaseOrders] Failed to get purchase orders")
+		return nil, nil, err
+	}
+
+	orderResponses := []response.PurchaseOrderResponse{}
+	for _, order := range *orders {
+		orderResponses = append(orderResponses, *toPurchaseOrderResponse(&order))
+	}
+
+	return &orderResponses, response.NewPagination(page, pageInfo), nil
+}
+
+// GetPurchaseOrder returns the order with its lines and the receipts of the goods received so far
+func (m *Module) GetPurchaseOrder(ctx context.Context, id *uuid.UUID) (*response.PurchaseOrderResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PurchaseOrderUseCases.GetPurchaseOrder")
+	defer span.End()
+
+	order, err := m.purchaseOrderRepo.GetById(ctx, id)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[PurchaseOrderUseCases.GetPurchaseOrder] Failed to get purchase order by ID")
+		return nil, err
+	}
+
+	res, err := m.toDetailResponse(ctx, order)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[PurchaseOrderUseCases.GetPurchaseOrder] Failed to get purchase order details")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (m *Module) CreatePurchaseOrder(ctx context.Context, request *request.PurchaseOrderRequest) (*response.PurchaseOrderResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PurchaseOrderUseCases.CreatePurchaseOrder")
+	defer span.End()
+
+	now := time.Now()
+	newOrder := &model.PurchaseOrder{
+		ID:        uuid.New(),
+		Status:    model.PurchaseOrderDraft,
+		CreatedBy: actor.FromContext(ctx),
+		BaseModel: model.BaseModel{
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+	applyRequest(newOrder, request)
+
+	res, err := m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		err := m.purchaseOrderRepo.Insert(ctx, newOrder)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.replaceLines(ctx, newOrder, request.Lines)
+		if err != nil {
+			return nil, err
+		}
+
+		return m.detail(ctx, &newOrder.ID)
+	}, nil)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":   err,
+			"request": request,
+		}).ErrorWithCtx(ctx, "[PurchaseOrderUseCases.CreatePurchaseOrder] Failed to create purchase order")
+		return nil, err
+	}
+
+	return res.(*response.PurchaseOrderResponse), nil
+}
+
+// UpdatePurchaseOrder replaces the supplier, the location and the lines of a draft order
+func (m *Module) UpdatePurchaseOrder(ctx context.Context, id *uuid.UUID, request *request.PurchaseOrderRequest) (*response.PurchaseOrderResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PurchaseOrderUseCases.UpdatePurchaseOrder")
+	defer span.End()
+
+	res, err := m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		order, err := m.purchaseOrderRepo.LockById(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if order.Status != model.PurchaseOrderDraft {
+			return nil, errNotDraft()
+		}
+
+		applyRequest(order, request)
+		err = m.purchaseOrderRepo.Update(ctx, order)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.purchaseOrderRepo.DeleteLines(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.replaceLines(ctx, order, request.Lines)
+		if err != nil {
+			return nil, err
+		}
+
+		return m.detail(ctx, id)
+	}, nil)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":   err,
+			"id":      id,
+			"request": request,
+		}).ErrorWithCtx(ctx, "[PurchaseOrderUseCases.UpdatePurchaseOrder] Failed to update purchase order")
+		return nil, err
+	}
+
+	return res.(*response.PurchaseOrderResponse), nil
+}
+
+// SendPurchaseOrder marks a draft order as sent to the supplier, goods can be received for it from then on
+func (m *Module) SendPurchaseOrder(ctx context.Context, id *uuid.UUID) (*response.PurchaseOrderResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PurchaseOrderUseCases.SendPurchaseOrder")
+	defer span.End()
+
+	res, err := m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		order, err := m.purchaseOrderRepo.LockById(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if order.Status != model.PurchaseOrderDraft {
+			return nil, errNotDraft()
+		}
+
+		now := time.Now()
+		order.Status = model.PurchaseOrderSent
+		order.SentAt = &now
+		err = m.purchaseOrderRepo.Update(ctx, order)
+		if err != nil {
+			return nil, err
+		}
+
+		return m.detail(ctx, id)
+	}, nil)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[PurchaseOrderUseCases.SendPurchaseOrder] Failed to send purchase order")
+		return nil, err
+	}
+
+	return res.(*response.PurchaseOrderResponse), nil
+}
+
+// CancelPurchaseOrder closes an order that is not fully received, the goods already received stay in stock
+func (m *Module) CancelPurchaseOrder(ctx context.Context, id *uuid.UUID) (*response.PurchaseOrderResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PurchaseOrderUseCases.CancelPurchaseOrder")
+	defer span.End()
+
+	res, err := m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		order, err := m.purchaseOrderRepo.LockById(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if order.Status == model.PurchaseOrderReceived || order.Status == model.PurchaseOrderCancelled {
+			return nil, &custerr.ErrChain{
+				Message: errorcode.PurchaseOrderClosed.Message,
+				Code:    errorcode.PurchaseOrderClosed.Code,
+				Type:    libResponse.ErrConflict,
+			}
+		}
+
+		now := time.Now()
+		order.Status = model.PurchaseOrderCancelled
+		order.CancelledAt = &now
+		err = m.purchaseOrderRepo.Update(ctx, order)
+		if err != nil {
+			return nil, err
+		}
+
+		return m.detail(ctx, id)
+	}, nil)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[PurchaseOrderUseCases.CancelPurchaseOrder] Failed to cancel purchase order")
+		return nil, err
+	}
+
+	return res.(*response.PurchaseOrderResponse), nil
+}
+
+// ReceivePurchaseOrder records a delivery: the quantities are added to the lines and to the stock of their wardrobes
+// in one transaction, and the order becomes received once nothing is outstanding
+func (m *Module) ReceivePurchaseOrder(ctx context.Context, id *uuid.UUID, request *request.PurchaseReceiveRequest) (*response.PurchaseOrderResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PurchaseOrderUseCases.ReceivePurchaseOrder")
+	defer span.End()
+
+	res, err := m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		order, err := m.purchaseOrderRepo.LockById(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if !order.IsOpen() {
+			return nil, &custerr.ErrChain{
+				Message: errorcode.PurchaseOrderNotOpen.Message,
+				Code:    errorcode.PurchaseOrderNotOpen.Code,
+				Type:    libResponse.ErrConflict,
+			}
+		}
+
+		location, err := m.receivingLocation(ctx, order, request.LocationID)
+		if err != nil {
+			return nil, err
+		}
+
+		lines, err := m.purchaseOrderRepo.GetLines(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		linesById := map[uuid.UUID]*model.PurchaseOrderLine{}
+		for i := range *lines {
+			linesById[(*lines)[i].ID] = &(*lines)[i]
+		}
+
+		receipt := &model.PurchaseReceipt{
+			ID:              uuid.New(),
+			PurchaseOrderID: order.ID,
+			LocationID:      location.ID,
+			Note:            request.Note,
+			ReceivedBy:      actor.FromContext(ctx),
+			CreatedAt:       time.Now(),
+		}
+		for _, lineReq := range request.Lines {
+			line, found := linesById[*lineReq.LineID]
+			if !found {
+				return nil, dao.ErrNoResult
+			}
+
+			err = m.receiveLine(ctx, order, line, lineReq.Quantity, &location.ID)
+			if err != nil {
+				return nil, err
+			}
+			receipt.Lines = append(receipt.Lines, model.PurchaseReceiptLine{
+				ReceiptID: receipt.ID,
+				LineID:    line.ID,
+				Quantity:  lineReq.Quantity,
+			})
+		}
+
+		err = m.receiptRepo.Insert(ctx, receipt)
+		if err != nil {
+			return nil, err
+		}
+
+		order.Status = model.PurchaseOrderReceived
+		for _, line := range *lines {
+			if line.Outstanding() > 0 {
+				order.Status = model.PurchaseOrderPartiallyReceived
+				break
+			}
+		}
+		if order.Status == model.PurchaseOrderReceived {
+			order.ReceivedAt = &receipt.CreatedAt
+		}
+		err = m.purchaseOrderRepo.Update(ctx, order)
+		if err != nil {
+			return nil, err
+		}
+
+		return m.detail(ctx, id)
+	}, nil)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":   err,
+			"id":      id,
+			"request": request,
+		}).ErrorWithCtx(ctx, "[PurchaseOrderUseCases.ReceivePurchaseOrder] Failed to receive purchase order")
+		return nil, err
+	}
+
+	return res.(*response.PurchaseOrderResponse), nil
+}
+
+// receiveLine adds quantity to the line and to the stock of its wardrobe at the location
+func (m *Module) receiveLine(ctx context.Context, order *model.PurchaseOrder, line *model.PurchaseOrderLine, quantity int, locationId *uuid.UUID) error {
+	errOverOutstanding := &custerr.ErrChain{
+		Message: errorcode.ReceiptOverOutstanding.Message,
+		Code:    errorcode.ReceiptOverOutstanding.Code,
+		Type:    libResponse.ErrBadRequest,
+	}
+	if quantity > line.Outstanding() {
+		return errOverOutstanding
+	}
+
+	err := m.purchaseOrderRepo.ReceiveLine(ctx, &line.ID, quantity)
+	if errors.Is(err, dao.ErrNoUpdateHappened) {
+		return errOverOutstanding
+	}
+	if err != nil {
+		return err
+	}
+	line.ReceivedQuantity += quantity
+
+	_, err = m.wardrobeUc.AddStock(ctx, &line.WardrobeID, &request.WardrobeAddSubRequest{
+		Amount:     quantity,
+		Reason:     fmt.Sprintf("purchase order %s received", order.ID),
+		LocationID: locationId,
+	})
+	return err
+}
+
+// receivingLocation is the location of the delivery, else the one of the order, else the default location
+func (m *Module) receivingLocation(ctx context.Context, order *model.PurchaseOrder, locationId *uuid.UUID) (*model.Location, error) {
+	if locationId == nil {
+		locationId = order.LocationID
+	}
+	if locationId == nil {
+		return m.locationRepo.GetDefault(ctx)
+	}
+	return m.locationRepo.GetById(ctx, locationId)
+}
+
+// replaceLines inserts the lines of the request for the order, every wardrobe must exist and not be in the trash
+func (m *Module) replaceLines(ctx context.Context, order *model.PurchaseOrder, lineReqs []request.PurchaseOrderLineRequest) error {
+	now := time.Now()
+	var lines []model.PurchaseOrderLine
+	for _, lineReq := range lineReqs {
+		_, err := m.wardrobeRepo.GetById(ctx, lineReq.WardrobeID)
+		if err != nil {
+			return err
+		}
+
+		lines = append(lines, model.PurchaseOrderLine{
+			ID:              uuid.New(),
+			PurchaseOrderID: order.ID,
+			WardrobeID:      *lineReq.WardrobeID,
+			Quantity:        lineReq.Quantity,
+			UnitCost:        lineReq.UnitCost,
+			BaseModel: model.BaseModel{
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+		})
+	}
+
+	return m.purchaseOrderRepo.InsertLines(ctx, lines)
+}
+
+func (m *Module) detail(ctx context.Context, id *uuid.UUID) (*response.PurchaseOrderResponse, error) {
+	order, err := m.purchaseOrderRepo.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return m.toDetailResponse(ctx, order)
+}
+
+func (m *Module) toDetailResponse(ctx context.Context, order *model.PurchaseOrder) (*response.PurchaseOrderResponse, error) {
+	lines, err := m.purchaseOrderRepo.GetLines(ctx, &order.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	receipts, err := m.receiptRepo.GetByPurchaseOrder(ctx, &order.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := toPurchaseOrderResponse(order)
+	for _, line := range *lines {
+		res.Lines = append(res.Lines, response.PurchaseOrderLineResponse{
+			ID:               line.ID.String(),
+			WardrobeID:       line.WardrobeID.String(),
+			Quantity:         line.Quantity,
+			UnitCost:         line.UnitCost,
+			Total:            line.UnitCost.Mul(line.Quantity),
+			ReceivedQuantity: line.ReceivedQuantity,
+			Outstanding:      line.Outstanding(),
+		})
+	}
+	for _, receipt := range *receipts {
+		receiptResponse := response.PurchaseReceiptResponse{
+			ID:         receipt.ID.String(),
+			LocationID: receipt.LocationID.String(),
+			Note:       receipt.Note,
+			ReceivedBy: receipt.ReceivedBy,
+			CreatedAt:  receipt.CreatedAt,
+			Lines:      []response.PurchaseReceiptLineResponse{},
+		}
+		for _, line := range receipt.Lines {
+			receiptResponse.Lines = append(receiptResponse.Lines, response.PurchaseReceiptLineResponse{
+				LineID:   line.LineID.String(),
+				Quantity: line.Quantity,
+			})
+		}
+		res.Receipts = append(res.Receipts, receiptResponse)
+	}
+
+	return res, nil
+}
+
+func applyRequest(order *model.PurchaseOrder, request *request.PurchaseOrderRequest) {
+	order.Supplier = request.Supplier
+	order.Currency = model.NormalizeCurrency(request.Currency)
+	if order.Currency == constants.EmptyString {
+		order.Currency = constants.DefaultCurrency
+	}
+	order.LocationID = request.LocationID
+	order.Note = request.Note
+}
+
+func errNotDraft() error {
+	return &custerr.ErrChain{
+		Message: errorcode.PurchaseOrderNotDraft.Message,
+		Code:    errorcode.PurchaseOrderNotDraft.Code,
+		Type:    libResponse.ErrConflict,
+	}
+}
+
+func toPurchaseOrderResponse(order *model.PurchaseOrder) *response.PurchaseOrderResponse {
+	res := &response.PurchaseOrderResponse{
+		ID:          order.ID.String(),
+		Supplier:    order.Supplier,
+		Currency:    order.Currency,
+		Status:      order.Status,
+		Note:        order.Note,
+		Total:       order.Total,
+		CreatedBy:   order.CreatedBy,
+		SentAt:      order.SentAt,
+		ReceivedAt:  order.ReceivedAt,
+		CancelledAt: order.CancelledAt,
+		CreatedAt:   order.CreatedAt,
+		UpdatedAt:   order.UpdatedAt,
+	}
+	if order.LocationID != nil {
+		res.LocationID = order.LocationID.String()
+	}
+	return res
+}
@@ -0,0 +1,19 @@
+package usecases
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/response/rest"
+)
+
+type PurchaseOrderUseCases interface {
+	GetPurchaseOrders(ctx context.Context, filter *request.PurchaseOrderFilterRequest, page *request.PageRequest) (*[]response.PurchaseOrderResponse, *rest.Pagination, error)
+	GetPurchaseOrder(ctx context.Context, id *uuid.UUID) (*response.PurchaseOrderResponse, error)
+	CreatePurchaseOrder(ctx context.Context, request *request.PurchaseOrderRequest) (*response.PurchaseOrderResponse, error)
+	UpdatePurchaseOrder(ctx context.Context, id *uuid.UUID, request *request.PurchaseOrderRequest) (*response.PurchaseOrderResponse, error)
+	SendPurchaseOrder(ctx context.Context, id *uuid.UUID) (*response.PurchaseOrderResponse, error)
+	CancelPurchaseOrder(ctx context.Context, id *uuid.UUID) (*response.PurchaseOrderResponse, error)
+	ReceivePurchaseOrder(ctx context.Context, id *uuid.UUID, request *request.PurchaseReceiveRequest) (*response.PurchaseOrderResponse, error)
+}
@@ -0,0 +1,49 @@
+package request
+
+import (
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/response"
+	"sagara_backend_test/pkg/constants/errorcode"
+)
+
+// StockAlertRuleRequest sets the threshold of a wardrobe, or of a category and its descendants
+type StockAlertRuleRequest struct {
+	WardrobeID *uuid.UUID `json:"wardrobe_id,omitempty"`
+	CategoryID *uuid.UUID `json:"category_id,omitempty"`
+	// Threshold raises an alert when the available stock falls under it
+	Threshold int `json:"threshold" example:"5"`
+}
+
+func (s *StockAlertRuleRequest) ValidateRule() error {
+	if (s.WardrobeID == nil) == (s.CategoryID == nil) {
+		return &custerr.ErrChain{
+			Message: errorcode.AlertRuleTargetInvalid.Message,
+			Code:    errorcode.AlertRuleTargetInvalid.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+	if s.Threshold <= 0 {
+		return &custerr.ErrChain{
+			Message: errorcode.AlertThresholdInvalid.Message,
+			Code:    errorcode.AlertThresholdInvalid.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+
+	return nil
+}
+
+func ValidateAlertStatus(status string) error {
+	switch status {
+	case model.StockAlertOpen, model.StockAlertAcknowledged, model.StockAlertResolved:
+		return nil
+	default:
+		return &custerr.ErrChain{
+			Message: errorcode.AlertStatusInvalid.Message,
+			Code:    errorcode.AlertStatusInvalid.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package request
+
+import (
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/response"
+	"sagara_backend_test/pkg/constants"
+	"sagara_backend_test/pkg/constants/errorcode"
+	"slices"
+	"strings"
+	"time"
+)
+
+type APIKeyRequest struct {
+	Name   string   `json:"name" example:"POS Jakarta 01"`
+	Scopes []string `json:"scopes" example:"wardrobe:read,stock:adjust"`
+	// ExpiresAt is when the key stops working, it never expires when empty
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+func (a *APIKeyRequest) ValidateAPIKey() error {
+	errInvalid := &custerr.ErrChain{
+		Message: errorcode.APIKeyInvalid.Message,
+		Code:    errorcode.APIKeyInvalid.Code,
+		Type:    response.ErrBadRequest,
+	}
+
+	a.Name = strings.TrimSpace(a.Name)
+	if a.Name == constants.EmptyString || len(a.Name) > 255 || len(a.Scopes) == 0 {
+		return errInvalid
+	}
+	if a.ExpiresAt != nil && !a.ExpiresAt.After(time.Now()) {
+		return errInvalid
+	}
+
+	for _, scope := range a.Scopes {
+		if !slices.Contains(constants.Scopes, scope) {
+			return errInvalid
+		}
+	}
+	slices.Sort(a.Scopes)
+	a.Scopes = slices.Compact(a.Scopes)
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package request
+
+import (
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/response"
+	"sagara_backend_test/pkg/constants"
+	"sagara_backend_test/pkg/constants/errorcode"
+	"time"
+)
+
+type AuditRequest struct {
+	Entity string
+	ID     *uuid.UUID
+	Actor  string
+	From   *time.Time
+	To     *time.Time
+}
+
+func (a *AuditRequest) ValidateAudit() error {
+	if a.Entity != constants.EmptyString && a.Entity != model.AuditEntityWardrobe {
+		return &custerr.ErrChain{
+			Message: errorcode.AuditEntityInvalid.Message,
+			Code:    errorcode.AuditEntityInvalid.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+
+	if a.From != nil && a.To != nil && a.From.After(*a.To) {
+		return &custerr.ErrChain{
+			Message: errorcode.AuditRangeInvalid.Message,
+			Code:    errorcode.AuditRangeInvalid.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+
+	return nil
+}
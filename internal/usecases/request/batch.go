@@ -0,0 +1,112 @@
+package request
+
+import (
+	"github.com/google/uuid"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/response"
+	"sagara_backend_test/pkg/constants"
+	"sagara_backend_test/pkg/constants/errorcode"
+)
+
+const (
+	// BatchModeAtomic applies every operation or none of them
+	BatchModeAtomic = "atomic"
+	// BatchModeBestEffort applies the operations that succeed and skips the others
+	BatchModeBestEffort = "best_effort"
+)
+
+const (
+	BatchOpCreate      = "create"
+	BatchOpUpdate      = "update"
+	BatchOpDelete      = "delete"
+	BatchOpAdjustStock = "adjust_stock"
+)
+
+type WardrobeBatchRequest struct {
+	// Mode is atomic when empty
+	Mode       string                   `json:"mode,omitempty" example:"atomic"`
+	Operations []WardrobeBatchOperation `json:"operations"`
+}
+
+// WardrobeBatchOperation carries the payload of its op, the others are ignored
+type WardrobeBatchOperation struct {
+	Op string `json:"op" example:"update"`
+	// ID of the wardrobe to update, delete or adjust
+	ID *uuid.UUID `json:"id,omitempty"`
+	// Version the update expects the wardrobe to be at, like the If-Match header of a single update
+	Version int                    `json:"version,omitempty"`
+	Create  *WardrobeInsertRequest `json:"create,omitempty"`
+	Update  *WardrobeUpdateRequest `json:"update,omitempty"`
+	// Delta is added to the stock, a negative one takes from it
+	Delta  int    `json:"delta,omitempty"`
+	Reason string `json:"reason,omitempty"`
+	// LocationID is the location the delta applies to, the default location when empty
+	LocationID *uuid.UUID `json:"location_id,omitempty"`
+}
+
+func (w *WardrobeBatchRequest) ValidateBatch() error {
+	if w.Mode != constants.EmptyString && w.Mode != BatchModeAtomic && w.Mode != BatchModeBestEffort {
+		return &custerr.ErrChain{
+			Message: errorcode.BatchModeInvalid.Message,
+			Code:    errorcode.BatchModeInvalid.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+	if len(w.Operations) == 0 || len(w.Operations) > constants.MaxBatchOperations {
+		return &custerr.ErrChain{
+			Message: errorcode.BatchSizeInvalid.Message,
+			Code:    errorcode.BatchSizeInvalid.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+
+	return nil
+}
+
+// ValidateOperation checks an operation on its own, the batch reports its error instead of rejecting the request
+func (w *WardrobeBatchOperation) ValidateOperation() error {
+	errInvalid := &custerr.ErrChain{
+		Message: errorcode.BatchOperationInvalid.Message,
+		Code:    errorcode.BatchOperationInvalid.Code,
+		Type:    response.ErrBadRequest,
+	}
+
+	switch w.Op {
+	case BatchOpCreate:
+		if w.Create == nil {
+			return errInvalid
+		}
+		return w.Create.ValidateInsertWardrobe()
+	case BatchOpUpdate:
+		if w.ID == nil || w.Update == nil {
+			return errInvalid
+		}
+		if w.Version == 0 {
+			return &custerr.ErrChain{
+				Message: errorcode.VersionRequired.Message,
+				Code:    errorcode.VersionRequired.Code,
+				Type:    response.ErrPreconditionRequired,
+			}
+		}
+		return w.Update.ValidateUpdateWardrobe()
+	case BatchOpDelete:
+		if w.ID == nil {
+			return errInvalid
+		}
+	case BatchOpAdjustStock:
+		if w.ID == nil {
+			return errInvalid
+		}
+		if w.Delta == 0 {
+			return &custerr.ErrChain{
+				Message: errorcode.AmountInvalid.Message,
+				Code:    errorcode.AmountInvalid.Code,
+				Type:    response.ErrBadRequest,
+			}
+		}
+	default:
+		return errInvalid
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package request
+
+import (
+	"github.com/google/uuid"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/response"
+	"sagara_backend_test/pkg/constants"
+	"sagara_backend_test/pkg/constants/errorcode"
+)
+
+type CategoryRequest struct {
+	// ParentID places the category under another one, empty makes it a root category
+	ParentID *uuid.UUID `json:"parent_id,omitempty"`
+	Name     string     `json:"name"`
+}
+
+func (c *CategoryRequest) ValidateCategory() error {
+	if c.Name == constants.EmptyString {
+		return &custerr.ErrChain{
+			Message: errorcode.NameEmpty.Message,
+			Code:    errorcode.NameEmpty.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+
+	return nil
+}
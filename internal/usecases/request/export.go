@@ -0,0 +1,30 @@
+package request
+
+import (
+	"sagara_backend_test/internal/infrastructures/spreadsheet"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/response"
+	"sagara_backend_test/pkg/constants/errorcode"
+)
+
+// ExportFormatJSON exports the wardrobes as a json array, the other formats are the spreadsheet ones
+const ExportFormatJSON = "json"
+
+type WardrobeExportRequest struct {
+	// Format is csv, xlsx or json
+	Format string
+	// Search narrows the export down to the matching wardrobes
+	Search WardrobeSearchRequest
+}
+
+func (w *WardrobeExportRequest) ValidateExport() error {
+	if w.Format != ExportFormatJSON && !spreadsheet.IsSupported(w.Format) {
+		return &custerr.ErrChain{
+			Message: errorcode.ExportFormatInvalid.Message,
+			Code:    errorcode.ExportFormatInvalid.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package request
+
+import (
+	"sagara_backend_test/internal/infrastructures/spreadsheet"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/response"
+	"sagara_backend_test/pkg/constants/errorcode"
+)
+
+type WardrobeImportRequest struct {
+	FileName string
+	// Format is either csv or xlsx
+	Format string
+	// DryRun only validates the rows and reports what would be created and updated
+	DryRun bool
+	Data   []byte
+}
+
+func (w *WardrobeImportRequest) ValidateImport() error {
+	if !spreadsheet.IsSupported(w.Format) {
+		return &custerr.ErrChain{
+			Message: errorcode.ImportFormatInvalid.Message,
+			Code:    errorcode.ImportFormatInvalid.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+	if len(w.Data) == 0 {
+		return &custerr.ErrChain{
+			Message: errorcode.ImportFileEmpty.Message,
+			Code:    errorcode.ImportFileEmpty.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package request
+
+import (
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/response"
+	"sagara_backend_test/pkg/constants"
+	"sagara_backend_test/pkg/constants/errorcode"
+	"strings"
+)
+
+type LocationRequest struct {
+	Code string `json:"code" example:"JKT-01"`
+	Name string `json:"name" example:"Jakarta store"`
+	// Kind is either store or warehouse
+	Kind string `json:"kind" example:"store"`
+}
+
+type StockTransferRequest struct {
+	FromLocationID *uuid.UUID `json:"from_location_id"`
+	ToLocationID   *uuid.UUID `json:"to_location_id"`
+	Quantity       int        `json:"quantity" example:"10"`
+	Note           string     `json:"note,omitempty"`
+}
+
+type StockTransferFilterRequest struct {
+	WardrobeID *uuid.UUID
+	// LocationID matches the transfers leaving or arriving at the location
+	LocationID *uuid.UUID
+	Status     string
+}
+
+func (l *LocationRequest) ValidateLocation() error {
+	l.Code = strings.TrimSpace(l.Code)
+	l.Name = strings.TrimSpace(l.Name)
+	if l.Code == constants.EmptyString || l.Name == constants.EmptyString ||
+		(l.Kind != model.LocationStore && l.Kind != model.LocationWarehouse) {
+		return &custerr.ErrChain{
+			Message: errorcode.LocationInvalid.Message,
+			Code:    errorcode.LocationInvalid.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+
+	return nil
+}
+
+func (s *StockTransferRequest) ValidateTransfer() error {
+	if s.FromLocationID == nil || s.ToLocationID == nil || *s.FromLocationID == *s.ToLocationID || s.Quantity <= 0 {
+		return &custerr.ErrChain{
+			Message: errorcode.TransferInvalid.Message,
+			Code:    errorcode.TransferInvalid.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+
+	return nil
+}
+
+func (s *StockTransferFilterRequest) ValidateFilter() error {
+	switch s.Status {
+	case constants.EmptyString, model.TransferInTransit, model.TransferReceived, model.TransferCancelled:
+		return nil
+	default:
+		return &custerr.ErrChain{
+			Message: errorcode.TransferStatusInvalid.Message,
+			Code:    errorcode.TransferStatusInvalid.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package request
+
+import (
+	"regexp"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/response"
+	"sagara_backend_test/pkg/constants"
+	"sagara_backend_test/pkg/constants/errorcode"
+)
+
+var currencyCode = regexp.MustCompile(`^[A-Za-z]{3}$`)
+
+// validatePrice rejects negative prices and currencies that are not ISO 4217 codes, an empty currency is left to the caller
+func validatePrice(price model.Money, currency string) error {
+	if price.IsNegative() {
+		return &custerr.ErrChain{
+			Message: errorcode.PriceNegative.Message,
+			Code:    errorcode.PriceNegative.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+	if currency != constants.EmptyString && !currencyCode.MatchString(currency) {
+		return &custerr.ErrChain{
+			Message: errorcode.CurrencyInvalid.Message,
+			Code:    errorcode.CurrencyInvalid.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package request
+
+import (
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/response"
+	"sagara_backend_test/pkg/constants"
+	"sagara_backend_test/pkg/constants/errorcode"
+	"strings"
+)
+
+type PageRequest struct {
+	Limit  int
+	Cursor string
+	// Sort is a comma separated list of fields, a leading "-" sorts the field descending
+	Sort      string
+	WithTotal bool
+}
+
+// ToPage validates the page against the sortable fields of the listed resource
+func (p *PageRequest) ToPage(sortFields model.SortFields) (*model.Page, error) {
+	page := &model.Page{
+		Limit:     p.Limit,
+		WithTotal: p.WithTotal,
+	}
+
+	if page.Limit == 0 {
+		page.Limit = constants.DefaultPageLimit
+	}
+	if page.Limit < 0 || page.Limit > constants.MaxPageLimit {
+		return nil, &custerr.ErrChain{
+			Message: errorcode.LimitInvalid.Message,
+			Code:    errorcode.LimitInvalid.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+
+	if p.Sort != constants.EmptyString {
+		for _, field := range strings.Split(p.Sort, ",") {
+			sort := model.SortField{Field: strings.TrimSpace(field)}
+			if strings.HasPrefix(sort.Field, "-") {
+				sort.Field = sort.Field[1:]
+				sort.Desc = true
+			}
+
+			if _, ok := sortFields[sort.Field]; !ok {
+				return nil, &custerr.ErrChain{
+					Message: errorcode.SortInvalid.Message,
+					Code:    errorcode.SortInvalid.Code,
+					Type:    response.ErrBadRequest,
+				}
+			}
+			page.Sort = append(page.Sort, sort)
+		}
+	}
+
+	if p.Cursor != constants.EmptyString {
+		cursor, err := model.DecodePageCursor(p.Cursor)
+		// a cursor carries one value per sort field plus the id
+		valid := err == nil && len(cursor.Values) == len(page.Sort)+1
+		if valid {
+			_, err = uuid.Parse(cursor.Values[len(page.Sort)])
+			valid = err == nil
+		}
+		// the values are cast to the column types by the query, a tampered one must not reach it
+		for i := 0; valid && i < len(page.Sort); i++ {
+			valid = sortFields[page.Sort[i].Field].Valid(cursor.Values[i])
+		}
+		if !valid {
+			return nil, &custerr.ErrChain{
+				Message: errorcode.CursorInvalid.Message,
+				Code:    errorcode.CursorInvalid.Code,
+				Type:    response.ErrBadRequest,
+			}
+		}
+		page.After = cursor
+	}
+
+	return page, nil
+}
@@ -0,0 +1,36 @@
+package request
+
+import (
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/response"
+	"sagara_backend_test/pkg/constants/errorcode"
+	"time"
+)
+
+type PriceScheduleRequest struct {
+	Price model.Money `json:"price" swaggertype:"string" example:"99000.00"`
+	// Currency keeps the currency of the wardrobe when empty
+	Currency      string    `json:"currency,omitempty" example:"IDR"`
+	EffectiveFrom time.Time `json:"effective_from"`
+	// EffectiveUntil restores the previous price when reached, the scheduled price stays for good without it
+	EffectiveUntil *time.Time `json:"effective_until,omitempty"`
+	Reason         string     `json:"reason"`
+}
+
+func (p *PriceScheduleRequest) ValidatePriceSchedule() error {
+	err := validatePrice(p.Price, p.Currency)
+	if err != nil {
+		return err
+	}
+
+	if !p.EffectiveFrom.After(time.Now()) || (p.EffectiveUntil != nil && !p.EffectiveUntil.After(p.EffectiveFrom)) {
+		return &custerr.ErrChain{
+			Message: errorcode.ScheduleInvalid.Message,
+			Code:    errorcode.ScheduleInvalid.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+
+	return nil
+}
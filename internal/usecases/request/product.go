@@ -0,0 +1,60 @@
+package request
+
+import (
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/response"
+	"sagara_backend_test/pkg/constants"
+	"sagara_backend_test/pkg/constants/errorcode"
+)
+
+type ProductRequest struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Price       model.Money `json:"price" swaggertype:"string" example:"149000.00"`
+	// Currency is an ISO 4217 code, IDR when empty
+	Currency string `json:"currency,omitempty" example:"IDR"`
+}
+
+// VariantRequest describes a variant added under an existing product, an empty price inherits the product price
+type VariantRequest struct {
+	SKU   string       `json:"sku,omitempty"`
+	Color string       `json:"color"`
+	Size  string       `json:"size"`
+	Price *model.Money `json:"price,omitempty" swaggertype:"string" example:"149000.00"`
+	Stock int          `json:"stock"`
+}
+
+func (p *ProductRequest) ValidateProduct() error {
+	if p.Name == constants.EmptyString {
+		return &custerr.ErrChain{
+			Message: errorcode.NameEmpty.Message,
+			Code:    errorcode.NameEmpty.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+
+	return validatePrice(p.Price, p.Currency)
+}
+
+func (v *VariantRequest) ValidateVariant() error {
+	if v.Color == constants.EmptyString {
+		return &custerr.ErrChain{
+			Message: errorcode.ColorEmpty.Message,
+			Code:    errorcode.ColorEmpty.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+	if v.Size == constants.EmptyString {
+		return &custerr.ErrChain{
+			Message: errorcode.SizeEmpty.Message,
+			Code:    errorcode.SizeEmpty.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+	if v.Price != nil {
+		return validatePrice(*v.Price, constants.EmptyString)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package request
+
+import (
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/response"
+	"sagara_backend_test/pkg/constants"
+	"sagara_backend_test/pkg/constants/errorcode"
+	"strings"
+)
+
+type PurchaseOrderRequest struct {
+	Supplier string `json:"supplier" example:"PT Tekstil Nusantara"`
+	// Currency of the unit costs, IDR when empty
+	Currency string `json:"currency,omitempty" example:"IDR"`
+	// LocationID is where the goods are received by default, the default location when empty
+	LocationID *uuid.UUID                 `json:"location_id,omitempty"`
+	Note       string                     `json:"note,omitempty"`
+	Lines      []PurchaseOrderLineRequest `json:"lines"`
+}
+
+type PurchaseOrderLineRequest struct {
+	WardrobeID *uuid.UUID  `json:"wardrobe_id"`
+	Quantity   int         `json:"quantity" example:"50"`
+	UnitCost   model.Money `json:"unit_cost" swaggertype:"string" example:"85000.00"`
+}
+
+type PurchaseReceiveRequest struct {
+	// LocationID overrides the location of the order for this delivery
+	LocationID *uuid.UUID                   `json:"location_id,omitempty"`
+	Note       string                       `json:"note,omitempty"`
+	Lines      []PurchaseReceiveLineRequest `json:"lines"`
+}
+
+type PurchaseReceiveLineRequest struct {
+	LineID   *uuid.UUID `json:"line_id"`
+	Quantity int        `json:"quantity" example:"20"`
+}
+
+type PurchaseOrderFilterRequest struct {
+	Status string
+	// Supplier matches the suppliers containing it, ignoring case
+	Supplier string
+}
+
+func (p *PurchaseOrderRequest) ValidatePurchaseOrder() error {
+	errInvalid := &custerr.ErrChain{
+		Message: errorcode.PurchaseOrderInvalid.Message,
+		Code:    errorcode.PurchaseOrderInvalid.Code,
+		Type:    response.ErrBadRequest,
+	}
+
+	p.Supplier = strings.TrimSpace(p.Supplier)
+	if p.Supplier == constants.EmptyString || len(p.Lines) == 0 || len(p.Lines) > constants.MaxOrderLines {
+		return errInvalid
+	}
+
+	seen := map[uuid.UUID]bool{}
+	for _, line := range p.Lines {
+		if line.WardrobeID == nil || line.Quantity <= 0 || seen[*line.WardrobeID] {
+			return errInvalid
+		}
+		seen[*line.WardrobeID] = true
+
+		err := validatePrice(line.UnitCost, p.Currency)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *PurchaseReceiveRequest) ValidateReceive() error {
+	errInvalid := &custerr.ErrChain{
+		Message: errorcode.ReceiptInvalid.Message,
+		Code:    errorcode.ReceiptInvalid.Code,
+		Type:    response.ErrBadRequest,
+	}
+
+	if len(p.Lines) == 0 || len(p.Lines) > constants.MaxOrderLines {
+		return errInvalid
+	}
+
+	seen := map[uuid.UUID]bool{}
+	for _, line := range p.Lines {
+		if line.LineID == nil || line.Quantity <= 0 || seen[*line.LineID] {
+			return errInvalid
+		}
+		seen[*line.LineID] = true
+	}
+
+	return nil
+}
+
+func (p *PurchaseOrderFilterRequest) ValidateFilter() error {
+	switch p.Status {
+	case constants.EmptyString, model.PurchaseOrderDraft, model.PurchaseOrderSent, model.PurchaseOrderPartiallyReceived,
+		model.PurchaseOrderReceived, model.PurchaseOrderCancelled:
+		return nil
+	default:
+		return &custerr.ErrChain{
+			Message: errorcode.PurchaseOrderStatusInvalid.Message,
+			Code:    errorcode.PurchaseOrderStatusInvalid.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+}
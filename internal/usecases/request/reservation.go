@@ -0,0 +1,31 @@
+package request
+
+import (
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/response"
+	"sagara_backend_test/pkg/constants/errorcode"
+)
+
+type ReservationRequest struct {
+	Quantity   int `json:"quantity"`
+	TTLSeconds int `json:"ttl_seconds"`
+}
+
+func (r *ReservationRequest) ValidateReservation() error {
+	if r.Quantity <= 0 {
+		return &custerr.ErrChain{
+			Message: errorcode.QuantityInvalid.Message,
+			Code:    errorcode.QuantityInvalid.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+	if r.TTLSeconds < 0 {
+		return &custerr.ErrChain{
+			Message: errorcode.TTLInvalid.Message,
+			Code:    errorcode.TTLInvalid.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package request
+
+import (
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/response"
+	"sagara_backend_test/pkg/constants"
+	"sagara_backend_test/pkg/constants/errorcode"
+	"strings"
+)
+
+type RoleAssignmentRequest struct {
+	// Subject is the subject of the tokens of a user, or api-key:<id> for an API key
+	Subject string `json:"subject" example:"api-key:3f1c2a9e-7d4b-4c55-9a7e-2b1f0c8d6e41"`
+	Role    string `json:"role" example:"clerk"`
+}
+
+func (r *RoleAssignmentRequest) ValidateRoleAssignment() error {
+	r.Subject = strings.TrimSpace(r.Subject)
+	if r.Subject == constants.EmptyString || len(r.Subject) > 255 || !model.IsValidRole(r.Role) {
+		return &custerr.ErrChain{
+			Message: errorcode.RoleAssignmentInvalid.Message,
+			Code:    errorcode.RoleAssignmentInvalid.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+	return nil
+}
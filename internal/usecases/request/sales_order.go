@@ -0,0 +1,77 @@
+package request
+
+import (
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/response"
+	"sagara_backend_test/pkg/constants"
+	"sagara_backend_test/pkg/constants/errorcode"
+	"strings"
+	"time"
+)
+
+type SalesOrderRequest struct {
+	Customer string `json:"customer,omitempty" example:"Toko Maju Jaya"`
+	// LocationID is where the stock is taken from, the default location when empty
+	LocationID *uuid.UUID              `json:"location_id,omitempty"`
+	Note       string                  `json:"note,omitempty"`
+	Lines      []SalesOrderLineRequest `json:"lines"`
+}
+
+// SalesOrderLineRequest has no price, the line is priced with the wardrobe when the order is placed
+type SalesOrderLineRequest struct {
+	WardrobeID *uuid.UUID `json:"wardrobe_id"`
+	Quantity   int        `json:"quantity" example:"2"`
+}
+
+type SalesOrderFilterRequest struct {
+	Status string
+	From   *time.Time
+	To     *time.Time
+}
+
+func (s *SalesOrderRequest) ValidateSalesOrder() error {
+	errInvalid := &custerr.ErrChain{
+		Message: errorcode.SalesOrderInvalid.Message,
+		Code:    errorcode.SalesOrderInvalid.Code,
+		Type:    response.ErrBadRequest,
+	}
+
+	s.Customer = strings.TrimSpace(s.Customer)
+	if len(s.Lines) == 0 || len(s.Lines) > constants.MaxOrderLines {
+		return errInvalid
+	}
+
+	seen := map[uuid.UUID]bool{}
+	for _, line := range s.Lines {
+		if line.WardrobeID == nil || line.Quantity <= 0 || seen[*line.WardrobeID] {
+			return errInvalid
+		}
+		seen[*line.WardrobeID] = true
+	}
+
+	return nil
+}
+
+func (s *SalesOrderFilterRequest) ValidateFilter() error {
+	switch s.Status {
+	case constants.EmptyString, model.SalesOrderPlaced, model.SalesOrderFulfilled, model.SalesOrderCancelled:
+	default:
+		return &custerr.ErrChain{
+			Message: errorcode.SalesOrderStatusInvalid.Message,
+			Code:    errorcode.SalesOrderStatusInvalid.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+
+	if s.From != nil && s.To != nil && s.From.After(*s.To) {
+		return &custerr.ErrChain{
+			Message: errorcode.SalesOrderRangeInvalid.Message,
+			Code:    errorcode.SalesOrderRangeInvalid.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+
+	return nil
+}
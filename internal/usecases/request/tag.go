@@ -0,0 +1,25 @@
+package request
+
+import (
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/response"
+	"sagara_backend_test/pkg/constants"
+	"sagara_backend_test/pkg/constants/errorcode"
+	"strings"
+)
+
+type TagRequest struct {
+	Name string `json:"name"`
+}
+
+func (t *TagRequest) ValidateTag() error {
+	if strings.TrimSpace(t.Name) == constants.EmptyString {
+		return &custerr.ErrChain{
+			Message: errorcode.TagEmpty.Message,
+			Code:    errorcode.TagEmpty.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+
+	return nil
+}
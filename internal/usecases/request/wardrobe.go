@@ -1,54 +1,164 @@
 package request
 
 import (
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
 	"sagara_backend_test/lib/custerr"
 	"sagara_backend_test/lib/response"
 	"sagara_backend_test/pkg/constants"
 	"sagara_backend_test/pkg/constants/errorcode"
+	"strings"
 )
 
 type WardrobeInsertRequest struct {
-	Name  string  `json:"name"`
-	Color string  `json:"color"`
-	Size  string  `json:"size"`
-	Price float32 `json:"price"`
-	Stock int     `json:"stock"`
+	ProductID *uuid.UUID `json:"product_id,omitempty"`
+	SKU       string     `json:"sku,omitempty"`
+	Name      string     `json:"name"`
+	Color     string     `json:"color"`
+	Size      string     `json:"size"`
+	// Price of a variant falls back to the product price when empty
+	Price *model.Money `json:"price,omitempty" swaggertype:"string" example:"149000.00"`
+	// Currency falls back to the product currency, or IDR without a product
+	Currency string `json:"currency,omitempty" example:"IDR"`
+	Stock    int    `json:"stock"`
 }
 
 type WardrobeUpdateRequest struct {
-	Name  string  `json:"name"`
-	Color string  `json:"color"`
-	Size  string  `json:"size"`
-	Price float32 `json:"price"`
-	Stock int     `json:"stock"`
+	SKU   string      `json:"sku,omitempty"`
+	Name  string      `json:"name"`
+	Color string      `json:"color"`
+	Size  string      `json:"size"`
+	Price model.Money `json:"price" swaggertype:"string" example:"149000.00"`
+	// Currency keeps the current currency when empty
+	Currency string `json:"currency,omitempty" example:"IDR"`
+	// PriceReason is recorded in the price history when the price changes
+	PriceReason string `json:"price_reason,omitempty"`
+	Stock       int    `json:"stock"`
+}
+
+type WardrobeSearchRequest struct {
+	// Query is a free text matched against the name, color and sku
+	Query      string
+	Color      string
+	Size       string
+	CategoryID *uuid.UUID
+	Tags       []string
+	// TagMatch is either "any" (default) or "all"
+	TagMatch string
+}
+
+type WardrobeCategoryRequest struct {
+	// CategoryID removes the wardrobe from its category when empty
+	CategoryID *uuid.UUID `json:"category_id"`
+}
+
+type WardrobeTagsRequest struct {
+	Tags []string `json:"tags"`
 }
 
 type WardrobeAddSubRequest struct {
-	Amount int `json:"amount"`
+	Amount int    `json:"amount"`
+	Reason string `json:"reason"`
+	// LocationID is the location the stock is added to or taken from, the default location when empty
+	LocationID *uuid.UUID `json:"location_id,omitempty"`
+}
+
+// ValidateInsertWardrobe requires a name unless the wardrobe is a variant of a product, which takes the product name
+func (w *WardrobeInsertRequest) ValidateInsertWardrobe() error {
+	if w.Name == constants.EmptyString && w.ProductID == nil {
+		return &custerr.ErrChain{
+			Message: errorcode.NameEmpty.Message,
+			Code:    errorcode.NameEmpty.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+	if w.Color == constants.EmptyString {
+		return &custerr.ErrChain{
+			Message: errorcode.ColorEmpty.Message,
+			Code:    errorcode.ColorEmpty.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+	if w.Size == constants.EmptyString {
+		return &custerr.ErrChain{
+			Message: errorcode.SizeEmpty.Message,
+			Code:    errorcode.SizeEmpty.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+	if w.Price != nil {
+		return validatePrice(*w.Price, w.Currency)
+	}
+	return validatePrice(0, w.Currency)
 }
 
 func (w *WardrobeUpdateRequest) ValidateUpdateWardrobe() error {
 	if w.Name == constants.EmptyString {
-		return custerr.ErrChain{
+		return &custerr.ErrChain{
 			Message: errorcode.NameEmpty.Message,
 			Code:    errorcode.NameEmpty.Code,
 			Type:    response.ErrBadRequest,
 		}
 	}
 	if w.Color == constants.EmptyString {
-		return custerr.ErrChain{
+		return &custerr.ErrChain{
 			Message: errorcode.ColorEmpty.Message,
 			Code:    errorcode.ColorEmpty.Code,
 			Type:    response.ErrBadRequest,
 		}
 	}
 	if w.Size == constants.EmptyString {
-		return custerr.ErrChain{
+		return &custerr.ErrChain{
 			Message: errorcode.SizeEmpty.Message,
 			Code:    errorcode.SizeEmpty.Code,
 			Type:    response.ErrBadRequest,
 		}
 	}
 
+	return validatePrice(w.Price, w.Currency)
+}
+
+func (w *WardrobeAddSubRequest) ValidateAddSubStock() error {
+	if w.Amount <= 0 {
+		return &custerr.ErrChain{
+			Message: errorcode.AmountInvalid.Message,
+			Code:    errorcode.AmountInvalid.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+
+	return nil
+}
+
+func (w *WardrobeSearchRequest) ValidateSearch() error {
+	if w.TagMatch != constants.EmptyString && w.TagMatch != constants.TagMatchAny && w.TagMatch != constants.TagMatchAll {
+		return &custerr.ErrChain{
+			Message: errorcode.TagMatchInvalid.Message,
+			Code:    errorcode.TagMatchInvalid.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+
+	return nil
+}
+
+func (w *WardrobeTagsRequest) ValidateTags() error {
+	if len(w.Tags) == 0 {
+		return &custerr.ErrChain{
+			Message: errorcode.TagEmpty.Message,
+			Code:    errorcode.TagEmpty.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+	for _, tag := range w.Tags {
+		if strings.TrimSpace(tag) == constants.EmptyString {
+			return &custerr.ErrChain{
+				Message: errorcode.TagEmpty.Message,
+				Code:    errorcode.TagEmpty.Code,
+				Type:    response.ErrBadRequest,
+			}
+		}
+	}
+
 	return nil
 }
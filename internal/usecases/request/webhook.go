@@ -0,0 +1,43 @@
+package request
+
+import (
+	"net/url"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/response"
+	"sagara_backend_test/pkg/constants/errorcode"
+	"slices"
+)
+
+type WebhookRequest struct {
+	URL string `json:"url" example:"https://partner.example.com/hooks/inventory"`
+	// EventTypes are the events pushed to the url, every event when it is empty
+	EventTypes []string `json:"event_types" example:"wardrobe.stock_changed"`
+	// Secret signs the deliveries, one is generated when it is empty on creation and it is kept when it is empty on update
+	Secret string `json:"secret,omitempty"`
+	// Active set to true enables a disabled subscription again, it resets its failures
+	Active *bool `json:"active,omitempty"`
+}
+
+func (w *WebhookRequest) ValidateWebhook() error {
+	endpoint, err := url.Parse(w.URL)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return &custerr.ErrChain{
+			Message: errorcode.WebhookURLInvalid.Message,
+			Code:    errorcode.WebhookURLInvalid.Code,
+			Type:    response.ErrBadRequest,
+		}
+	}
+
+	for _, eventType := range w.EventTypes {
+		if !slices.Contains(model.WebhookEvents, eventType) {
+			return &custerr.ErrChain{
+				Message: errorcode.WebhookEventInvalid.Message,
+				Code:    errorcode.WebhookEventInvalid.Code,
+				Type:    response.ErrBadRequest,
+			}
+		}
+	}
+
+	return nil
+}
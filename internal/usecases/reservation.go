@@ -0,0 +1,16 @@
+package usecases
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+)
+
+type ReservationUseCases interface {
+	Reserve(ctx context.Context, wardrobeId *uuid.UUID, request *request.ReservationRequest) (*response.ReservationResponse, error)
+	GetReservation(ctx context.Context, id *uuid.UUID) (*response.ReservationResponse, error)
+	Commit(ctx context.Context, id *uuid.UUID) (*response.ReservationResponse, error)
+	Release(ctx context.Context, id *uuid.UUID) (*response.ReservationResponse, error)
+	ExpireReservations(ctx context.Context) (int64, error)
+}
@@ -0,0 +1,37 @@
+package reservation
+
+import (
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/internal/usecases"
+	"sagara_backend_test/lib/txmanager"
+	"time"
+)
+
+type Module struct {
+	reservationRepo repository.ReservationRepository
+	wardrobeRepo    repository.WardrobeRepository
+	wardrobeUc      usecases.WardrobeUseCases
+	txMgr           txmanager.TxManager
+	defaultTTL      time.Duration
+	maxTTL          time.Duration
+}
+
+type Opts struct {
+	ReservationRepo repository.ReservationRepository
+	WardrobeRepo    repository.WardrobeRepository
+	WardrobeUc      usecases.WardrobeUseCases
+	TxMgr           txmanager.TxManager
+	DefaultTTL      time.Duration
+	MaxTTL          time.Duration
+}
+
+func New(opts *Opts) usecases.ReservationUseCases {
+	return &Module{
+		reservationRepo: opts.ReservationRepo,
+		wardrobeRepo:    opts.WardrobeRepo,
+		wardrobeUc:      opts.WardrobeUc,
+		txMgr:           opts.TxMgr,
+		defaultTTL:      opts.DefaultTTL,
+		maxTTL:          opts.MaxTTL,
+	}
+}
@@ -0,0 +1,208 @@
+package reservation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/interfaces/dao"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/log"
+	libResponse "sagara_backend_test/lib/response"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/pkg/constants/errorcode"
+	"time"
+)
+
+func (m *Module) Reserve(ctx context.Context, wardrobeId *uuid.UUID, request *request.ReservationRequest) (*response.ReservationResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ReservationUseCases.Reserve")
+	defer span.End()
+
+	ttl := m.defaultTTL
+	if request.TTLSeconds > 0 {
+		ttl = time.Duration(request.TTLSeconds) * time.Second
+	}
+	if m.maxTTL > 0 && ttl > m.maxTTL {
+		return nil, &custerr.ErrChain{
+			Message: errorcode.TTLInvalid.Message,
+			Code:    errorcode.TTLInvalid.Code,
+			Type:    libResponse.ErrBadRequest,
+		}
+	}
+
+	now := time.Now()
+	newReservation := &model.Reservation{
+		BaseModel: model.BaseModel{
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		ID:         uuid.New(),
+		WardrobeID: *wardrobeId,
+		Quantity:   request.Quantity,
+		Status:     model.ReservationActive,
+		ExpiresAt:  now.Add(ttl),
+	}
+
+	_, err := m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		// lock the wardrobe so concurrent reservations are checked one after another
+		err := m.wardrobeRepo.LockById(ctx, wardrobeId)
+		if err != nil {
+			return nil, err
+		}
+
+		wardrobe, err := m.wardrobeRepo.GetById(ctx, wardrobeId)
+		if err != nil {
+			return nil, err
+		}
+
+		if wardrobe.Available() < request.Quantity {
+			return nil, &custerr.ErrChain{
+				Message: errorcode.StockInsufficient.Message,
+				Code:    errorcode.StockInsufficient.Code,
+				Type:    libResponse.ErrBadRequest,
+			}
+		}
+
+		err = m.reservationRepo.Insert(ctx, newReservation)
+		if err != nil {
+			return nil, err
+		}
+
+		// the available stock in the wardrobe response changed, its ETag has to change too
+		return nil, m.wardrobeRepo.BumpVersion(ctx, wardrobeId)
+	}, nil)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"wardrobe_id": wardrobeId,
+			"request":     request,
+		}).ErrorWithCtx(ctx, "[ReservationUseCases.Reserve] Failed to reserve stock")
+		return nil, err
+	}
+
+	return toReservationResponse(newReservation), nil
+}
+
+func (m *Module) GetReservation(ctx context.Context, id *uuid.UUID) (*response.ReservationResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ReservationUseCases.GetReservation")
+	defer span.End()
+
+	reservation, err := m.reservationRepo.GetById(ctx, id)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[ReservationUseCases.GetReservation] Failed to get reservation by ID")
+		return nil, err
+	}
+
+	return toReservationResponse(reservation), nil
+}
+
+// Commit turns the held stock into a real deduction of the wardrobe stock
+func (m *Module) Commit(ctx context.Context, id *uuid.UUID) (*response.ReservationResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ReservationUseCases.Commit")
+	defer span.End()
+
+	res, err := m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		// close the reservation first, the deduction below must not count it as held anymore
+		reservation, err := m.closeReservation(ctx, id, model.ReservationCommitted)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = m.wardrobeUc.SubStock(ctx, &reservation.WardrobeID, &request.WardrobeAddSubRequest{
+			Amount: reservation.Quantity,
+			Reason: fmt.Sprintf("reservation %s committed", reservation.ID),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return reservation, nil
+	}, nil)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[ReservationUseCases.Commit] Failed to commit reservation")
+		return nil, err
+	}
+
+	return toReservationResponse(res.(*model.Reservation)), nil
+}
+
+// Release gives the held stock back before the reservation expires
+func (m *Module) Release(ctx context.Context, id *uuid.UUID) (*response.ReservationResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ReservationUseCases.Release")
+	defer span.End()
+
+	res, err := m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		reservation, err := m.closeReservation(ctx, id, model.ReservationReleased)
+		if err != nil {
+			return nil, err
+		}
+
+		return reservation, m.wardrobeRepo.BumpVersion(ctx, &reservation.WardrobeID)
+	}, nil)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[ReservationUseCases.Release] Failed to release reservation")
+		return nil, err
+	}
+
+	return toReservationResponse(res.(*model.Reservation)), nil
+}
+
+// ExpireReservations marks every active reservation past its expiry as expired, it is run by the sweeper
+func (m *Module) ExpireReservations(ctx context.Context) (int64, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ReservationUseCases.ExpireReservations")
+	defer span.End()
+
+	expired, err := m.reservationRepo.ExpireStale(ctx, time.Now())
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[ReservationUseCases.ExpireReservations] Failed to expire reservations")
+		return 0, err
+	}
+
+	return expired, nil
+}
+
+func (m *Module) closeReservation(ctx context.Context, id *uuid.UUID, status string) (*model.Reservation, error) {
+	reservation, err := m.reservationRepo.UpdateStatus(ctx, id, status)
+	if errors.Is(err, dao.ErrNoUpdateHappened) {
+		// either it does not exist or it is not active anymore
+		if _, err = m.reservationRepo.GetById(ctx, id); err != nil {
+			return nil, err
+		}
+		return nil, &custerr.ErrChain{
+			Message: errorcode.ReservationNotActive.Message,
+			Code:    errorcode.ReservationNotActive.Code,
+			Type:    libResponse.ErrConflict,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return reservation, nil
+}
+
+func toReservationResponse(reservation *model.Reservation) *response.ReservationResponse {
+	return &response.ReservationResponse{
+		ID:         reservation.ID.String(),
+		WardrobeID: reservation.WardrobeID.String(),
+		Quantity:   reservation.Quantity,
+		Status:     reservation.Status,
+		ExpiresAt:  reservation.ExpiresAt,
+		CreatedAt:  reservation.CreatedAt,
+		UpdatedAt:  reservation.UpdatedAt,
+	}
+}
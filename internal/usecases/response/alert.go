@@ -0,0 +1,33 @@
+package response
+
+import "time"
+
+type StockAlertRuleResponse struct {
+	ID         string    `json:"id"`
+	WardrobeID string    `json:"wardrobe_id,omitempty"`
+	CategoryID string    `json:"category_id,omitempty"`
+	Threshold  int       `json:"threshold"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type StockAlertResponse struct {
+	ID         string `json:"id"`
+	WardrobeID string `json:"wardrobe_id"`
+	RuleID     string `json:"rule_id,omitempty"`
+	Threshold  int    `json:"threshold"`
+	// Stock is the available stock that raised the alert
+	Stock  int    `json:"stock"`
+	Status string `json:"status" example:"open"`
+	// NotifiedChannels are the channels the alert was delivered to
+	NotifiedChannels []string   `json:"notified_channels"`
+	NotifiedAt       *time.Time `json:"notified_at,omitempty"`
+	NotifyError      string     `json:"notify_error,omitempty"`
+	AcknowledgedBy   string     `json:"acknowledged_by,omitempty"`
+	AcknowledgedAt   *time.Time `json:"acknowledged_at,omitempty"`
+	ResolvedAt       *time.Time `json:"resolved_at,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+
+	// Wardrobe is only filled in the notifications
+	Wardrobe *WardrobeResponse `json:"wardrobe,omitempty"`
+}
@@ -0,0 +1,19 @@
+package response
+
+import "time"
+
+type APIKeyResponse struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Prefix string `json:"prefix"`
+	// Subject is who the key acts as, the roles are assigned to it
+	Subject string   `json:"subject"`
+	Scopes  []string `json:"scopes"`
+	// Key is only returned when the key is created, it can not be read again afterwards
+	Key        string     `json:"key,omitempty"`
+	CreatedBy  string     `json:"created_by"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
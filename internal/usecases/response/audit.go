@@ -0,0 +1,18 @@
+package response
+
+import (
+	"sagara_backend_test/internal/domain/model"
+	"time"
+)
+
+type AuditLogResponse struct {
+	ID        string `json:"id"`
+	Entity    string `json:"entity" example:"wardrobe"`
+	EntityID  string `json:"entity_id"`
+	Action    string `json:"action" example:"update"`
+	Actor     string `json:"actor"`
+	RequestID string `json:"request_id,omitempty"`
+	// Diff holds the before and after values of every changed field
+	Diff      model.AuditDiff `json:"diff" swaggertype:"object"`
+	CreatedAt time.Time       `json:"created_at"`
+}
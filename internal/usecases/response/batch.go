@@ -0,0 +1,32 @@
+package response
+
+const (
+	BatchSucceeded = "succeeded"
+	BatchFailed    = "failed"
+	// BatchRolledBack succeeded but was undone because another operation of an atomic batch failed
+	BatchRolledBack = "rolled_back"
+)
+
+type WardrobeBatchResponse struct {
+	Mode string `json:"mode"`
+	// Committed tells whether the changes of the succeeded operations were kept
+	Committed bool                     `json:"committed"`
+	Succeeded int                      `json:"succeeded"`
+	Failed    int                      `json:"failed"`
+	Results   []BatchOperationResponse `json:"results"`
+}
+
+type BatchOperationResponse struct {
+	Index    int               `json:"index"`
+	Op       string            `json:"op"`
+	Status   string            `json:"status"`
+	Wardrobe *WardrobeResponse `json:"wardrobe,omitempty"`
+	Error    *BatchError       `json:"error,omitempty"`
+}
+
+// BatchError is what the failed operation would have answered on its own endpoint
+type BatchError struct {
+	Status  int    `json:"status"`
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
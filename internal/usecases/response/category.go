@@ -0,0 +1,17 @@
+package response
+
+import "time"
+
+type CategoryResponse struct {
+	ID        string             `json:"id,omitempty"`
+	ParentID  string             `json:"parent_id,omitempty"`
+	Name      string             `json:"name,omitempty"`
+	Children  []CategoryResponse `json:"children,omitempty"`
+	CreatedAt time.Time          `json:"created_at"`
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+type TagResponse struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
@@ -0,0 +1,10 @@
+package response
+
+import "io"
+
+type ExportResponse struct {
+	FileName    string
+	ContentType string
+	// File is written while it is read, it fails with the error that stopped the export if any
+	File io.ReadCloser
+}
@@ -0,0 +1,8 @@
+package response
+
+// IdempotentResponse is the response stored for an idempotency key, it is replayed as it was sent
+type IdempotentResponse struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
@@ -0,0 +1,29 @@
+package response
+
+import "time"
+
+type ImportJobResponse struct {
+	ID        string `json:"id,omitempty"`
+	FileName  string `json:"file_name,omitempty"`
+	Format    string `json:"format,omitempty"`
+	DryRun    bool   `json:"dry_run"`
+	Status    string `json:"status,omitempty"`
+	TotalRows int    `json:"total_rows"`
+	Created   int    `json:"created"`
+	Updated   int    `json:"updated"`
+	Failed    int    `json:"failed"`
+	// Rows is the report of every row, filled once the job is done
+	Rows       []ImportRowResponse `json:"rows,omitempty"`
+	Error      string              `json:"error,omitempty"`
+	CreatedBy  string              `json:"created_by,omitempty"`
+	CreatedAt  time.Time           `json:"created_at"`
+	FinishedAt *time.Time          `json:"finished_at,omitempty"`
+}
+
+type ImportRowResponse struct {
+	Row        int      `json:"row"`
+	Action     string   `json:"action,omitempty"`
+	SKU        string   `json:"sku,omitempty"`
+	WardrobeID string   `json:"wardrobe_id,omitempty"`
+	Errors     []string `json:"errors,omitempty"`
+}
@@ -0,0 +1,45 @@
+package response
+
+import "time"
+
+type LocationResponse struct {
+	ID        string    `json:"id"`
+	Code      string    `json:"code"`
+	Name      string    `json:"name"`
+	Kind      string    `json:"kind" example:"store"`
+	IsDefault bool      `json:"is_default"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// StockLevelResponse breaks the stock of a wardrobe down by location, Stock is their sum
+type StockLevelResponse struct {
+	WardrobeID string `json:"wardrobe_id"`
+	Stock      int    `json:"stock"`
+	// InTransit has left its origin and is not counted in Stock until it is received
+	InTransit int                     `json:"in_transit"`
+	Locations []LocationStockResponse `json:"locations"`
+}
+
+type LocationStockResponse struct {
+	LocationID string `json:"location_id"`
+	Code       string `json:"code"`
+	Name       string `json:"name"`
+	IsDefault  bool   `json:"is_default"`
+	Quantity   int    `json:"quantity"`
+}
+
+type StockTransferResponse struct {
+	ID             string     `json:"id"`
+	WardrobeID     string     `json:"wardrobe_id"`
+	FromLocationID string     `json:"from_location_id"`
+	ToLocationID   string     `json:"to_location_id"`
+	Quantity       int        `json:"quantity"`
+	Status         string     `json:"status" example:"in_transit"`
+	Note           string     `json:"note,omitempty"`
+	CreatedBy      string     `json:"created_by"`
+	ReceivedAt     *time.Time `json:"received_at,omitempty"`
+	CancelledAt    *time.Time `json:"cancelled_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
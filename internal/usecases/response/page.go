@@ -0,0 +1,17 @@
+package response
+
+import (
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/lib/response/rest"
+)
+
+func NewPagination(page *model.Page, pageInfo *model.PageInfo) *rest.Pagination {
+	pagination := &rest.Pagination{
+		Limit: page.Limit,
+		Total: pageInfo.Total,
+	}
+	if pageInfo.Next != nil {
+		pagination.NextCursor = pageInfo.Next.Encode()
+	}
+	return pagination
+}
@@ -0,0 +1,33 @@
+package response
+
+import (
+	"sagara_backend_test/internal/domain/model"
+	"time"
+)
+
+type PriceChangeResponse struct {
+	ID          string      `json:"id,omitempty"`
+	WardrobeID  string      `json:"wardrobe_id,omitempty"`
+	ScheduleID  string      `json:"schedule_id,omitempty"`
+	OldPrice    model.Money `json:"old_price" swaggertype:"string" example:"149000.00"`
+	OldCurrency string      `json:"old_currency,omitempty" example:"IDR"`
+	NewPrice    model.Money `json:"new_price" swaggertype:"string" example:"99000.00"`
+	NewCurrency string      `json:"new_currency,omitempty" example:"IDR"`
+	ChangedBy   string      `json:"changed_by,omitempty"`
+	Reason      string      `json:"reason,omitempty"`
+	CreatedAt   time.Time   `json:"created_at"`
+}
+
+type PriceScheduleResponse struct {
+	ID             string      `json:"id,omitempty"`
+	WardrobeID     string      `json:"wardrobe_id,omitempty"`
+	Price          model.Money `json:"price" swaggertype:"string" example:"99000.00"`
+	Currency       string      `json:"currency,omitempty" example:"IDR"`
+	EffectiveFrom  time.Time   `json:"effective_from"`
+	EffectiveUntil *time.Time  `json:"effective_until,omitempty"`
+	Status         string      `json:"status,omitempty"`
+	Reason         string      `json:"reason,omitempty"`
+	CreatedBy      string      `json:"created_by,omitempty"`
+	CreatedAt      time.Time   `json:"created_at"`
+	UpdatedAt      time.Time   `json:"updated_at"`
+}
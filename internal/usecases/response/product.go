@@ -0,0 +1,22 @@
+package response
+
+import (
+	"sagara_backend_test/internal/domain/model"
+	"time"
+)
+
+type ProductResponse struct {
+	ID          string      `json:"id,omitempty"`
+	Name        string      `json:"name,omitempty"`
+	Description string      `json:"description,omitempty"`
+	Price       model.Money `json:"price" swaggertype:"string" example:"149000.00"`
+	Currency    string      `json:"currency,omitempty" example:"IDR"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+}
+
+// ProductVariantsResponse is a product with its variants, Product is empty for wardrobes that do not belong to any product
+type ProductVariantsResponse struct {
+	Product  *ProductResponse   `json:"product,omitempty"`
+	Variants []WardrobeResponse `json:"variants"`
+}
@@ -0,0 +1,50 @@
+package response
+
+import (
+	"sagara_backend_test/internal/domain/model"
+	"time"
+)
+
+type PurchaseOrderResponse struct {
+	ID          string      `json:"id"`
+	Supplier    string      `json:"supplier"`
+	Currency    string      `json:"currency"`
+	LocationID  string      `json:"location_id,omitempty"`
+	Status      string      `json:"status" example:"sent"`
+	Note        string      `json:"note,omitempty"`
+	Total       model.Money `json:"total" swaggertype:"string" example:"4250000.00"`
+	CreatedBy   string      `json:"created_by"`
+	SentAt      *time.Time  `json:"sent_at,omitempty"`
+	ReceivedAt  *time.Time  `json:"received_at,omitempty"`
+	CancelledAt *time.Time  `json:"cancelled_at,omitempty"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+
+	// Lines and Receipts are only returned for a single order
+	Lines    []PurchaseOrderLineResponse `json:"lines,omitempty"`
+	Receipts []PurchaseReceiptResponse   `json:"receipts,omitempty"`
+}
+
+type PurchaseOrderLineResponse struct {
+	ID               string      `json:"id"`
+	WardrobeID       string      `json:"wardrobe_id"`
+	Quantity         int         `json:"quantity"`
+	UnitCost         model.Money `json:"unit_cost" swaggertype:"string" example:"85000.00"`
+	Total            model.Money `json:"total" swaggertype:"string" example:"4250000.00"`
+	ReceivedQuantity int         `json:"received_quantity"`
+	Outstanding      int         `json:"outstanding"`
+}
+
+type PurchaseReceiptResponse struct {
+	ID         string                        `json:"id"`
+	LocationID string                        `json:"location_id"`
+	Note       string                        `json:"note,omitempty"`
+	ReceivedBy string                        `json:"received_by"`
+	CreatedAt  time.Time                     `json:"created_at"`
+	Lines      []PurchaseReceiptLineResponse `json:"lines"`
+}
+
+type PurchaseReceiptLineResponse struct {
+	LineID   string `json:"line_id"`
+	Quantity int    `json:"quantity"`
+}
@@ -0,0 +1,16 @@
+package response
+
+import "time"
+
+type RoleResponse struct {
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
+
+type RoleAssignmentResponse struct {
+	Subject    string    `json:"subject"`
+	Role       string    `json:"role"`
+	AssignedBy string    `json:"assigned_by"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
@@ -0,0 +1,32 @@
+package response
+
+import (
+	"sagara_backend_test/internal/domain/model"
+	"time"
+)
+
+type SalesOrderResponse struct {
+	ID          string      `json:"id"`
+	Customer    string      `json:"customer,omitempty"`
+	Currency    string      `json:"currency"`
+	Total       model.Money `json:"total" swaggertype:"string" example:"300000.00"`
+	LocationID  string      `json:"location_id"`
+	Status      string      `json:"status" example:"placed"`
+	Note        string      `json:"note,omitempty"`
+	CreatedBy   string      `json:"created_by"`
+	FulfilledAt *time.Time  `json:"fulfilled_at,omitempty"`
+	CancelledAt *time.Time  `json:"cancelled_at,omitempty"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+
+	// Lines are only returned for a single order
+	Lines []SalesOrderLineResponse `json:"lines,omitempty"`
+}
+
+type SalesOrderLineResponse struct {
+	ID         string      `json:"id"`
+	WardrobeID string      `json:"wardrobe_id"`
+	Quantity   int         `json:"quantity"`
+	UnitPrice  model.Money `json:"unit_price" swaggertype:"string" example:"150000.00"`
+	Total      model.Money `json:"total" swaggertype:"string" example:"300000.00"`
+}
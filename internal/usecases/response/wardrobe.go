@@ -1,10 +1,58 @@
 package response
 
+import (
+	"sagara_backend_test/internal/domain/model"
+	"time"
+)
+
 type WardrobeResponse struct {
-	ID    string  `json:"id,omitempty"`
-	Name  string  `json:"name,omitempty"`
-	Color string  `json:"color,omitempty"`
-	Size  string  `json:"size,omitempty"`
-	Price float32 `json:"price,omitempty"`
-	Stock int     `json:"stock,omitempty"`
+	ID         string      `json:"id,omitempty"`
+	ProductID  string      `json:"product_id,omitempty"`
+	SKU        string      `json:"sku,omitempty"`
+	CategoryID string      `json:"category_id,omitempty"`
+	Name       string      `json:"name,omitempty"`
+	Color      string      `json:"color,omitempty"`
+	Size       string      `json:"size,omitempty"`
+	Price      model.Money `json:"price" swaggertype:"string" example:"149000.00"`
+	Currency   string      `json:"currency,omitempty" example:"IDR"`
+	Stock      int         `json:"stock,omitempty"`
+	Reserved   int         `json:"reserved"`
+	Available  int         `json:"available"`
+	Version    int         `json:"version,omitempty"`
+	DeletedAt  *time.Time  `json:"deleted_at,omitempty"`
+
+	// PriceSchedules are the active and upcoming price changes, only filled for a single wardrobe
+	PriceSchedules []PriceScheduleResponse `json:"price_schedules,omitempty"`
+}
+
+type PurgeResponse struct {
+	Purged        int64     `json:"purged"`
+	DeletedBefore time.Time `json:"deleted_before"`
+}
+
+type WardrobeSuggestionResponse struct {
+	ID    string `json:"id,omitempty"`
+	Name  string `json:"name,omitempty"`
+	Color string `json:"color,omitempty"`
+	Size  string `json:"size,omitempty"`
+	SKU   string `json:"sku,omitempty"`
+}
+
+type StockMovementResponse struct {
+	ID         string    `json:"id,omitempty"`
+	WardrobeID string    `json:"wardrobe_id,omitempty"`
+	Delta      int       `json:"delta"`
+	Reason     string    `json:"reason,omitempty"`
+	Balance    int       `json:"balance"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type ReservationResponse struct {
+	ID         string    `json:"id,omitempty"`
+	WardrobeID string    `json:"wardrobe_id,omitempty"`
+	Quantity   int       `json:"quantity"`
+	Status     string    `json:"status,omitempty"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
 }
@@ -0,0 +1,47 @@
+package response
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type WebhookResponse struct {
+	ID         string   `json:"id"`
+	URL        string   `json:"url"`
+	EventTypes []string `json:"event_types"`
+	// Secret is only returned when the subscription is created or its secret changed
+	Secret              string     `json:"secret,omitempty"`
+	Active              bool       `json:"active"`
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	DisabledAt          *time.Time `json:"disabled_at,omitempty"`
+	DisabledReason      string     `json:"disabled_reason,omitempty"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID        string `json:"id"`
+	WebhookID string `json:"webhook_id"`
+	EventID   string `json:"event_id"`
+	EventType string `json:"event_type"`
+	Status    string `json:"status" example:"pending"`
+	Attempts  int    `json:"attempts"`
+	// NextAttemptAt is only set while the delivery is pending
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	DeliveredAt   *time.Time `json:"delivered_at,omitempty"`
+	LastError     string     `json:"last_error,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+
+	// Payload and AttemptLogs are only returned for a single delivery
+	Payload     json.RawMessage                  `json:"payload,omitempty" swaggertype:"object"`
+	AttemptLogs []WebhookDeliveryAttemptResponse `json:"attempt_logs,omitempty"`
+}
+
+type WebhookDeliveryAttemptResponse struct {
+	Attempt      int       `json:"attempt"`
+	StatusCode   int       `json:"status_code,omitempty"`
+	ResponseBody string    `json:"response_body,omitempty"`
+	Error        string    `json:"error,omitempty"`
+	DurationMs   int64     `json:"duration_ms"`
+	CreatedAt    time.Time `json:"created_at"`
+}
@@ -0,0 +1,16 @@
+package usecases
+
+import (
+	"context"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+)
+
+type RoleUseCases interface {
+	GetRoles(ctx context.Context) (*[]response.RoleResponse, error)
+	GetAllRoleAssignment(ctx context.Context) (*[]response.RoleAssignmentResponse, error)
+	AssignRole(ctx context.Context, request *request.RoleAssignmentRequest) (*response.RoleAssignmentResponse, error)
+	UnassignRole(ctx context.Context, subject string) error
+	// ResolveRole returns the role the subject acts with and its permissions
+	ResolveRole(ctx context.Context, subject string) (*response.RoleResponse, error)
+}
@@ -0,0 +1,28 @@
+package role
+
+import (
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/internal/usecases"
+)
+
+type Module struct {
+	roleAssignmentRepo repository.RoleAssignmentRepository
+	admins             []string
+	defaultRole        string
+}
+
+type Opts struct {
+	RoleAssignmentRepo repository.RoleAssignmentRepository
+	// Admins are the subjects that are always admin, so there is someone to assign the first roles
+	Admins []string
+	// DefaultRole is the role of the subjects without an assignment, they have no permission when it is empty
+	DefaultRole string
+}
+
+func New(opts *Opts) usecases.RoleUseCases {
+	return &Module{
+		roleAssignmentRepo: opts.RoleAssignmentRepo,
+		admins:             opts.Admins,
+		defaultRole:        opts.DefaultRole,
+	}
+}
@@ -0,0 +1,134 @@
+package role
+
+import (
+	"context"
+	"errors"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/infrastructures/actor"
+	"sagara_backend_test/internal/interfaces/dao"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/pkg/constants"
+	"slices"
+	"time"
+)
+
+func (m *Module) GetRoles(ctx context.Context) (*[]response.RoleResponse, error) {
+	span, _ := tracing.StartSpanFromContext(ctx, "RoleUseCases.GetRoles")
+	defer span.End()
+
+	roleResponses := []response.RoleResponse{}
+	for _, role := range model.Roles {
+		roleResponses = append(roleResponses, *toRoleResponse(role))
+	}
+
+	return &roleResponses, nil
+}
+
+func (m *Module) GetAllRoleAssignment(ctx context.Context) (*[]response.RoleAssignmentResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "RoleUseCases.GetAllRoleAssignment")
+	defer span.End()
+
+	assignments, err := m.roleAssignmentRepo.GetAll(ctx)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[RoleUseCases.GetAllRoleAssignment] Failed to get all role assignment")
+		return nil, err
+	}
+
+	assignmentResponses := []response.RoleAssignmentResponse{}
+	for _, assignment := range *assignments {
+		assignmentResponses = append(assignmentResponses, *toRoleAssignmentResponse(&assignment))
+	}
+
+	return &assignmentResponses, nil
+}
+
+// AssignRole gives the role to the subject in place of the one it had
+func (m *Module) AssignRole(ctx context.Context, request *request.RoleAssignmentRequest) (*response.RoleAssignmentResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "RoleUseCases.AssignRole")
+	defer span.End()
+
+	now := time.Now()
+	assignment := &model.RoleAssignment{
+		Subject:    request.Subject,
+		Role:       request.Role,
+		AssignedBy: actor.FromContext(ctx),
+		BaseModel: model.BaseModel{
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+
+	err := m.roleAssignmentRepo.Upsert(ctx, assignment)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":   err,
+			"subject": request.Subject,
+		}).ErrorWithCtx(ctx, "[RoleUseCases.AssignRole] Failed to assign role")
+		return nil, err
+	}
+
+	return toRoleAssignmentResponse(assignment), nil
+}
+
+// UnassignRole takes the role of the subject away, it falls back to the default role
+func (m *Module) UnassignRole(ctx context.Context, subject string) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "RoleUseCases.UnassignRole")
+	defer span.End()
+
+	err := m.roleAssignmentRepo.Delete(ctx, subject)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":   err,
+			"subject": subject,
+		}).ErrorWithCtx(ctx, "[RoleUseCases.UnassignRole] Failed to unassign role")
+		return err
+	}
+
+	return nil
+}
+
+// ResolveRole picks, in order, admin for the configured admins, the assigned role and the default role
+func (m *Module) ResolveRole(ctx context.Context, subject string) (*response.RoleResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "RoleUseCases.ResolveRole")
+	defer span.End()
+
+	if subject != constants.EmptyString && slices.Contains(m.admins, subject) {
+		return toRoleResponse(model.RoleAdmin), nil
+	}
+
+	assignment, err := m.roleAssignmentRepo.GetBySubject(ctx, subject)
+	if errors.Is(err, dao.ErrNoResult) {
+		return toRoleResponse(m.defaultRole), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toRoleResponse(assignment.Role), nil
+}
+
+func toRoleResponse(role string) *response.RoleResponse {
+	permissions := model.RolePermissions(role)
+	if permissions == nil {
+		permissions = []string{}
+	}
+	return &response.RoleResponse{
+		Name:        role,
+		Permissions: permissions,
+	}
+}
+
+func toRoleAssignmentResponse(assignment *model.RoleAssignment) *response.RoleAssignmentResponse {
+	return &response.RoleAssignmentResponse{
+		Subject:    assignment.Subject,
+		Role:       assignment.Role,
+		AssignedBy: assignment.AssignedBy,
+		CreatedAt:  assignment.CreatedAt,
+		UpdatedAt:  assignment.UpdatedAt,
+	}
+}
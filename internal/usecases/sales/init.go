@@ -0,0 +1,34 @@
+package sales
+
+import (
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/internal/usecases"
+	"sagara_backend_test/lib/txmanager"
+)
+
+type Module struct {
+	salesOrderRepo repository.SalesOrderRepository
+	wardrobeRepo   repository.WardrobeRepository
+	locationRepo   repository.LocationRepository
+	wardrobeUc     usecases.WardrobeUseCases
+	txMgr          txmanager.TxManager
+}
+
+type Opts struct {
+	SalesOrderRepo repository.SalesOrderRepository
+	WardrobeRepo   repository.WardrobeRepository
+	LocationRepo   repository.LocationRepository
+	// WardrobeUc takes and returns the stock of the lines, in the transaction of the order
+	WardrobeUc usecases.WardrobeUseCases
+	TxMgr      txmanager.TxManager
+}
+
+func New(opts *Opts) usecases.SalesOrderUseCases {
+	return &Module{
+		salesOrderRepo: opts.SalesOrderRepo,
+		wardrobeRepo:   opts.WardrobeRepo,
+		locationRepo:   opts.LocationRepo,
+		wardrobeUc:     opts.WardrobeUc,
+		txMgr:          opts.TxMgr,
+	}
+}
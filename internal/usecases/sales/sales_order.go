@@ -0,0 +1,360 @@
+package sales
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/infrastructures/actor"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/log"
+	libResponse "sagara_backend_test/lib/response"
+	"sagara_backend_test/lib/response/rest"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/pkg/constants"
+	"sagara_backend_test/pkg/constants/errorcode"
+	"slices"
+	"strings"
+	"time"
+)
+
+func (m *Module) GetSalesOrders(ctx context.Context, filterReq *request.SalesOrderFilterRequest, pageReq *request.PageRequest) (*[]response.SalesOrderResponse, *rest.Pagination, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "SalesOrderUseCases.GetSalesOrders")
+	defer span.End()
+
+	if pageReq.Sort == constants.EmptyString {
+		recentReq := *pageReq
+		recentReq.Sort = "-created_at"
+		pageReq = &recentReq
+	}
+
+	page, err := pageReq.ToPage(model.SalesOrderSortFields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	orders, pageInfo, err := m.salesOrderRepo.Get(ctx, &model.SalesOrderFilter{
+		Status: filterReq.Status,
+		From:   filterReq.From,
+		To:     filterReq.To,
+	}, page)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":  err,
+			"filter": filterReq,
+		}).ErrorWithCtx(ctx, "[SalesOrderUseCases.GetSalesOrders] Failed to get sales orders")
+		return nil, nil, err
+	}
+
+	orderResponses := []response.SalesOrderResponse{}
+	for _, order := range *orders {
+		orderResponses = append(orderResponses, *toSalesOrderResponse(&order))
+	}
+
+	return &orderResponses, response.NewPagination(page, pageInfo), nil
+}
+
+func (m *Module) GetSalesOrder(ctx context.Context, id *uuid.UUID) (*response.SalesOrderResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "SalesOrderUseCases.GetSalesOrder")
+	defer span.End()
+
+	res, err := m.detail(ctx, id)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[SalesOrderUseCases.GetSalesOrder] Failed to get sales order by ID")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// PlaceSalesOrder prices every line with its wardrobe and takes their stock from the location in one transaction,
+// nothing is taken when the stock of any line is short
+func (m *Module) PlaceSalesOrder(ctx context.Context, request *request.SalesOrderRequest) (*response.SalesOrderResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "SalesOrderUseCases.PlaceSalesOrder")
+	defer span.End()
+
+	now := time.Now()
+	newOrder := &model.SalesOrder{
+		ID:        uuid.New(),
+		Customer:  request.Customer,
+		Status:    model.SalesOrderPlaced,
+		Note:      request.Note,
+		CreatedBy: actor.FromContext(ctx),
+		BaseModel: model.BaseModel{
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+
+	res, err := m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		location, err := m.stockLocation(ctx, request.LocationID)
+		if err != nil {
+			return nil, err
+		}
+		newOrder.LocationID = location.ID
+
+		lines, err := m.priceLines(ctx, newOrder, request.Lines)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.salesOrderRepo.Insert(ctx, newOrder)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.salesOrderRepo.InsertLines(ctx, lines)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.takeStock(ctx, newOrder, lines)
+		if err != nil {
+			return nil, err
+		}
+
+		return m.detail(ctx, &newOrder.ID)
+	}, nil)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":   err,
+			"request": request,
+		}).ErrorWithCtx(ctx, "[SalesOrderUseCases.PlaceSalesOrder] Failed to place sales order")
+		return nil, err
+	}
+
+	return res.(*response.SalesOrderResponse), nil
+}
+
+// FulfillSalesOrder marks a placed order as handed over to the customer, its stock can not be returned anymore
+func (m *Module) FulfillSalesOrder(ctx context.Context, id *uuid.UUID) (*response.SalesOrderResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "SalesOrderUseCases.FulfillSalesOrder")
+	defer span.End()
+
+	res, err := m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		order, err := m.lockPlaced(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		now := time.Now()
+		order.Status = model.SalesOrderFulfilled
+		order.FulfilledAt = &now
+		err = m.salesOrderRepo.Update(ctx, order)
+		if err != nil {
+			return nil, err
+		}
+
+		return m.detail(ctx, id)
+	}, nil)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[SalesOrderUseCases.FulfillSalesOrder] Failed to fulfill sales order")
+		return nil, err
+	}
+
+	return res.(*response.SalesOrderResponse), nil
+}
+
+// CancelSalesOrder returns the stock of every line to the location it was taken from
+func (m *Module) CancelSalesOrder(ctx context.Context, id *uuid.UUID) (*response.SalesOrderResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "SalesOrderUseCases.CancelSalesOrder")
+	defer span.End()
+
+	res, err := m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		order, err := m.lockPlaced(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		lines, err := m.salesOrderRepo.GetLines(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		for _, line := range *lines {
+			_, err = m.wardrobeUc.AddStock(ctx, &line.WardrobeID, &request.WardrobeAddSubRequest{
+				Amount:     line.Quantity,
+				Reason:     fmt.Sprintf("sales order %s cancelled", order.ID),
+				LocationID: &order.LocationID,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		now := time.Now()
+		order.Status = model.SalesOrderCancelled
+		order.CancelledAt = &now
+		err = m.salesOrderRepo.Update(ctx, order)
+		if err != nil {
+			return nil, err
+		}
+
+		return m.detail(ctx, id)
+	}, nil)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[SalesOrderUseCases.CancelSalesOrder] Failed to cancel sales order")
+		return nil, err
+	}
+
+	return res.(*response.SalesOrderResponse), nil
+}
+
+// priceLines locks the wardrobes of the lines and prices the lines with them, adding them to the total of the order.
+// The wardrobes are locked in the same order by every sales order so two of them can not deadlock.
+func (m *Module) priceLines(ctx context.Context, order *model.SalesOrder, lineReqs []request.SalesOrderLineRequest) ([]model.SalesOrderLine, error) {
+	lineReqs = slices.Clone(lineReqs)
+	slices.SortFunc(lineReqs, func(a, b request.SalesOrderLineRequest) int {
+		return bytes.Compare(a.WardrobeID[:], b.WardrobeID[:])
+	})
+
+	var lines []model.SalesOrderLine
+	for _, lineReq := range lineReqs {
+		err := m.wardrobeRepo.LockById(ctx, lineReq.WardrobeID)
+		if err != nil {
+			return nil, err
+		}
+		wardrobe, err := m.wardrobeRepo.GetById(ctx, lineReq.WardrobeID)
+		if err != nil {
+			return nil, err
+		}
+
+		if order.Currency == constants.EmptyString {
+			order.Currency = wardrobe.Currency
+		}
+		if wardrobe.Currency != order.Currency {
+			return nil, &custerr.ErrChain{
+				Message: errorcode.SalesOrderCurrencyMismatch.Message,
+				Code:    errorcode.SalesOrderCurrencyMismatch.Code,
+				Type:    libResponse.ErrBadRequest,
+			}
+		}
+
+		lines = append(lines, model.SalesOrderLine{
+			ID:           uuid.New(),
+			SalesOrderID: order.ID,
+			WardrobeID:   wardrobe.ID,
+			Quantity:     lineReq.Quantity,
+			UnitPrice:    wardrobe.Price,
+			CreatedAt:    order.CreatedAt,
+		})
+		order.Total += wardrobe.Price.Mul(lineReq.Quantity)
+	}
+
+	return lines, nil
+}
+
+// takeStock subtracts the quantity of every line from its wardrobe at the location of the order. Every line is tried
+// so the client learns all the short wardrobes at once, the caller rolls back when one of them is.
+func (m *Module) takeStock(ctx context.Context, order *model.SalesOrder, lines []model.SalesOrderLine) error {
+	var short []string
+	for _, line := range lines {
+		_, err := m.wardrobeUc.SubStock(ctx, &line.WardrobeID, &request.WardrobeAddSubRequest{
+			Amount:     line.Quantity,
+			Reason:     fmt.Sprintf("sales order %s placed", order.ID),
+			LocationID: &order.LocationID,
+		})
+		if isStockShort(err) {
+			short = append(short, line.WardrobeID.String())
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(short) > 0 {
+		return &custerr.ErrChain{
+			Message: fmt.Sprintf("%s: %s", errorcode.SalesOrderStockInsufficient.Message, strings.Join(short, ", ")),
+			Code:    errorcode.SalesOrderStockInsufficient.Code,
+			Type:    libResponse.ErrBadRequest,
+		}
+	}
+	return nil
+}
+
+func (m *Module) lockPlaced(ctx context.Context, id *uuid.UUID) (*model.SalesOrder, error) {
+	order, err := m.salesOrderRepo.LockById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != model.SalesOrderPlaced {
+		return nil, &custerr.ErrChain{
+			Message: errorcode.SalesOrderNotPlaced.Message,
+			Code:    errorcode.SalesOrderNotPlaced.Code,
+			Type:    libResponse.ErrConflict,
+		}
+	}
+	return order, nil
+}
+
+// stockLocation is the location of the request, else the default location
+func (m *Module) stockLocation(ctx context.Context, locationId *uuid.UUID) (*model.Location, error) {
+	if locationId == nil {
+		return m.locationRepo.GetDefault(ctx)
+	}
+	return m.locationRepo.GetById(ctx, locationId)
+}
+
+func (m *Module) detail(ctx context.Context, id *uuid.UUID) (*response.SalesOrderResponse, error) {
+	order, err := m.salesOrderRepo.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	lines, err := m.salesOrderRepo.GetLines(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	res := toSalesOrderResponse(order)
+	for _, line := range *lines {
+		res.Lines = append(res.Lines, response.SalesOrderLineResponse{
+			ID:         line.ID.String(),
+			WardrobeID: line.WardrobeID.String(),
+			Quantity:   line.Quantity,
+			UnitPrice:  line.UnitPrice,
+			Total:      line.UnitPrice.Mul(line.Quantity),
+		})
+	}
+
+	return res, nil
+}
+
+// isStockShort tells whether SubStock failed because the wardrobe or its stock at the location is short
+func isStockShort(err error) bool {
+	var e *custerr.ErrChain
+	if !errors.As(err, &e) {
+		return false
+	}
+	return e.Code == errorcode.StockInsufficient.Code || e.Code == errorcode.LocationStockInsufficient.Code
+}
+
+func toSalesOrderResponse(order *model.SalesOrder) *response.SalesOrderResponse {
+	return &response.SalesOrderResponse{
+		ID:          order.ID.String(),
+		Customer:    order.Customer,
+		Currency:    order.Currency,
+		Total:       order.Total,
+		LocationID:  order.LocationID.String(),
+		Status:      order.Status,
+		Note:        order.Note,
+		CreatedBy:   order.CreatedBy,
+		FulfilledAt: order.FulfilledAt,
+		CancelledAt: order.CancelledAt,
+		CreatedAt:   order.CreatedAt,
+		UpdatedAt:   order.UpdatedAt,
+	}
+}
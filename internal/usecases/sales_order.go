@@ -0,0 +1,17 @@
+package usecases
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/response/rest"
+)
+
+type SalesOrderUseCases interface {
+	GetSalesOrders(ctx context.Context, filter *request.SalesOrderFilterRequest, page *request.PageRequest) (*[]response.SalesOrderResponse, *rest.Pagination, error)
+	GetSalesOrder(ctx context.Context, id *uuid.UUID) (*response.SalesOrderResponse, error)
+	PlaceSalesOrder(ctx context.Context, request *request.SalesOrderRequest) (*response.SalesOrderResponse, error)
+	FulfillSalesOrder(ctx context.Context, id *uuid.UUID) (*response.SalesOrderResponse, error)
+	CancelSalesOrder(ctx context.Context, id *uuid.UUID) (*response.SalesOrderResponse, error)
+}
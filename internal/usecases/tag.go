@@ -0,0 +1,17 @@
+package usecases
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+)
+
+type TagUseCases interface {
+	GetAllTag(ctx context.Context) (*[]response.TagResponse, error)
+	InsertTag(ctx context.Context, request *request.TagRequest) (*response.TagResponse, error)
+	DeleteTag(ctx context.Context, id *uuid.UUID) error
+	GetWardrobeTags(ctx context.Context, wardrobeId *uuid.UUID) (*[]response.TagResponse, error)
+	AddWardrobeTags(ctx context.Context, wardrobeId *uuid.UUID, request *request.WardrobeTagsRequest) (*[]response.TagResponse, error)
+	RemoveWardrobeTag(ctx context.Context, wardrobeId, tagId *uuid.UUID) error
+}
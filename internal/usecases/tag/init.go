@@ -0,0 +1,27 @@
+package tag
+
+import (
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/internal/usecases"
+	"sagara_backend_test/lib/txmanager"
+)
+
+type Module struct {
+	tagRepo      repository.TagRepository
+	wardrobeRepo repository.WardrobeRepository
+	txMgr        txmanager.TxManager
+}
+
+type Opts struct {
+	TagRepo      repository.TagRepository
+	WardrobeRepo repository.WardrobeRepository
+	TxMgr        txmanager.TxManager
+}
+
+func New(opts *Opts) usecases.TagUseCases {
+	return &Module{
+		tagRepo:      opts.TagRepo,
+		wardrobeRepo: opts.WardrobeRepo,
+		txMgr:        opts.TxMgr,
+	}
+}
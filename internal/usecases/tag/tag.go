@@ -0,0 +1,157 @@
+package tag
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"time"
+)
+
+func (m *Module) GetAllTag(ctx context.Context) (*[]response.TagResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "TagUseCases.GetAllTag")
+	defer span.End()
+
+	tags, err := m.tagRepo.GetAll(ctx)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[TagUseCases.GetAllTag] Failed to get all tag")
+		return nil, err
+	}
+
+	return toTagResponses(tags), nil
+}
+
+// InsertTag creates the tag, the existing one is returned when the name is already taken
+func (m *Module) InsertTag(ctx context.Context, request *request.TagRequest) (*response.TagResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "TagUseCases.InsertTag")
+	defer span.End()
+
+	tag, err := m.tagRepo.Upsert(ctx, newTag(request.Name))
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":   err,
+			"request": request,
+		}).ErrorWithCtx(ctx, "[TagUseCases.InsertTag] Failed to insert tag")
+		return nil, err
+	}
+
+	return toTagResponse(tag), nil
+}
+
+func (m *Module) DeleteTag(ctx context.Context, id *uuid.UUID) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "TagUseCases.DeleteTag")
+	defer span.End()
+
+	err := m.tagRepo.Delete(ctx, id)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[TagUseCases.DeleteTag] Failed to delete tag")
+		return err
+	}
+
+	return nil
+}
+
+func (m *Module) GetWardrobeTags(ctx context.Context, wardrobeId *uuid.UUID) (*[]response.TagResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "TagUseCases.GetWardrobeTags")
+	defer span.End()
+
+	tags, err := m.tagRepo.GetByWardrobeId(ctx, wardrobeId)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"wardrobe_id": wardrobeId,
+		}).ErrorWithCtx(ctx, "[TagUseCases.GetWardrobeTags] Failed to get wardrobe tags")
+		return nil, err
+	}
+
+	return toTagResponses(tags), nil
+}
+
+// AddWardrobeTags attaches the tags to the wardrobe, tags that do not exist yet are created on the way
+func (m *Module) AddWardrobeTags(ctx context.Context, wardrobeId *uuid.UUID, request *request.WardrobeTagsRequest) (*[]response.TagResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "TagUseCases.AddWardrobeTags")
+	defer span.End()
+
+	res, err := m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		_, err := m.wardrobeRepo.GetById(ctx, wardrobeId)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range request.Tags {
+			tag, err := m.tagRepo.Upsert(ctx, newTag(name))
+			if err != nil {
+				return nil, err
+			}
+
+			err = m.tagRepo.Assign(ctx, wardrobeId, &tag.ID)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return m.tagRepo.GetByWardrobeId(ctx, wardrobeId)
+	}, nil)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"wardrobe_id": wardrobeId,
+			"request":     request,
+		}).ErrorWithCtx(ctx, "[TagUseCases.AddWardrobeTags] Failed to add wardrobe tags")
+		return nil, err
+	}
+
+	return toTagResponses(res.(*[]model.Tag)), nil
+}
+
+func (m *Module) RemoveWardrobeTag(ctx context.Context, wardrobeId, tagId *uuid.UUID) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "TagUseCases.RemoveWardrobeTag")
+	defer span.End()
+
+	err := m.tagRepo.Unassign(ctx, wardrobeId, tagId)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"wardrobe_id": wardrobeId,
+			"tag_id":      tagId,
+		}).ErrorWithCtx(ctx, "[TagUseCases.RemoveWardrobeTag] Failed to remove wardrobe tag")
+		return err
+	}
+
+	return nil
+}
+
+func newTag(name string) *model.Tag {
+	now := time.Now()
+	return &model.Tag{
+		BaseModel: model.BaseModel{
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		ID:   uuid.New(),
+		Name: model.NormalizeTag(name),
+	}
+}
+
+func toTagResponses(tags *[]model.Tag) *[]response.TagResponse {
+	tagResponses := []response.TagResponse{}
+	for _, tag := range *tags {
+		tagResponses = append(tagResponses, *toTagResponse(&tag))
+	}
+	return &tagResponses
+}
+
+func toTagResponse(tag *model.Tag) *response.TagResponse {
+	return &response.TagResponse{
+		ID:   tag.ID.String(),
+		Name: tag.Name,
+	}
+}
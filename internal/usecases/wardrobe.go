@@ -5,18 +5,39 @@ import (
 	"github.com/google/uuid"
 	"sagara_backend_test/internal/usecases/request"
 	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/response/rest"
 )
 
 type WardrobeUseCases interface {
-	GetAllWardrobe(ctx context.Context) (*[]response.WardrobeResponse, error)
+	GetAllWardrobe(ctx context.Context, page *request.PageRequest) (*[]response.WardrobeResponse, *rest.Pagination, error)
 	GetWardrobe(ctx context.Context, id *uuid.UUID) (*response.WardrobeResponse, error)
 	InsertWardrobe(ctx context.Context, request *request.WardrobeInsertRequest) (*response.WardrobeResponse, error)
-	UpdateWardrobe(ctx context.Context, id *uuid.UUID, request *request.WardrobeUpdateRequest) (*response.WardrobeResponse, error)
+	UpdateWardrobe(ctx context.Context, id *uuid.UUID, version int, request *request.WardrobeUpdateRequest) (*response.WardrobeResponse, error)
 	DeleteWardrobe(ctx context.Context, id *uuid.UUID) error
-	Search(ctx context.Context, color, size string) (*[]response.WardrobeResponse, error)
-	AddStock(ctx context.Context, id *uuid.UUID, add int) (*response.WardrobeResponse, error)
-	SubStock(ctx context.Context, id *uuid.UUID, def int) (*response.WardrobeResponse, error)
-	GetAvailable(ctx context.Context) (*[]response.WardrobeResponse, error)
-	GetUnavailable(ctx context.Context) (*[]response.WardrobeResponse, error)
-	GetLessThan(ctx context.Context, amount int) (*[]response.WardrobeResponse, error)
+	Batch(ctx context.Context, request *request.WardrobeBatchRequest) (*response.WardrobeBatchResponse, error)
+	GetTrash(ctx context.Context, page *request.PageRequest) (*[]response.WardrobeResponse, *rest.Pagination, error)
+	RestoreWardrobe(ctx context.Context, id *uuid.UUID) (*response.WardrobeResponse, error)
+	PurgeTrash(ctx context.Context) (*response.PurgeResponse, error)
+	Search(ctx context.Context, request *request.WardrobeSearchRequest, page *request.PageRequest) (*[]response.WardrobeResponse, *rest.Pagination, error)
+	Suggest(ctx context.Context, query string) (*[]response.WardrobeSuggestionResponse, error)
+	Export(ctx context.Context, request *request.WardrobeExportRequest) (*response.ExportResponse, error)
+	GetByProduct(ctx context.Context, productId *uuid.UUID) (*[]response.WardrobeResponse, error)
+	SetCategory(ctx context.Context, id *uuid.UUID, request *request.WardrobeCategoryRequest) (*response.WardrobeResponse, error)
+	AddStock(ctx context.Context, id *uuid.UUID, request *request.WardrobeAddSubRequest) (*response.WardrobeResponse, error)
+	SubStock(ctx context.Context, id *uuid.UUID, request *request.WardrobeAddSubRequest) (*response.WardrobeResponse, error)
+	GetStockMovements(ctx context.Context, id *uuid.UUID) (*[]response.StockMovementResponse, error)
+	GetStockLevels(ctx context.Context, id *uuid.UUID) (*response.StockLevelResponse, error)
+	TransferStock(ctx context.Context, id *uuid.UUID, request *request.StockTransferRequest) (*response.StockTransferResponse, error)
+	GetTransfers(ctx context.Context, filter *request.StockTransferFilterRequest, page *request.PageRequest) (*[]response.StockTransferResponse, *rest.Pagination, error)
+	GetTransfer(ctx context.Context, id *uuid.UUID) (*response.StockTransferResponse, error)
+	ReceiveTransfer(ctx context.Context, id *uuid.UUID) (*response.StockTransferResponse, error)
+	CancelTransfer(ctx context.Context, id *uuid.UUID) (*response.StockTransferResponse, error)
+	GetPriceHistory(ctx context.Context, id *uuid.UUID) (*[]response.PriceChangeResponse, error)
+	GetPriceSchedules(ctx context.Context, id *uuid.UUID) (*[]response.PriceScheduleResponse, error)
+	SchedulePrice(ctx context.Context, id *uuid.UUID, request *request.PriceScheduleRequest) (*response.PriceScheduleResponse, error)
+	CancelPriceSchedule(ctx context.Context, id, scheduleId *uuid.UUID) error
+	ApplyScheduledPrices(ctx context.Context) (int, error)
+	GetAvailable(ctx context.Context, page *request.PageRequest) (*[]response.WardrobeResponse, *rest.Pagination, error)
+	GetUnavailable(ctx context.Context, page *request.PageRequest) (*[]response.WardrobeResponse, *rest.Pagination, error)
+	GetLessThan(ctx context.Context, amount int, page *request.PageRequest) (*[]response.WardrobeResponse, *rest.Pagination, error)
 }
@@ -0,0 +1,59 @@
+package wardrobe
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/interfaces/dao"
+	"time"
+)
+
+// evaluateStockAlert raises an alert when the available stock, what the active reservations do not hold, is under the
+// threshold of the wardrobe rule, or the default threshold without any, and resolves the alert once the stock is back,
+// so a wardrobe gets one alert per crossing. It runs in the transaction of the stock change,
+// the alert is notified later by the alert use case.
+func (m *Module) evaluateStockAlert(ctx context.Context, wardrobe *model.Wardrobe) error {
+	rule, err := m.alertRuleRepo.GetForWardrobe(ctx, wardrobe)
+	if err != nil && !errors.Is(err, dao.ErrNoResult) {
+		return err
+	}
+
+	alert, err := m.alertRepo.GetUnresolved(ctx, &wardrobe.ID)
+	if err != nil && !errors.Is(err, dao.ErrNoResult) {
+		return err
+	}
+
+	threshold := m.lowStockThreshold
+	var ruleID *uuid.UUID
+	if rule != nil {
+		threshold = rule.Threshold
+		ruleID = &rule.ID
+	}
+
+	available := wardrobe.Available()
+	low := available < threshold
+	now := time.Now()
+
+	switch {
+	case low && alert == nil:
+		return m.alertRepo.Insert(ctx, &model.StockAlert{
+			BaseModel: model.BaseModel{
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+			ID:         uuid.New(),
+			WardrobeID: wardrobe.ID,
+			RuleID:     ruleID,
+			Threshold:  threshold,
+			Stock:      available,
+			Status:     model.StockAlertOpen,
+		})
+	case !low && alert != nil:
+		alert.Status = model.StockAlertResolved
+		alert.ResolvedAt = &now
+		return m.alertRepo.Update(ctx, alert)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package wardrobe
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/infrastructures/actor"
+	"sagara_backend_test/lib/tracing"
+	"time"
+)
+
+// recordAudit writes the audit log of a change to the wardrobe, it must run in the transaction of the change.
+// before is nil for a creation and after is nil for a deletion, the diff then holds the whole wardrobe.
+func (m *Module) recordAudit(ctx context.Context, action string, id uuid.UUID, before, after *model.Wardrobe) error {
+	diff, err := model.NewAuditDiff(toAuditSnapshot(before), toAuditSnapshot(after))
+	if err != nil {
+		return err
+	}
+
+	return m.auditRepo.Insert(ctx, &model.AuditLog{
+		ID:        uuid.New(),
+		Entity:    model.AuditEntityWardrobe,
+		EntityID:  id,
+		Action:    action,
+		Actor:     actor.FromContext(ctx),
+		RequestID: tracing.RequestIDFromContext(ctx),
+		Diff:      diff,
+		CreatedAt: time.Now(),
+	})
+}
+
+// auditSnapshot is what the audit diff compares, the stored fields of a wardrobe without the computed stock
+type auditSnapshot struct {
+	ProductID  *uuid.UUID  `json:"product_id"`
+	SKU        *string     `json:"sku"`
+	CategoryID *uuid.UUID  `json:"category_id"`
+	Name       string      `json:"name"`
+	Color      string      `json:"color"`
+	Size       string      `json:"size"`
+	Price      model.Money `json:"price"`
+	Currency   string      `json:"currency"`
+	Stock      int         `json:"stock"`
+	Version    int         `json:"version"`
+	DeletedAt  *time.Time  `json:"deleted_at"`
+}
+
+func toAuditSnapshot(wardrobe *model.Wardrobe) *auditSnapshot {
+	if wardrobe == nil {
+		return nil
+	}
+
+	return &auditSnapshot{
+		ProductID:  wardrobe.ProductID,
+		SKU:        wardrobe.SKU,
+		CategoryID: wardrobe.CategoryID,
+		Name:       wardrobe.Name,
+		Color:      wardrobe.Color,
+		Size:       wardrobe.Size,
+		Price:      wardrobe.Price,
+		Currency:   wardrobe.Currency,
+		Stock:      wardrobe.Stock,
+		Version:    wardrobe.Version,
+		DeletedAt:  wardrobe.DeletedAt,
+	}
+}
+
+// beforeStockChange rebuilds the wardrobe as it was before the stock adjustment that returned it
+func beforeStockChange(wardrobe *model.Wardrobe, delta int) *model.Wardrobe {
+	before := *wardrobe
+	before.Stock -= delta
+	before.Version--
+	return &before
+}
@@ -0,0 +1,150 @@
+package wardrobe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sagara_backend_test/internal/infrastructures/access"
+	"sagara_backend_test/internal/infrastructures/custresp"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/log"
+	libResponse "sagara_backend_test/lib/response"
+	"sagara_backend_test/lib/tracing"
+	txSql "sagara_backend_test/lib/txmanager/sql"
+	"sagara_backend_test/pkg/constants"
+	"sagara_backend_test/pkg/constants/errorcode"
+)
+
+// batchSavepoint lets a failed operation be undone without aborting the rest of the batch
+const batchSavepoint = "batch_operation"
+
+var errBatchRolledBack = errors.New("an operation of the atomic batch failed")
+
+// Batch runs the operations in order inside one transaction. Every operation is tried so all of their errors
+// are reported, then an atomic batch with a failure is rolled back while a best effort one keeps what succeeded.
+func (m *Module) Batch(ctx context.Context, batchReq *request.WardrobeBatchRequest) (*response.WardrobeBatchResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.Batch")
+	defer span.End()
+
+	mode := batchReq.Mode
+	if mode == constants.EmptyString {
+		mode = request.BatchModeAtomic
+	}
+
+	res := &response.WardrobeBatchResponse{
+		Mode:    mode,
+		Results: make([]response.BatchOperationResponse, 0, len(batchReq.Operations)),
+	}
+
+	_, err := m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		for i, operation := range batchReq.Operations {
+			result := response.BatchOperationResponse{
+				Index: i,
+				Op:    operation.Op,
+			}
+
+			wardrobe, err := txSql.Savepoint(ctx, batchSavepoint, func(ctx context.Context) (any, error) {
+				return m.runBatchOperation(ctx, &operation)
+			})
+			if err != nil {
+				result.Status = response.BatchFailed
+				result.Error = toBatchError(err)
+				res.Failed++
+			} else {
+				result.Status = response.BatchSucceeded
+				result.Wardrobe, _ = wardrobe.(*response.WardrobeResponse)
+				res.Succeeded++
+			}
+
+			res.Results = append(res.Results, result)
+		}
+
+		if mode == request.BatchModeAtomic && res.Failed > 0 {
+			return nil, errBatchRolledBack
+		}
+		return nil, nil
+	}, nil)
+	if errors.Is(err, errBatchRolledBack) {
+		for i := range res.Results {
+			if res.Results[i].Status == response.BatchSucceeded {
+				res.Results[i].Status = response.BatchRolledBack
+				res.Results[i].Wardrobe = nil
+			}
+		}
+		res.Succeeded = 0
+		return res, nil
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"mode":  mode,
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.Batch] Failed to run batch")
+		return nil, err
+	}
+
+	res.Committed = true
+	return res, nil
+}
+
+// runBatchOperation goes through the same use case as the single endpoint of the operation
+func (m *Module) runBatchOperation(ctx context.Context, operation *request.WardrobeBatchOperation) (*response.WardrobeResponse, error) {
+	err := operation.ValidateOperation()
+	if err != nil {
+		return nil, err
+	}
+
+	// the batch route is open to every role, each operation needs the permission of its own route
+	if !access.Can(ctx, batchPermission(operation.Op)) {
+		return nil, &custerr.ErrChain{
+			Message: errorcode.PermissionMissing.Message,
+			Code:    errorcode.PermissionMissing.Code,
+			Type:    libResponse.ErrForbiddenResource,
+		}
+	}
+	// the batch route only asks for the write scope, moving stock also needs the scope of the stock routes
+	if operation.Op == request.BatchOpAdjustStock && !access.HasScope(ctx, constants.ScopeStockAdjust) {
+		return nil, &custerr.ErrChain{
+			Message: errorcode.ScopeMissing.Message,
+			Code:    errorcode.ScopeMissing.Code,
+			Type:    libResponse.ErrForbiddenResource,
+		}
+	}
+
+	switch operation.Op {
+	case request.BatchOpCreate:
+		return m.InsertWardrobe(ctx, operation.Create)
+	case request.BatchOpUpdate:
+		return m.UpdateWardrobe(ctx, operation.ID, operation.Version, operation.Update)
+	case request.BatchOpDelete:
+		return nil, m.DeleteWardrobe(ctx, operation.ID)
+	default:
+		if operation.Delta > 0 {
+			return m.AddStock(ctx, operation.ID, &request.WardrobeAddSubRequest{Amount: operation.Delta, Reason: operation.Reason, LocationID: operation.LocationID})
+		}
+		return m.SubStock(ctx, operation.ID, &request.WardrobeAddSubRequest{Amount: -operation.Delta, Reason: operation.Reason, LocationID: operation.LocationID})
+	}
+}
+
+func batchPermission(op string) string {
+	switch op {
+	case request.BatchOpCreate:
+		return constants.PermissionWardrobeCreate
+	case request.BatchOpUpdate:
+		return constants.PermissionWardrobeUpdate
+	case request.BatchOpDelete:
+		return constants.PermissionWardrobeDelete
+	default:
+		return constants.PermissionStockAdjust
+	}
+}
+
+func toBatchError(err error) *response.BatchError {
+	errResp, _ := custresp.CustomErrorResponse(err)
+	return &response.BatchError{
+		Status:  errResp.Code,
+		Code:    errResp.Error.ErrorCode,
+		Message: fmt.Sprint(errResp.Error.ErrorMessage),
+	}
+}
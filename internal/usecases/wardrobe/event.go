@@ -0,0 +1,70 @@
+package wardrobe
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/infrastructures/actor"
+	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/tracing"
+	"time"
+)
+
+// auditEvents is the domain event published for every audited action
+var auditEvents = map[string]string{
+	model.AuditActionCreate:      model.EventWardrobeCreated,
+	model.AuditActionUpdate:      model.EventWardrobeUpdated,
+	model.AuditActionDelete:      model.EventWardrobeDeleted,
+	model.AuditActionRestore:     model.EventWardrobeRestored,
+	model.AuditActionStockChange: model.EventWardrobeStockChanged,
+}
+
+// recordChange writes the audit log and queues the domain event of a change to the wardrobe, it must run in the
+// transaction of the change so that neither is kept when the change is rolled back
+func (m *Module) recordChange(ctx context.Context, action string, id uuid.UUID, before, after *model.Wardrobe) error {
+	err := m.recordAudit(ctx, action, id, before, after)
+	if err != nil {
+		return err
+	}
+
+	return m.recordEvent(ctx, auditEvents[action], id, before, after)
+}
+
+// wardrobeEvent is the data of a wardrobe event, Wardrobe is the wardrobe as it was deleted for a deletion
+type wardrobeEvent struct {
+	Wardrobe *response.WardrobeResponse `json:"wardrobe"`
+	Previous *response.WardrobeResponse `json:"previous,omitempty"`
+}
+
+func (m *Module) recordEvent(ctx context.Context, eventType string, id uuid.UUID, before, after *model.Wardrobe) error {
+	data := wardrobeEvent{}
+	if after != nil {
+		data.Wardrobe = toWardrobeResponse(after)
+		if before != nil {
+			data.Previous = toWardrobeResponse(before)
+		}
+	} else {
+		data.Wardrobe = toWardrobeResponse(before)
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	return m.outboxRepo.Insert(ctx, &model.OutboxEvent{
+		ID:            uuid.New(),
+		AggregateType: model.OutboxAggregateWardrobe,
+		AggregateID:   id,
+		EventType:     eventType,
+		Payload:       payload,
+		Actor:         actor.FromContext(ctx),
+		RequestID:     tracing.RequestIDFromContext(ctx),
+		Status:        model.OutboxPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	})
+}
@@ -0,0 +1,139 @@
+package wardrobe
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/infrastructures/spreadsheet"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"strconv"
+	"time"
+)
+
+// exportColumns starts with the columns of the import so an exported file can be edited and imported back
+var exportColumns = []string{"sku", "name", "color", "size", "price", "currency", "stock", "product_id", "id", "category_id", "reserved", "available"}
+
+// Export streams the wardrobes matching the search by name, the file is written while the client reads it
+func (m *Module) Export(ctx context.Context, exportReq *request.WardrobeExportRequest) (*response.ExportResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.Export")
+	defer span.End()
+
+	filter := toWardrobeFilter(&exportReq.Search)
+	reader, writer := io.Pipe()
+
+	// the file is only read once the request handler returned, the stream must not end with its context.
+	// It gets a deadline of its own instead, closing the reader unblocks a write nobody is reading anymore.
+	streamCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), m.exportTimeout)
+	stopClose := context.AfterFunc(streamCtx, func() {
+		reader.CloseWithError(streamCtx.Err())
+	})
+	go func() {
+		defer cancel()
+		defer stopClose()
+
+		err := m.writeExport(streamCtx, exportReq.Format, filter, writer)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error":  err,
+				"format": exportReq.Format,
+			}).ErrorWithCtx(streamCtx, "[WardrobeUseCases.Export] Failed to export wardrobe")
+		}
+		writer.CloseWithError(err)
+	}()
+
+	contentType := spreadsheet.ContentType(exportReq.Format)
+	if exportReq.Format == request.ExportFormatJSON {
+		contentType = "application/json"
+	}
+
+	return &response.ExportResponse{
+		FileName:    fmt.Sprintf("wardrobe-%s.%s", time.Now().Format("20060102-150405"), exportReq.Format),
+		ContentType: contentType,
+		File:        reader,
+	}, nil
+}
+
+func (m *Module) writeExport(ctx context.Context, format string, filter *model.WardrobeFilter, w io.Writer) error {
+	if format == request.ExportFormatJSON {
+		return m.writeJSONExport(ctx, filter, w)
+	}
+
+	writer, err := spreadsheet.NewWriter(format, w)
+	if err != nil {
+		return err
+	}
+
+	err = writer.WriteRow(exportColumns)
+	if err == nil {
+		err = m.wardrobeRepo.Stream(ctx, filter, func(wardrobe *model.Wardrobe) error {
+			return writer.WriteRow(toExportRow(wardrobe))
+		})
+	}
+
+	// a workbook holds a temporary file until it is closed, even when the export failed
+	closeErr := writer.Close()
+	if err != nil {
+		return err
+	}
+	return closeErr
+}
+
+// writeJSONExport writes the wardrobes as a json array of the same objects the api returns
+func (m *Module) writeJSONExport(ctx context.Context, filter *model.WardrobeFilter, w io.Writer) error {
+	buffer := bufio.NewWriter(w)
+
+	_, err := buffer.WriteString("[")
+	if err != nil {
+		return err
+	}
+
+	separator := ""
+	err = m.wardrobeRepo.Stream(ctx, filter, func(wardrobe *model.Wardrobe) error {
+		data, err := json.Marshal(toWardrobeResponse(wardrobe))
+		if err != nil {
+			return err
+		}
+
+		_, err = buffer.WriteString(separator)
+		if err != nil {
+			return err
+		}
+		separator = ","
+
+		_, err = buffer.Write(data)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = buffer.WriteString("]")
+	if err != nil {
+		return err
+	}
+	return buffer.Flush()
+}
+
+func toExportRow(wardrobe *model.Wardrobe) []string {
+	res := toWardrobeResponse(wardrobe)
+	return []string{
+		res.SKU,
+		res.Name,
+		res.Color,
+		res.Size,
+		res.Price.String(),
+		res.Currency,
+		strconv.Itoa(res.Stock),
+		res.ProductID,
+		res.ID,
+		res.CategoryID,
+		strconv.Itoa(res.Reserved),
+		strconv.Itoa(res.Available),
+	}
+}
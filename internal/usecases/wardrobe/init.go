@@ -4,21 +4,69 @@ import (
 	"sagara_backend_test/internal/domain/repository"
 	"sagara_backend_test/internal/usecases"
 	"sagara_backend_test/lib/txmanager"
+	"time"
 )
 
 type Module struct {
-	wardrobeRepo repository.WardrobeRepository
-	txMgr        txmanager.TxManager
+	wardrobeRepo      repository.WardrobeRepository
+	productRepo       repository.ProductRepository
+	categoryRepo      repository.CategoryRepository
+	stockMovementRepo repository.StockMovementRepository
+	priceChangeRepo   repository.PriceChangeRepository
+	priceScheduleRepo repository.PriceScheduleRepository
+	auditRepo         repository.AuditRepository
+	outboxRepo        repository.OutboxRepository
+	alertRuleRepo     repository.StockAlertRuleRepository
+	alertRepo         repository.StockAlertRepository
+	locationRepo      repository.LocationRepository
+	locationStockRepo repository.LocationStockRepository
+	stockTransferRepo repository.StockTransferRepository
+	txMgr             txmanager.TxManager
+	trashRetention    time.Duration
+	lowStockThreshold int
+	exportTimeout     time.Duration
 }
 
 type Opts struct {
-	WardrobeRepo repository.WardrobeRepository
-	TxMgr        txmanager.TxManager
+	WardrobeRepo      repository.WardrobeRepository
+	ProductRepo       repository.ProductRepository
+	CategoryRepo      repository.CategoryRepository
+	StockMovementRepo repository.StockMovementRepository
+	PriceChangeRepo   repository.PriceChangeRepository
+	PriceScheduleRepo repository.PriceScheduleRepository
+	AuditRepo         repository.AuditRepository
+	OutboxRepo        repository.OutboxRepository
+	AlertRuleRepo     repository.StockAlertRuleRepository
+	AlertRepo         repository.StockAlertRepository
+	LocationRepo      repository.LocationRepository
+	LocationStockRepo repository.LocationStockRepository
+	StockTransferRepo repository.StockTransferRepository
+	TxMgr             txmanager.TxManager
+	TrashRetention    time.Duration
+	// LowStockThreshold is the amount GetLessThan compares to when it is given none
+	LowStockThreshold int
+	// ExportTimeout is how long an export may stream, a download nobody reads is given up after it
+	ExportTimeout time.Duration
 }
 
 func New(opts *Opts) usecases.WardrobeUseCases {
 	return &Module{
-		wardrobeRepo: opts.WardrobeRepo,
-		txMgr:        opts.TxMgr,
+		wardrobeRepo:      opts.WardrobeRepo,
+		productRepo:       opts.ProductRepo,
+		categoryRepo:      opts.CategoryRepo,
+		stockMovementRepo: opts.StockMovementRepo,
+		priceChangeRepo:   opts.PriceChangeRepo,
+		priceScheduleRepo: opts.PriceScheduleRepo,
+		auditRepo:         opts.AuditRepo,
+		outboxRepo:        opts.OutboxRepo,
+		alertRuleRepo:     opts.AlertRuleRepo,
+		alertRepo:         opts.AlertRepo,
+		locationRepo:      opts.LocationRepo,
+		locationStockRepo: opts.LocationStockRepo,
+		stockTransferRepo: opts.StockTransferRepo,
+		txMgr:             opts.TxMgr,
+		trashRetention:    opts.TrashRetention,
+		lowStockThreshold: opts.LowStockThreshold,
+		exportTimeout:     opts.ExportTimeout,
 	}
 }
@@ -0,0 +1,404 @@
+package wardrobe
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/infrastructures/actor"
+	"sagara_backend_test/internal/interfaces/dao"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/log"
+	libResponse "sagara_backend_test/lib/response"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/pkg/constants"
+	"sagara_backend_test/pkg/constants/errorcode"
+	"time"
+)
+
+func (m *Module) GetPriceHistory(ctx context.Context, id *uuid.UUID) (*[]response.PriceChangeResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.GetPriceHistory")
+	defer span.End()
+
+	_, err := m.wardrobeRepo.GetById(ctx, id)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.GetPriceHistory] Failed to get wardrobe by ID")
+		return nil, err
+	}
+
+	changes, err := m.priceChangeRepo.GetByWardrobeId(ctx, id)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.GetPriceHistory] Failed to get price changes")
+		return nil, err
+	}
+
+	changeResponses := []response.PriceChangeResponse{}
+	for _, change := range *changes {
+		changeResponse := response.PriceChangeResponse{
+			ID:          change.ID.String(),
+			WardrobeID:  change.WardrobeID.String(),
+			OldPrice:    change.OldPrice,
+			OldCurrency: change.OldCurrency,
+			NewPrice:    change.NewPrice,
+			NewCurrency: change.NewCurrency,
+			ChangedBy:   change.ChangedBy,
+			Reason:      change.Reason,
+			CreatedAt:   change.CreatedAt,
+		}
+		if change.ScheduleID != nil {
+			changeResponse.ScheduleID = change.ScheduleID.String()
+		}
+		changeResponses = append(changeResponses, changeResponse)
+	}
+
+	return &changeResponses, nil
+}
+
+func (m *Module) GetPriceSchedules(ctx context.Context, id *uuid.UUID) (*[]response.PriceScheduleResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.GetPriceSchedules")
+	defer span.End()
+
+	_, err := m.wardrobeRepo.GetById(ctx, id)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.GetPriceSchedules] Failed to get wardrobe by ID")
+		return nil, err
+	}
+
+	schedules, err := m.priceScheduleRepo.GetByWardrobeId(ctx, id)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.GetPriceSchedules] Failed to get price schedules")
+		return nil, err
+	}
+
+	return toPriceScheduleResponses(schedules), nil
+}
+
+// SchedulePrice plans a price change of the wardrobe, it may not overlap another pending or active schedule
+func (m *Module) SchedulePrice(ctx context.Context, id *uuid.UUID, request *request.PriceScheduleRequest) (*response.PriceScheduleResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.SchedulePrice")
+	defer span.End()
+
+	res, err := m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		// the lock keeps two overlapping schedules from being added side by side
+		err := m.wardrobeRepo.LockById(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		wardrobe, err := m.wardrobeRepo.GetById(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		now := time.Now()
+		newSchedule := &model.PriceSchedule{
+			BaseModel: model.BaseModel{
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+			ID:             uuid.New(),
+			WardrobeID:     wardrobe.ID,
+			Price:          request.Price,
+			Currency:       model.NormalizeCurrency(request.Currency),
+			EffectiveFrom:  request.EffectiveFrom,
+			EffectiveUntil: request.EffectiveUntil,
+			Status:         model.PriceSchedulePending,
+			Reason:         request.Reason,
+			CreatedBy:      actor.FromContext(ctx),
+		}
+		if newSchedule.Currency == constants.EmptyString {
+			newSchedule.Currency = wardrobe.Currency
+		}
+
+		schedules, err := m.priceScheduleRepo.GetByWardrobeId(ctx, id, model.PriceScheduleActive, model.PriceSchedulePending)
+		if err != nil {
+			return nil, err
+		}
+		for _, schedule := range *schedules {
+			if newSchedule.Overlaps(&schedule) {
+				return nil, &custerr.ErrChain{
+					Message: errorcode.ScheduleOverlap.Message,
+					Code:    errorcode.ScheduleOverlap.Code,
+					Type:    libResponse.ErrConflict,
+				}
+			}
+		}
+
+		err = m.priceScheduleRepo.Insert(ctx, newSchedule)
+		if err != nil {
+			return nil, err
+		}
+
+		// the wardrobe response lists its schedules, its ETag has to change too
+		return newSchedule, m.wardrobeRepo.BumpVersion(ctx, id)
+	}, nil)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":   err,
+			"id":      id,
+			"request": request,
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.SchedulePrice] Failed to schedule price")
+		return nil, err
+	}
+
+	return toPriceScheduleResponse(res.(*model.PriceSchedule)), nil
+}
+
+func (m *Module) CancelPriceSchedule(ctx context.Context, id, scheduleId *uuid.UUID) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.CancelPriceSchedule")
+	defer span.End()
+
+	schedule, err := m.priceScheduleRepo.GetById(ctx, scheduleId)
+	if err == nil && schedule.WardrobeID != *id {
+		err = dao.ErrNoResult
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"id":          id,
+			"schedule_id": scheduleId,
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.CancelPriceSchedule] Failed to get price schedule by ID")
+		return err
+	}
+
+	schedule.Status = model.PriceScheduleCancelled
+	_, err = m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		err := m.priceScheduleRepo.UpdateStatus(ctx, schedule, model.PriceSchedulePending)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, m.wardrobeRepo.BumpVersion(ctx, id)
+	}, nil)
+	if errors.Is(err, dao.ErrNoUpdateHappened) {
+		return &custerr.ErrChain{
+			Message: errorcode.ScheduleNotPending.Message,
+			Code:    errorcode.ScheduleNotPending.Code,
+			Type:    libResponse.ErrConflict,
+		}
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"id":          id,
+			"schedule_id": scheduleId,
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.CancelPriceSchedule] Failed to cancel price schedule")
+		return err
+	}
+
+	return nil
+}
+
+// ApplyScheduledPrices restores the prices of the schedules that ended and then applies the schedules that started,
+// a failing schedule is logged and retried on the next run. It returns how many schedules were moved on.
+func (m *Module) ApplyScheduledPrices(ctx context.Context) (int, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.ApplyScheduledPrices")
+	defer span.End()
+
+	now := time.Now()
+
+	ending, err := m.priceScheduleRepo.GetEnding(ctx, now)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.ApplyScheduledPrices] Failed to get ending price schedules")
+		return 0, err
+	}
+
+	starting, err := m.priceScheduleRepo.GetStarting(ctx, now)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.ApplyScheduledPrices] Failed to get starting price schedules")
+		return 0, err
+	}
+
+	applied := 0
+	for _, schedule := range *ending {
+		_, err = m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+			return nil, m.endSchedule(ctx, &schedule)
+		}, nil)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error":       err,
+				"schedule_id": schedule.ID,
+			}).ErrorWithCtx(ctx, "[WardrobeUseCases.ApplyScheduledPrices] Failed to end price schedule")
+			continue
+		}
+		applied++
+	}
+
+	for _, schedule := range *starting {
+		_, err = m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+			return nil, m.startSchedule(ctx, &schedule, now)
+		}, nil)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error":       err,
+				"schedule_id": schedule.ID,
+			}).ErrorWithCtx(ctx, "[WardrobeUseCases.ApplyScheduledPrices] Failed to start price schedule")
+			continue
+		}
+		applied++
+	}
+
+	return applied, nil
+}
+
+// startSchedule puts the scheduled price on the wardrobe and keeps the replaced one,
+// a schedule whose whole window has already passed is ended without touching the price
+func (m *Module) startSchedule(ctx context.Context, schedule *model.PriceSchedule, now time.Time) error {
+	if schedule.EffectiveUntil != nil && !schedule.EffectiveUntil.After(now) {
+		schedule.Status = model.PriceScheduleEnded
+		err := m.priceScheduleRepo.UpdateStatus(ctx, schedule, model.PriceSchedulePending)
+		if err != nil {
+			return err
+		}
+		return m.wardrobeRepo.BumpVersion(ctx, &schedule.WardrobeID)
+	}
+
+	wardrobe, err := m.lockWardrobe(ctx, &schedule.WardrobeID)
+	if err != nil {
+		return err
+	}
+
+	previousPrice, previousCurrency := wardrobe.Price, wardrobe.Currency
+	schedule.PreviousPrice = &previousPrice
+	schedule.PreviousCurrency = &previousCurrency
+	schedule.Status = model.PriceScheduleApplied
+	if schedule.EffectiveUntil != nil {
+		schedule.Status = model.PriceScheduleActive
+	}
+
+	err = m.priceScheduleRepo.UpdateStatus(ctx, schedule, model.PriceSchedulePending)
+	if err != nil {
+		return err
+	}
+
+	reason := schedule.Reason
+	if reason == constants.EmptyString {
+		reason = model.PriceReasonScheduled
+	}
+	return m.setPrice(ctx, wardrobe, schedule.Price, schedule.Currency, schedule, reason)
+}
+
+// endSchedule restores the price the schedule replaced, unless the price was changed again in the meantime
+func (m *Module) endSchedule(ctx context.Context, schedule *model.PriceSchedule) error {
+	wardrobe, err := m.lockWardrobe(ctx, &schedule.WardrobeID)
+	if err != nil {
+		return err
+	}
+
+	schedule.Status = model.PriceScheduleEnded
+	err = m.priceScheduleRepo.UpdateStatus(ctx, schedule, model.PriceScheduleActive)
+	if err != nil {
+		return err
+	}
+
+	if wardrobe.Price != schedule.Price || wardrobe.Currency != schedule.Currency || schedule.PreviousPrice == nil {
+		// the price stays, but the schedule leaves the wardrobe response
+		return m.wardrobeRepo.BumpVersion(ctx, &wardrobe.ID)
+	}
+
+	currency := wardrobe.Currency
+	if schedule.PreviousCurrency != nil {
+		currency = *schedule.PreviousCurrency
+	}
+	return m.setPrice(ctx, wardrobe, *schedule.PreviousPrice, currency, schedule, model.PriceReasonRestored)
+}
+
+func (m *Module) lockWardrobe(ctx context.Context, id *uuid.UUID) (*model.Wardrobe, error) {
+	err := m.wardrobeRepo.LockById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.wardrobeRepo.GetById(ctx, id)
+}
+
+// setPrice saves a price set by a schedule and records it, the wardrobe must be locked
+func (m *Module) setPrice(ctx context.Context, wardrobe *model.Wardrobe, price model.Money, currency string, schedule *model.PriceSchedule, reason string) error {
+	before := *wardrobe
+	oldPrice, oldCurrency := wardrobe.Price, wardrobe.Currency
+	wardrobe.Price = price
+	wardrobe.Currency = currency
+
+	if wardrobe.ProductID != nil {
+		product, err := m.productRepo.GetById(ctx, wardrobe.ProductID)
+		if err != nil {
+			return err
+		}
+		applyProduct(wardrobe, product)
+	}
+
+	err := m.wardrobeRepo.Update(ctx, wardrobe)
+	if err != nil {
+		return err
+	}
+
+	err = m.recordPriceChange(ctx, wardrobe, oldPrice, oldCurrency, schedule.CreatedBy, reason, &schedule.ID)
+	if err != nil {
+		return err
+	}
+
+	return m.recordChange(ctx, model.AuditActionUpdate, wardrobe.ID, &before, wardrobe)
+}
+
+// recordPriceChange writes the history row of a price change, wardrobe must already hold the new price.
+// Nothing is written when the price did not actually change.
+func (m *Module) recordPriceChange(ctx context.Context, wardrobe *model.Wardrobe, oldPrice model.Money, oldCurrency, changedBy, reason string, scheduleId *uuid.UUID) error {
+	if wardrobe.Price == oldPrice && wardrobe.Currency == oldCurrency {
+		return nil
+	}
+
+	return m.priceChangeRepo.Insert(ctx, &model.PriceChange{
+		ID:          uuid.New(),
+		WardrobeID:  wardrobe.ID,
+		ScheduleID:  scheduleId,
+		OldPrice:    oldPrice,
+		OldCurrency: oldCurrency,
+		NewPrice:    wardrobe.Price,
+		NewCurrency: wardrobe.Currency,
+		ChangedBy:   changedBy,
+		Reason:      reason,
+		CreatedAt:   time.Now(),
+	})
+}
+
+func toPriceScheduleResponses(schedules *[]model.PriceSchedule) *[]response.PriceScheduleResponse {
+	scheduleResponses := []response.PriceScheduleResponse{}
+	for _, schedule := range *schedules {
+		scheduleResponses = append(scheduleResponses, *toPriceScheduleResponse(&schedule))
+	}
+	return &scheduleResponses
+}
+
+func toPriceScheduleResponse(schedule *model.PriceSchedule) *response.PriceScheduleResponse {
+	return &response.PriceScheduleResponse{
+		ID:             schedule.ID.String(),
+		WardrobeID:     schedule.WardrobeID.String(),
+		Price:          schedule.Price,
+		Currency:       schedule.Currency,
+		EffectiveFrom:  schedule.EffectiveFrom,
+		EffectiveUntil: schedule.EffectiveUntil,
+		Status:         schedule.Status,
+		Reason:         schedule.Reason,
+		CreatedBy:      schedule.CreatedBy,
+		CreatedAt:      schedule.CreatedAt,
+		UpdatedAt:      schedule.UpdatedAt,
+	}
+}
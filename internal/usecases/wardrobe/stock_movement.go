@@ -0,0 +1,71 @@
+package wardrobe
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/pkg/constants"
+	"time"
+)
+
+func (m *Module) GetStockMovements(ctx context.Context, id *uuid.UUID) (*[]response.StockMovementResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.GetStockMovements")
+	defer span.End()
+
+	_, err := m.wardrobeRepo.GetById(ctx, id)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.GetStockMovements] Failed to get wardrobe by ID")
+		return nil, err
+	}
+
+	movements, err := m.stockMovementRepo.GetByWardrobeId(ctx, id)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.GetStockMovements] Failed to get stock movements")
+		return nil, err
+	}
+
+	movementResponses := []response.StockMovementResponse{}
+	for _, movement := range *movements {
+		movementResponses = append(movementResponses, response.StockMovementResponse{
+			ID:         movement.ID.String(),
+			WardrobeID: movement.WardrobeID.String(),
+			Delta:      movement.Delta,
+			Reason:     movement.Reason,
+			Balance:    movement.Balance,
+			CreatedAt:  movement.CreatedAt,
+		})
+	}
+
+	return &movementResponses, nil
+}
+
+// recordMovement writes the ledger row for a stock change and evaluates the low stock alert of the wardrobe,
+// wardrobe must already hold the resulting stock
+func (m *Module) recordMovement(ctx context.Context, wardrobe *model.Wardrobe, delta int, reason, defaultReason string) error {
+	if reason == constants.EmptyString {
+		reason = defaultReason
+	}
+
+	err := m.stockMovementRepo.Insert(ctx, &model.StockMovement{
+		ID:         uuid.New(),
+		WardrobeID: wardrobe.ID,
+		Delta:      delta,
+		Reason:     reason,
+		Balance:    wardrobe.Stock,
+		CreatedAt:  time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return m.evaluateStockAlert(ctx, wardrobe)
+}
@@ -0,0 +1,343 @@
+package wardrobe
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/infrastructures/actor"
+	"sagara_backend_test/internal/interfaces/dao"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/log"
+	libResponse "sagara_backend_test/lib/response"
+	"sagara_backend_test/lib/response/rest"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/pkg/constants"
+	"sagara_backend_test/pkg/constants/errorcode"
+	"time"
+)
+
+// GetStockLevels breaks the stock of the wardrobe down by location, every location is listed even without stock
+func (m *Module) GetStockLevels(ctx context.Context, id *uuid.UUID) (*response.StockLevelResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.GetStockLevels")
+	defer span.End()
+
+	wardrobe, err := m.wardrobeRepo.GetById(ctx, id)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.GetStockLevels] Failed to get wardrobe by ID")
+		return nil, err
+	}
+
+	stocks, err := m.locationStockRepo.GetByWardrobeId(ctx, id)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.GetStockLevels] Failed to get location stocks")
+		return nil, err
+	}
+
+	inTransit, err := m.stockTransferRepo.GetInTransit(ctx, id)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.GetStockLevels] Failed to get stock in transit")
+		return nil, err
+	}
+
+	res := &response.StockLevelResponse{
+		WardrobeID: wardrobe.ID.String(),
+		Stock:      wardrobe.Stock,
+		InTransit:  inTransit,
+		Locations:  []response.LocationStockResponse{},
+	}
+	for _, stock := range *stocks {
+		res.Locations = append(res.Locations, response.LocationStockResponse{
+			LocationID: stock.LocationID.String(),
+			Code:       stock.LocationCode,
+			Name:       stock.LocationName,
+			IsDefault:  stock.IsDefault,
+			Quantity:   stock.Quantity,
+		})
+	}
+
+	return res, nil
+}
+
+// TransferStock takes the quantity from the origin and keeps it in transit until the transfer is received at the
+// destination or cancelled, the stock of the wardrobe goes down meanwhile
+func (m *Module) TransferStock(ctx context.Context, id *uuid.UUID, request *request.StockTransferRequest) (*response.StockTransferResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.TransferStock")
+	defer span.End()
+
+	for _, locationId := range []*uuid.UUID{request.FromLocationID, request.ToLocationID} {
+		_, err := m.locationRepo.GetById(ctx, locationId)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error":       err,
+				"id":          id,
+				"location_id": locationId,
+			}).ErrorWithCtx(ctx, "[WardrobeUseCases.TransferStock] Failed to get location")
+			return nil, err
+		}
+	}
+
+	res, err := m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		// lock first so the reserved stock checked by SubStock can not change under us
+		err := m.wardrobeRepo.LockById(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		wardrobe, err := m.wardrobeRepo.SubStock(ctx, id, request.Quantity)
+		if errors.Is(err, dao.ErrNoUpdateHappened) {
+			return nil, &custerr.ErrChain{
+				Message: errorcode.StockInsufficient.Message,
+				Code:    errorcode.StockInsufficient.Code,
+				Type:    libResponse.ErrBadRequest,
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.adjustLocationStock(ctx, wardrobe.ID, request.FromLocationID, -request.Quantity)
+		if err != nil {
+			return nil, err
+		}
+
+		now := time.Now()
+		transfer := &model.StockTransfer{
+			ID:             uuid.New(),
+			WardrobeID:     wardrobe.ID,
+			FromLocationID: *request.FromLocationID,
+			ToLocationID:   *request.ToLocationID,
+			Quantity:       request.Quantity,
+			Status:         model.TransferInTransit,
+			Note:           request.Note,
+			CreatedBy:      actor.FromContext(ctx),
+			BaseModel: model.BaseModel{
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+		}
+		err = m.stockTransferRepo.Insert(ctx, transfer)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.recordMovement(ctx, wardrobe, -request.Quantity, "", model.StockReasonTransferOut)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.recordChange(ctx, model.AuditActionStockChange, wardrobe.ID, beforeStockChange(wardrobe, -request.Quantity), wardrobe)
+		if err != nil {
+			return nil, err
+		}
+
+		return transfer, nil
+	}, nil)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":   err,
+			"id":      id,
+			"request": request,
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.TransferStock] Failed to transfer stock")
+		return nil, err
+	}
+
+	return toTransferResponse(res.(*model.StockTransfer)), nil
+}
+
+func (m *Module) GetTransfers(ctx context.Context, filterReq *request.StockTransferFilterRequest, pageReq *request.PageRequest) (*[]response.StockTransferResponse, *rest.Pagination, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.GetTransfers")
+	defer span.End()
+
+	if pageReq.Sort == constants.EmptyString {
+		recentReq := *pageReq
+		recentReq.Sort = "-created_at"
+		pageReq = &recentReq
+	}
+
+	page, err := pageReq.ToPage(model.StockTransferSortFields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transfers, pageInfo, err := m.stockTransferRepo.Get(ctx, &model.StockTransferFilter{
+		WardrobeID: filterReq.WardrobeID,
+		LocationID: filterReq.LocationID,
+		Status:     filterReq.Status,
+	}, page)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":  err,
+			"filter": filterReq,
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.GetTransfers] Failed to get transfers")
+		return nil, nil, err
+	}
+
+	transferResponses := []response.StockTransferResponse{}
+	for _, transfer := range *transfers {
+		transferResponses = append(transferResponses, *toTransferResponse(&transfer))
+	}
+
+	return &transferResponses, response.NewPagination(page, pageInfo), nil
+}
+
+func (m *Module) GetTransfer(ctx context.Context, id *uuid.UUID) (*response.StockTransferResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.GetTransfer")
+	defer span.End()
+
+	transfer, err := m.stockTransferRepo.GetById(ctx, id)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.GetTransfer] Failed to get transfer by ID")
+		return nil, err
+	}
+
+	return toTransferResponse(transfer), nil
+}
+
+// ReceiveTransfer adds the quantity in transit to the destination
+func (m *Module) ReceiveTransfer(ctx context.Context, id *uuid.UUID) (*response.StockTransferResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.ReceiveTransfer")
+	defer span.End()
+
+	transfer, err := m.settleTransfer(ctx, id, model.TransferReceived)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.ReceiveTransfer] Failed to receive transfer")
+		return nil, err
+	}
+
+	return toTransferResponse(transfer), nil
+}
+
+// CancelTransfer returns the quantity in transit to the origin
+func (m *Module) CancelTransfer(ctx context.Context, id *uuid.UUID) (*response.StockTransferResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.CancelTransfer")
+	defer span.End()
+
+	transfer, err := m.settleTransfer(ctx, id, model.TransferCancelled)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.CancelTransfer] Failed to cancel transfer")
+		return nil, err
+	}
+
+	return toTransferResponse(transfer), nil
+}
+
+// settleTransfer moves a transfer in transit to status, the quantity lands at the destination when it is received and
+// back at the origin when it is cancelled
+func (m *Module) settleTransfer(ctx context.Context, id *uuid.UUID, status string) (*model.StockTransfer, error) {
+	res, err := m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		transfer, err := m.stockTransferRepo.LockById(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if transfer.Status != model.TransferInTransit {
+			return nil, &custerr.ErrChain{
+				Message: errorcode.TransferNotInTransit.Message,
+				Code:    errorcode.TransferNotInTransit.Code,
+				Type:    libResponse.ErrConflict,
+			}
+		}
+
+		now := time.Now()
+		locationId, reason := &transfer.ToLocationID, model.StockReasonTransferIn
+		transfer.Status = status
+		if status == model.TransferReceived {
+			transfer.ReceivedAt = &now
+		} else {
+			locationId, reason = &transfer.FromLocationID, model.StockReasonTransferCancel
+			transfer.CancelledAt = &now
+		}
+
+		wardrobe, err := m.wardrobeRepo.AddStock(ctx, &transfer.WardrobeID, transfer.Quantity)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.adjustLocationStock(ctx, wardrobe.ID, locationId, transfer.Quantity)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.stockTransferRepo.Update(ctx, transfer)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.recordMovement(ctx, wardrobe, transfer.Quantity, "", reason)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.recordChange(ctx, model.AuditActionStockChange, wardrobe.ID, beforeStockChange(wardrobe, transfer.Quantity), wardrobe)
+		if err != nil {
+			return nil, err
+		}
+
+		return transfer, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*model.StockTransfer), nil
+}
+
+// adjustLocationStock applies a stock change of the wardrobe to the location, the default location when it is nil.
+// The stock of the wardrobe must be changed by the same delta in the same transaction.
+func (m *Module) adjustLocationStock(ctx context.Context, id uuid.UUID, locationId *uuid.UUID, delta int) error {
+	if locationId == nil {
+		location, err := m.locationRepo.GetDefault(ctx)
+		if err != nil {
+			return err
+		}
+		locationId = &location.ID
+	}
+
+	err := m.locationStockRepo.Adjust(ctx, &id, locationId, delta)
+	if errors.Is(err, dao.ErrNoUpdateHappened) {
+		return &custerr.ErrChain{
+			Message: errorcode.LocationStockInsufficient.Message,
+			Code:    errorcode.LocationStockInsufficient.Code,
+			Type:    libResponse.ErrBadRequest,
+		}
+	}
+
+	return err
+}
+
+func toTransferResponse(transfer *model.StockTransfer) *response.StockTransferResponse {
+	return &response.StockTransferResponse{
+		ID:             transfer.ID.String(),
+		WardrobeID:     transfer.WardrobeID.String(),
+		FromLocationID: transfer.FromLocationID.String(),
+		ToLocationID:   transfer.ToLocationID.String(),
+		Quantity:       transfer.Quantity,
+		Status:         transfer.Status,
+		Note:           transfer.Note,
+		CreatedBy:      transfer.CreatedBy,
+		ReceivedAt:     transfer.ReceivedAt,
+		CancelledAt:    transfer.CancelledAt,
+		CreatedAt:      transfer.CreatedAt,
+		UpdatedAt:      transfer.UpdatedAt,
+	}
+}
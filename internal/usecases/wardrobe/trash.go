@@ -0,0 +1,97 @@
+package wardrobe
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/interfaces/dao"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/response/rest"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/pkg/constants"
+	"time"
+)
+
+// GetTrash lists the deleted wardrobes, the most recently deleted first unless asked otherwise
+func (m *Module) GetTrash(ctx context.Context, pageReq *request.PageRequest) (*[]response.WardrobeResponse, *rest.Pagination, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.GetTrash")
+	defer span.End()
+
+	if pageReq.Sort == constants.EmptyString {
+		deletedReq := *pageReq
+		deletedReq.Sort = "-deleted_at"
+		pageReq = &deletedReq
+	}
+
+	page, err := pageReq.ToPage(model.WardrobeTrashSortFields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wardrobes, pageInfo, err := m.wardrobeRepo.GetDeleted(ctx, page)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.GetTrash] Failed to get deleted wardrobe")
+		return nil, nil, err
+	}
+
+	wardrobeResponses := []response.WardrobeResponse{}
+	for _, wardrobe := range *wardrobes {
+		wardrobeResponses = append(wardrobeResponses, *toWardrobeResponse(&wardrobe))
+	}
+
+	return &wardrobeResponses, response.NewPagination(page, pageInfo), nil
+}
+
+// RestoreWardrobe takes the wardrobe out of the trash, it fails when another wardrobe took its sku meanwhile
+func (m *Module) RestoreWardrobe(ctx context.Context, id *uuid.UUID) (*response.WardrobeResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.RestoreWardrobe")
+	defer span.End()
+
+	res, err := m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		wardrobe, err := m.wardrobeRepo.Restore(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return wardrobe, m.recordChange(ctx, model.AuditActionRestore, wardrobe.ID, nil, wardrobe)
+	}, nil)
+	if errors.Is(err, dao.ErrDuplicate) {
+		return nil, errSKUDuplicate()
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.RestoreWardrobe] Failed to restore wardrobe")
+		return nil, err
+	}
+
+	return toWardrobeResponse(res.(*model.Wardrobe)), nil
+}
+
+// PurgeTrash permanently removes the wardrobes that have been in the trash longer than the retention
+func (m *Module) PurgeTrash(ctx context.Context) (*response.PurgeResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.PurgeTrash")
+	defer span.End()
+
+	deletedBefore := time.Now().Add(-m.trashRetention)
+
+	purged, err := m.wardrobeRepo.Purge(ctx, deletedBefore)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":          err,
+			"deleted_before": deletedBefore,
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.PurgeTrash] Failed to purge wardrobe")
+		return nil, err
+	}
+
+	return &response.PurgeResponse{
+		Purged:        purged,
+		DeletedBefore: deletedBefore,
+	}, nil
+}
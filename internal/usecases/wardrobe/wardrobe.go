@@ -2,139 +2,279 @@ package wardrobe
 
 import (
 	"context"
+	"errors"
 	"github.com/google/uuid"
 	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/infrastructures/access"
+	"sagara_backend_test/internal/infrastructures/actor"
+	"sagara_backend_test/internal/interfaces/dao"
 	"sagara_backend_test/internal/usecases/request"
 	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/custerr"
 	"sagara_backend_test/lib/log"
+	libResponse "sagara_backend_test/lib/response"
+	"sagara_backend_test/lib/response/rest"
 	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/pkg/constants"
+	"sagara_backend_test/pkg/constants/errorcode"
+	"strings"
+	"time"
 )
 
-func (m *Module) AddStock(ctx context.Context, id *uuid.UUID, add int) (*response.WardrobeResponse, error) {
+func (m *Module) AddStock(ctx context.Context, id *uuid.UUID, request *request.WardrobeAddSubRequest) (*response.WardrobeResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.AddStock")
 	defer span.End()
 
-	existingWardrobe, err := m.wardrobeRepo.GetById(ctx, id)
+	res, err := m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		wardrobe, err := m.wardrobeRepo.AddStock(ctx, id, request.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.adjustLocationStock(ctx, wardrobe.ID, request.LocationID, request.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.recordMovement(ctx, wardrobe, request.Amount, request.Reason, model.StockReasonAdd)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.recordChange(ctx, model.AuditActionStockChange, wardrobe.ID, beforeStockChange(wardrobe, request.Amount), wardrobe)
+		if err != nil {
+			return nil, err
+		}
+
+		return wardrobe, nil
+	}, nil)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"error": err,
 			"id":    id,
-		}).ErrorWithCtx(ctx, "[WardrobeUseCases.AddStock] Failed to get wardrobe by ID")
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.AddStock] Failed to add stock")
 		return nil, err
 	}
 
-	stockNow := existingWardrobe.Stock + add
+	wardrobe := res.(*model.Wardrobe)
+	return toWardrobeResponse(wardrobe), nil
+}
+
+func (m *Module) SubStock(ctx context.Context, id *uuid.UUID, request *request.WardrobeAddSubRequest) (*response.WardrobeResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.SubStock")
+	defer span.End()
 
-	err = m.wardrobeRepo.AddStock(ctx, id, stockNow)
+	res, err := m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		// lock first so the reserved stock checked by SubStock can not change under us
+		err := m.wardrobeRepo.LockById(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		wardrobe, err := m.wardrobeRepo.SubStock(ctx, id, request.Amount)
+		if errors.Is(err, dao.ErrNoUpdateHappened) {
+			return nil, &custerr.ErrChain{
+				Message: errorcode.StockInsufficient.Message,
+				Code:    errorcode.StockInsufficient.Code,
+				Type:    libResponse.ErrBadRequest,
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.adjustLocationStock(ctx, wardrobe.ID, request.LocationID, -request.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.recordMovement(ctx, wardrobe, -request.Amount, request.Reason, model.StockReasonSub)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.recordChange(ctx, model.AuditActionStockChange, wardrobe.ID, beforeStockChange(wardrobe, -request.Amount), wardrobe)
+		if err != nil {
+			return nil, err
+		}
+
+		return wardrobe, nil
+	}, nil)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"error": err,
 			"id":    id,
-		}).ErrorWithCtx(ctx, "[WardrobeUseCases.AddStock] Failed to add stock")
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.SubStock] Failed to sub stock")
 		return nil, err
 	}
 
-	wardrobeResponse := &response.WardrobeResponse{
-		ID:    existingWardrobe.ID.String(),
-		Name:  existingWardrobe.Name,
-		Color: existingWardrobe.Color,
-		Size:  existingWardrobe.Size,
-		Price: existingWardrobe.Price,
-		Stock: stockNow,
-	}
-
-	return wardrobeResponse, nil
+	wardrobe := res.(*model.Wardrobe)
+	return toWardrobeResponse(wardrobe), nil
 }
 
-func (m *Module) SubStock(ctx context.Context, id *uuid.UUID, def int) (*response.WardrobeResponse, error) {
-	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.SubStock")
+func (m *Module) Search(ctx context.Context, request *request.WardrobeSearchRequest, pageReq *request.PageRequest) (*[]response.WardrobeResponse, *rest.Pagination, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.Search")
 	defer span.End()
 
-	existingWardrobe, err := m.wardrobeRepo.GetById(ctx, id)
-	if err != nil {
-		log.WithFields(log.Fields{
-			"error": err,
-			"id":    id,
-		}).ErrorWithCtx(ctx, "[WardrobeUseCases.SubStock] Failed to get wardrobe by ID")
-		return nil, err
+	// the relevance only exists for a query, which is then sorted by it unless asked otherwise
+	sortFields := model.WardrobeSortFields
+	if request.Query != constants.EmptyString {
+		sortFields = sortFields.With(model.WardrobeRelevance, model.SortNumeric)
+		if pageReq.Sort == constants.EmptyString {
+			relevanceReq := *pageReq
+			relevanceReq.Sort = "-" + model.WardrobeRelevance
+			pageReq = &relevanceReq
+		}
 	}
 
-	stockNow := existingWardrobe.Stock - def
+	page, err := pageReq.ToPage(sortFields)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	err = m.wardrobeRepo.SubStock(ctx, id, stockNow)
+	wardrobes, pageInfo, err := m.wardrobeRepo.Search(ctx, toWardrobeFilter(request), page)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"error": err,
-			"id":    id,
-		}).ErrorWithCtx(ctx, "[WardrobeUseCases.SubStock] Failed to add stock")
-		return nil, err
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.Search] Failed to get wardrobes")
+		return nil, nil, err
 	}
 
-	wardrobeResponse := &response.WardrobeResponse{
-		ID:    existingWardrobe.ID.String(),
-		Name:  existingWardrobe.Name,
-		Color: existingWardrobe.Color,
-		Size:  existingWardrobe.Size,
-		Price: existingWardrobe.Price,
-		Stock: stockNow,
+	var wardrobeResponses []response.WardrobeResponse
+	for _, wardrobe := range *wardrobes {
+		wardrobeResponses = append(wardrobeResponses, *toWardrobeResponse(&wardrobe))
 	}
 
-	return wardrobeResponse, nil
+	return &wardrobeResponses, response.NewPagination(page, pageInfo), nil
+
 }
 
-func (m *Module) Search(ctx context.Context, color, size string) (*[]response.WardrobeResponse, error) {
-	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.Search")
+// Suggest completes a partially typed query with the best matching wardrobes
+func (m *Module) Suggest(ctx context.Context, query string) (*[]response.WardrobeSuggestionResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.Suggest")
 	defer span.End()
 
-	wardrobes, err := m.wardrobeRepo.Search(ctx, color, size)
+	suggestions := []response.WardrobeSuggestionResponse{}
+	if strings.TrimSpace(query) == constants.EmptyString {
+		return &suggestions, nil
+	}
+
+	wardrobes, err := m.wardrobeRepo.Suggest(ctx, query)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"error": err,
-		}).ErrorWithCtx(ctx, "[WardrobeUseCases.Search] Failed to get wardrobes")
+			"query": query,
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.Suggest] Failed to suggest wardrobes")
 		return nil, err
 	}
 
-	var wardrobeResponses []response.WardrobeResponse
 	for _, wardrobe := range *wardrobes {
-		wardrobeResponses = append(wardrobeResponses, response.WardrobeResponse{
+		suggestion := response.WardrobeSuggestionResponse{
 			ID:    wardrobe.ID.String(),
 			Name:  wardrobe.Name,
 			Color: wardrobe.Color,
 			Size:  wardrobe.Size,
-			Price: wardrobe.Price,
-			Stock: wardrobe.Stock,
-		})
+		}
+		if wardrobe.SKU != nil {
+			suggestion.SKU = *wardrobe.SKU
+		}
+		suggestions = append(suggestions, suggestion)
+	}
+
+	return &suggestions, nil
+}
+
+func (m *Module) GetByProduct(ctx context.Context, productId *uuid.UUID) (*[]response.WardrobeResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.GetByProduct")
+	defer span.End()
+
+	wardrobes, err := m.wardrobeRepo.GetByProductId(ctx, productId)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":      err,
+			"product_id": productId,
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.GetByProduct] Failed to get variants")
+		return nil, err
+	}
+
+	wardrobeResponses := []response.WardrobeResponse{}
+	for _, wardrobe := range *wardrobes {
+		wardrobeResponses = append(wardrobeResponses, *toWardrobeResponse(&wardrobe))
 	}
 
 	return &wardrobeResponses, nil
+}
+
+// SetCategory moves the wardrobe into a category, an empty category removes it from its current one
+func (m *Module) SetCategory(ctx context.Context, id *uuid.UUID, request *request.WardrobeCategoryRequest) (*response.WardrobeResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.SetCategory")
+	defer span.End()
+
+	if request.CategoryID != nil {
+		_, err := m.categoryRepo.GetById(ctx, request.CategoryID)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error":   err,
+				"id":      id,
+				"request": request,
+			}).ErrorWithCtx(ctx, "[WardrobeUseCases.SetCategory] Failed to get category")
+			return nil, err
+		}
+	}
+
+	res, err := m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		before, err := m.lockWardrobe(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		wardrobe, err := m.wardrobeRepo.SetCategory(ctx, id, request.CategoryID)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.recordChange(ctx, model.AuditActionUpdate, wardrobe.ID, before, wardrobe)
+		if err != nil {
+			return nil, err
+		}
+
+		return wardrobe, nil
+	}, nil)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":   err,
+			"id":      id,
+			"request": request,
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.SetCategory] Failed to set category")
+		return nil, err
+	}
 
+	return toWardrobeResponse(res.(*model.Wardrobe)), nil
 }
 
-func (m *Module) GetAllWardrobe(ctx context.Context) (*[]response.WardrobeResponse, error) {
+func (m *Module) GetAllWardrobe(ctx context.Context, pageReq *request.PageRequest) (*[]response.WardrobeResponse, *rest.Pagination, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.GetAllWardrobe")
 	defer span.End()
 
-	wardrobes, err := m.wardrobeRepo.GetAll(ctx)
+	page, err := pageReq.ToPage(model.WardrobeSortFields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wardrobes, pageInfo, err := m.wardrobeRepo.GetAll(ctx, page)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"error": err,
 		}).ErrorWithCtx(ctx, "[WardrobeUseCases.GetAllWardrobe] Failed to get all wardrobe")
-		return nil, err
+		return nil, nil, err
 	}
 
 	var wardrobeResponses []response.WardrobeResponse
 	for _, wardrobe := range *wardrobes {
-		wardrobeResponses = append(wardrobeResponses, response.WardrobeResponse{
-			ID:    wardrobe.ID.String(),
-			Name:  wardrobe.Name,
-			Color: wardrobe.Color,
-			Size:  wardrobe.Size,
-			Price: wardrobe.Price,
-			Stock: wardrobe.Stock,
-		})
+		wardrobeResponses = append(wardrobeResponses, *toWardrobeResponse(&wardrobe))
 	}
 
-	return &wardrobeResponses, nil
+	return &wardrobeResponses, response.NewPagination(page, pageInfo), nil
 }
 
 func (m *Module) GetWardrobe(ctx context.Context, id *uuid.UUID) (*response.WardrobeResponse, error) {
@@ -150,30 +290,94 @@ func (m *Module) GetWardrobe(ctx context.Context, id *uuid.UUID) (*response.Ward
 		return nil, err
 	}
 
-	return &response.WardrobeResponse{
-		ID:    wardrobe.ID.String(),
-		Name:  wardrobe.Name,
-		Color: wardrobe.Color,
-		Size:  wardrobe.Size,
-		Price: wardrobe.Price,
-		Stock: wardrobe.Stock,
-	}, nil
+	schedules, err := m.priceScheduleRepo.GetByWardrobeId(ctx, id, model.PriceScheduleActive, model.PriceSchedulePending)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WardrobeUseCases.GetWardrobe] Failed to get price schedules")
+		return nil, err
+	}
+
+	res := toWardrobeResponse(wardrobe)
+	res.PriceSchedules = *toPriceScheduleResponses(schedules)
+	return res, nil
 }
 
 func (m *Module) InsertWardrobe(ctx context.Context, request *request.WardrobeInsertRequest) (*response.WardrobeResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.InsertWardrobe")
 	defer span.End()
 
+	now := time.Now()
 	newWardrobe := &model.Wardrobe{
-		ID:    uuid.New(),
-		Name:  request.Name,
-		Color: request.Color,
-		Size:  request.Size,
-		Price: request.Price,
-		Stock: request.Stock,
+		BaseModel: model.BaseModel{
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		ID:        uuid.New(),
+		Name:      request.Name,
+		Color:     request.Color,
+		Size:      request.Size,
+		Currency:  model.NormalizeCurrency(request.Currency),
+		Stock:     request.Stock,
+		Version:   1,
+		ProductID: request.ProductID,
+		SKU:       toSKU(request.SKU),
 	}
 
-	err := m.wardrobeRepo.Insert(ctx, newWardrobe)
+	_, err := m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		if newWardrobe.ProductID != nil {
+			product, err := m.productRepo.GetById(ctx, newWardrobe.ProductID)
+			if err != nil {
+				return nil, err
+			}
+
+			// an empty price means the variant sells at the product price
+			newWardrobe.Price = product.Price
+			if request.Price != nil {
+				newWardrobe.Price = *request.Price
+			}
+			if newWardrobe.Currency == constants.EmptyString {
+				newWardrobe.Currency = product.Currency
+			}
+			applyProduct(newWardrobe, product)
+		} else {
+			if request.Price != nil {
+				newWardrobe.Price = *request.Price
+			}
+			if newWardrobe.Currency == constants.EmptyString {
+				newWardrobe.Currency = constants.DefaultCurrency
+			}
+		}
+
+		err := m.wardrobeRepo.Insert(ctx, newWardrobe)
+		if errors.Is(err, dao.ErrDuplicate) && newWardrobe.SKU != nil {
+			return nil, errSKUDuplicate()
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if newWardrobe.Stock != 0 {
+			// the initial stock is held at the default location, transfers move it to the others
+			err = m.adjustLocationStock(ctx, newWardrobe.ID, nil, newWardrobe.Stock)
+			if err != nil {
+				return nil, err
+			}
+
+			err = m.recordMovement(ctx, newWardrobe, newWardrobe.Stock, "", model.StockReasonInitial)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		err = m.recordChange(ctx, model.AuditActionCreate, newWardrobe.ID, nil, newWardrobe)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, nil
+	}, nil)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"error":   err,
@@ -182,38 +386,111 @@ func (m *Module) InsertWardrobe(ctx context.Context, request *request.WardrobeIn
 		return nil, err
 	}
 
-	wardrobeResponse := &response.WardrobeResponse{
-		ID:    newWardrobe.ID.String(),
-		Name:  newWardrobe.Name,
-		Color: newWardrobe.Color,
-		Size:  newWardrobe.Size,
-		Price: newWardrobe.Price,
-		Stock: newWardrobe.Stock,
-	}
-
-	return wardrobeResponse, nil
+	return toWardrobeResponse(newWardrobe), nil
 }
 
-func (m *Module) UpdateWardrobe(ctx context.Context, id *uuid.UUID, request *request.WardrobeUpdateRequest) (*response.WardrobeResponse, error) {
+func (m *Module) UpdateWardrobe(ctx context.Context, id *uuid.UUID, version int, request *request.WardrobeUpdateRequest) (*response.WardrobeResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.UpdateWardrobe")
 	defer span.End()
 
-	existingWardrobe, err := m.wardrobeRepo.GetById(ctx, id)
-	if err != nil {
-		log.WithFields(log.Fields{
-			"error": err,
-			"id":    id,
-		}).ErrorWithCtx(ctx, "[WardrobeUseCases.UpdateWardrobe] Failed to get wardrobe by ID")
-		return nil, err
+	errStaleVersion := &custerr.ErrChain{
+		Message: errorcode.VersionMismatch.Message,
+		Code:    errorcode.VersionMismatch.Code,
+		Type:    libResponse.ErrPreconditionFailed,
 	}
 
-	existingWardrobe.Name = request.Name
-	existingWardrobe.Color = request.Color
-	existingWardrobe.Size = request.Size
-	existingWardrobe.Price = request.Price
-	existingWardrobe.Stock = request.Stock
-
-	err = m.wardrobeRepo.Update(ctx, existingWardrobe)
+	res, err := m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		err := m.wardrobeRepo.LockById(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		existingWardrobe, err := m.wardrobeRepo.GetById(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if existingWardrobe.Version != version {
+			return nil, errStaleVersion
+		}
+
+		// the full payload carries the price, sending it back unchanged is what a caller without the permission does
+		if priceChanged(existingWardrobe, request) && !access.Can(ctx, constants.PermissionWardrobePrice) {
+			return nil, &custerr.ErrChain{
+				Message: errorcode.PriceChangeForbidden.Message,
+				Code:    errorcode.PriceChangeForbidden.Code,
+				Type:    libResponse.ErrForbiddenResource,
+			}
+		}
+
+		if request.Stock < existingWardrobe.Reserved {
+			// stock held by active reservations can not be taken away
+			return nil, &custerr.ErrChain{
+				Message: errorcode.StockInsufficient.Message,
+				Code:    errorcode.StockInsufficient.Code,
+				Type:    libResponse.ErrBadRequest,
+			}
+		}
+
+		delta := request.Stock - existingWardrobe.Stock
+		oldPrice, oldCurrency := existingWardrobe.Price, existingWardrobe.Currency
+		before := *existingWardrobe
+
+		existingWardrobe.Name = request.Name
+		existingWardrobe.Color = request.Color
+		existingWardrobe.Size = request.Size
+		existingWardrobe.Price = request.Price
+		if request.Currency != constants.EmptyString {
+			existingWardrobe.Currency = model.NormalizeCurrency(request.Currency)
+		}
+		existingWardrobe.Stock = request.Stock
+		existingWardrobe.SKU = toSKU(request.SKU)
+
+		if existingWardrobe.ProductID != nil {
+			product, err := m.productRepo.GetById(ctx, existingWardrobe.ProductID)
+			if err != nil {
+				return nil, err
+			}
+			applyProduct(existingWardrobe, product)
+		}
+
+		err = m.wardrobeRepo.Update(ctx, existingWardrobe)
+		if errors.Is(err, dao.ErrNoUpdateHappened) {
+			// the version was bumped between our read and write
+			return nil, errStaleVersion
+		}
+		if errors.Is(err, dao.ErrDuplicate) {
+			return nil, errSKUDuplicate()
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if delta != 0 {
+			// a stock set by an update is settled at the default location
+			err = m.adjustLocationStock(ctx, existingWardrobe.ID, nil, delta)
+			if err != nil {
+				return nil, err
+			}
+
+			err = m.recordMovement(ctx, existingWardrobe, delta, "", model.StockReasonUpdate)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		err = m.recordPriceChange(ctx, existingWardrobe, oldPrice, oldCurrency, actor.FromContext(ctx), request.PriceReason, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.recordChange(ctx, model.AuditActionUpdate, existingWardrobe.ID, &before, existingWardrobe)
+		if err != nil {
+			return nil, err
+		}
+
+		return existingWardrobe, nil
+	}, nil)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"error": err,
@@ -222,23 +499,28 @@ func (m *Module) UpdateWardrobe(ctx context.Context, id *uuid.UUID, request *req
 		return nil, err
 	}
 
-	wardrobeResponse := &response.WardrobeResponse{
-		ID:    existingWardrobe.ID.String(),
-		Name:  existingWardrobe.Name,
-		Color: existingWardrobe.Color,
-		Size:  existingWardrobe.Size,
-		Price: existingWardrobe.Price,
-		Stock: existingWardrobe.Stock,
-	}
-
-	return wardrobeResponse, nil
+	existingWardrobe := res.(*model.Wardrobe)
+	return toWardrobeResponse(existingWardrobe), nil
 }
 
+// DeleteWardrobe moves the wardrobe to the trash, it can be restored until the trash is purged
 func (m *Module) DeleteWardrobe(ctx context.Context, id *uuid.UUID) error {
 	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.DeleteWardrobe")
 	defer span.End()
 
-	err := m.wardrobeRepo.Delete(ctx, id)
+	_, err := m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		wardrobe, err := m.lockWardrobe(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.wardrobeRepo.Delete(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, m.recordChange(ctx, model.AuditActionDelete, wardrobe.ID, wardrobe, nil)
+	}, nil)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"error": err,
@@ -250,83 +532,161 @@ func (m *Module) DeleteWardrobe(ctx context.Context, id *uuid.UUID) error {
 	return nil
 }
 
-func (m *Module) GetAvailable(ctx context.Context) (*[]response.WardrobeResponse, error) {
+func (m *Module) GetAvailable(ctx context.Context, pageReq *request.PageRequest) (*[]response.WardrobeResponse, *rest.Pagination, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.GetAvailable")
 	defer span.End()
 
-	wardrobes, err := m.wardrobeRepo.GetAvailable(ctx)
+	page, err := pageReq.ToPage(model.WardrobeSortFields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wardrobes, pageInfo, err := m.wardrobeRepo.GetAvailable(ctx, page)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"error": err,
 		}).ErrorWithCtx(ctx, "[WardrobeUseCases.GetAvailable] Failed to get available wardrobe")
-		return nil, err
+		return nil, nil, err
 	}
 
 	var wardrobeResponses []response.WardrobeResponse
 	for _, wardrobe := range *wardrobes {
-		wardrobeResponses = append(wardrobeResponses, response.WardrobeResponse{
-			ID:    wardrobe.ID.String(),
-			Name:  wardrobe.Name,
-			Color: wardrobe.Color,
-			Size:  wardrobe.Size,
-			Price: wardrobe.Price,
-			Stock: wardrobe.Stock,
-		})
+		wardrobeResponses = append(wardrobeResponses, *toWardrobeResponse(&wardrobe))
 	}
 
-	return &wardrobeResponses, nil
+	return &wardrobeResponses, response.NewPagination(page, pageInfo), nil
 }
 
-func (m *Module) GetUnavailable(ctx context.Context) (*[]response.WardrobeResponse, error) {
+func (m *Module) GetUnavailable(ctx context.Context, pageReq *request.PageRequest) (*[]response.WardrobeResponse, *rest.Pagination, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.GetUnavailable")
 	defer span.End()
 
-	wardrobes, err := m.wardrobeRepo.GetUnavailable(ctx)
+	page, err := pageReq.ToPage(model.WardrobeSortFields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wardrobes, pageInfo, err := m.wardrobeRepo.GetUnavailable(ctx, page)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"error": err,
 		}).ErrorWithCtx(ctx, "[WardrobeUseCases.GetAvailable] Failed to get unavailable wardrobe")
-		return nil, err
+		return nil, nil, err
 	}
 
 	var wardrobeResponses []response.WardrobeResponse
 	for _, wardrobe := range *wardrobes {
-		wardrobeResponses = append(wardrobeResponses, response.WardrobeResponse{
-			ID:    wardrobe.ID.String(),
-			Name:  wardrobe.Name,
-			Color: wardrobe.Color,
-			Size:  wardrobe.Size,
-			Price: wardrobe.Price,
-			Stock: wardrobe.Stock,
-		})
+		wardrobeResponses = append(wardrobeResponses, *toWardrobeResponse(&wardrobe))
 	}
 
-	return &wardrobeResponses, nil
+	return &wardrobeResponses, response.NewPagination(page, pageInfo), nil
 }
 
-func (m *Module) GetLessThan(ctx context.Context, amount int) (*[]response.WardrobeResponse, error) {
+// GetLessThan lists the wardrobes whose available stock is under amount, the configured default threshold when amount is 0
+func (m *Module) GetLessThan(ctx context.Context, amount int, pageReq *request.PageRequest) (*[]response.WardrobeResponse, *rest.Pagination, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "WardrobeUseCases.GetLessThan")
 	defer span.End()
 
-	wardrobes, err := m.wardrobeRepo.GetLessThan(ctx, amount)
+	if amount == 0 {
+		amount = m.lowStockThreshold
+	}
+
+	page, err := pageReq.ToPage(model.WardrobeSortFields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wardrobes, pageInfo, err := m.wardrobeRepo.GetLessThan(ctx, amount, page)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"error": err,
 		}).ErrorWithCtx(ctx, "[WardrobeUseCases.GetLessThan] Failed to get wardrobe")
-		return nil, err
+		return nil, nil, err
 	}
 
 	var wardrobeResponses []response.WardrobeResponse
 	for _, wardrobe := range *wardrobes {
-		wardrobeResponses = append(wardrobeResponses, response.WardrobeResponse{
-			ID:    wardrobe.ID.String(),
-			Name:  wardrobe.Name,
-			Color: wardrobe.Color,
-			Size:  wardrobe.Size,
-			Price: wardrobe.Price,
-			Stock: wardrobe.Stock,
-		})
+		wardrobeResponses = append(wardrobeResponses, *toWardrobeResponse(&wardrobe))
 	}
 
-	return &wardrobeResponses, nil
+	return &wardrobeResponses, response.NewPagination(page, pageInfo), nil
+}
+
+// priceChanged reports whether the update would change the price or the currency of the wardrobe
+func priceChanged(wardrobe *model.Wardrobe, request *request.WardrobeUpdateRequest) bool {
+	if request.Currency != constants.EmptyString && model.NormalizeCurrency(request.Currency) != wardrobe.Currency {
+		return true
+	}
+	return request.Price != wardrobe.Price
+}
+
+// applyProduct makes a variant follow its product, the name is always the product name
+// and the price only counts as an override while it differs from the product price or currency
+func applyProduct(wardrobe *model.Wardrobe, product *model.Product) {
+	wardrobe.Name = product.Name
+	wardrobe.PriceOverridden = wardrobe.Price != product.Price || wardrobe.Currency != product.Currency
+}
+
+func toWardrobeFilter(request *request.WardrobeSearchRequest) *model.WardrobeFilter {
+	filter := &model.WardrobeFilter{
+		Query:      request.Query,
+		Color:      request.Color,
+		Size:       request.Size,
+		CategoryID: request.CategoryID,
+		AllTags:    request.TagMatch == constants.TagMatchAll,
+	}
+
+	// duplicated tags would never be matched all at once
+	seenTags := map[string]bool{}
+	for _, tag := range request.Tags {
+		tag = model.NormalizeTag(tag)
+		if tag == "" || seenTags[tag] {
+			continue
+		}
+		seenTags[tag] = true
+		filter.Tags = append(filter.Tags, tag)
+	}
+
+	return filter
+}
+
+func toSKU(sku string) *string {
+	if sku == "" {
+		return nil
+	}
+	return &sku
+}
+
+func errSKUDuplicate() error {
+	return &custerr.ErrChain{
+		Message: errorcode.SKUDuplicate.Message,
+		Code:    errorcode.SKUDuplicate.Code,
+		Type:    libResponse.ErrConflict,
+	}
+}
+
+func toWardrobeResponse(wardrobe *model.Wardrobe) *response.WardrobeResponse {
+	res := &response.WardrobeResponse{
+		ID:        wardrobe.ID.String(),
+		Name:      wardrobe.Name,
+		Color:     wardrobe.Color,
+		Size:      wardrobe.Size,
+		Price:     wardrobe.Price,
+		Currency:  wardrobe.Currency,
+		Stock:     wardrobe.Stock,
+		Reserved:  wardrobe.Reserved,
+		Available: wardrobe.Available(),
+		Version:   wardrobe.Version,
+		DeletedAt: wardrobe.DeletedAt,
+	}
+	if wardrobe.ProductID != nil {
+		res.ProductID = wardrobe.ProductID.String()
+	}
+	if wardrobe.SKU != nil {
+		res.SKU = *wardrobe.SKU
+	}
+	if wardrobe.CategoryID != nil {
+		res.CategoryID = wardrobe.CategoryID.String()
+	}
+	return res
 }
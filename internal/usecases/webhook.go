@@ -0,0 +1,23 @@
+package usecases
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/infrastructures/publisher"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/response/rest"
+)
+
+type WebhookUseCases interface {
+	GetWebhooks(ctx context.Context) (*[]response.WebhookResponse, error)
+	GetWebhook(ctx context.Context, id *uuid.UUID) (*response.WebhookResponse, error)
+	InsertWebhook(ctx context.Context, request *request.WebhookRequest) (*response.WebhookResponse, error)
+	UpdateWebhook(ctx context.Context, id *uuid.UUID, request *request.WebhookRequest) (*response.WebhookResponse, error)
+	DeleteWebhook(ctx context.Context, id *uuid.UUID) error
+	GetDeliveries(ctx context.Context, id *uuid.UUID, pageReq *request.PageRequest) (*[]response.WebhookDeliveryResponse, *rest.Pagination, error)
+	GetDelivery(ctx context.Context, id, deliveryId *uuid.UUID) (*response.WebhookDeliveryResponse, error)
+	Redeliver(ctx context.Context, id, deliveryId *uuid.UUID) (*response.WebhookDeliveryResponse, error)
+	EnqueueEvent(ctx context.Context, event *publisher.Event) error
+	DeliverWebhooks(ctx context.Context) (int, error)
+}
@@ -0,0 +1,268 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"math/rand"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/infrastructures/publisher"
+	libHttp "sagara_backend_test/lib/http"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/tracing"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	// HeaderSignature is sha256= followed by the hex HMAC-SHA256, keyed by the subscription secret, of the timestamp
+	// header, a dot and the raw body
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// responseBodyLimit is how much of the answer of the receiver is kept in the attempt log
+const responseBodyLimit = 1024
+
+// maxBackOffShift keeps the doubled delay from overflowing
+const maxBackOffShift = 20
+
+// EnqueueEvent queues a delivery of the event to every active subscription of its type. It is called by the outbox
+// relay, an event published twice is only queued once per subscription.
+func (m *Module) EnqueueEvent(ctx context.Context, event *publisher.Event) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookUseCases.EnqueueEvent")
+	defer span.End()
+
+	eventId, err := uuid.Parse(event.ID)
+	if err != nil {
+		return err
+	}
+
+	subscriptions, err := m.subscriptionRepo.GetActive(ctx)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, subscription := range *subscriptions {
+		if !subscription.EventTypes.Matches(event.Type) {
+			continue
+		}
+
+		err = m.deliveryRepo.Insert(ctx, &model.WebhookDelivery{
+			BaseModel: model.BaseModel{
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+			ID:             uuid.New(),
+			SubscriptionID: subscription.ID,
+			EventID:        eventId,
+			EventType:      event.Type,
+			Payload:        payload,
+			Status:         model.WebhookDeliveryPending,
+			NextAttemptAt:  now,
+		})
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error":    err,
+				"event_id": event.ID,
+				"webhook":  subscription.ID,
+			}).ErrorWithCtx(ctx, "[WebhookUseCases.EnqueueEvent] Failed to queue delivery")
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeliverWebhooks makes one attempt for every delivery that is due and returns how many succeeded. A failed delivery
+// is retried after the delay of the retry config until it runs out of attempts, and a subscription failing too many
+// attempts in a row is disabled. The deliveries are claimed and their results recorded in short transactions of their
+// own, no transaction is open while a receiver is called.
+func (m *Module) DeliverWebhooks(ctx context.Context) (int, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookUseCases.DeliverWebhooks")
+	defer span.End()
+
+	// the lease outlasts the batch, every delivery of it can take up to the timeout
+	deliveries, err := m.deliveryRepo.ClaimPending(ctx, m.batchSize, time.Duration(m.batchSize+1)*m.timeout)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[WebhookUseCases.DeliverWebhooks] Failed to claim deliveries")
+		return 0, err
+	}
+
+	delivered := 0
+	subscriptions := make(map[uuid.UUID]*model.WebhookSubscription)
+	for _, delivery := range *deliveries {
+		subscription, found := subscriptions[delivery.SubscriptionID]
+		if !found {
+			subscription, err = m.subscriptionRepo.GetById(ctx, &delivery.SubscriptionID)
+			if err != nil {
+				log.WithFields(log.Fields{
+					"error":   err,
+					"webhook": delivery.SubscriptionID,
+				}).ErrorWithCtx(ctx, "[WebhookUseCases.DeliverWebhooks] Failed to get webhook")
+				return delivered, err
+			}
+			subscriptions[delivery.SubscriptionID] = subscription
+		}
+
+		// disabled by an earlier delivery of this batch, the delivery waits for the subscription to be activated again
+		if !subscription.Active {
+			continue
+		}
+
+		succeeded, err := m.deliver(ctx, subscription, &delivery)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error":    err,
+				"delivery": delivery.ID,
+			}).ErrorWithCtx(ctx, "[WebhookUseCases.DeliverWebhooks] Failed to record delivery")
+			return delivered, err
+		}
+		if succeeded {
+			delivered++
+		}
+	}
+
+	return delivered, nil
+}
+
+// deliver makes one attempt, then records it on the delivery, the attempt log and the subscription failures in one
+// transaction. It tells whether the receiver accepted the delivery.
+func (m *Module) deliver(ctx context.Context, subscription *model.WebhookSubscription, delivery *model.WebhookDelivery) (bool, error) {
+	delivery.Attempts++
+	attempt := m.send(ctx, subscription, delivery)
+
+	now := time.Now()
+	if attempt.Error == "" {
+		delivery.Status = model.WebhookDeliverySucceeded
+		delivery.DeliveredAt = &now
+		delivery.LastError = ""
+	} else {
+		delivery.LastError = attempt.Error
+		if delivery.Attempts >= m.retry.MaxRetry {
+			delivery.Status = model.WebhookDeliveryFailed
+		} else {
+			delivery.NextAttemptAt = now.Add(m.backoff(delivery.Attempts))
+		}
+	}
+
+	_, err := m.txMgr.Execute(ctx, func(ctx context.Context) (any, error) {
+		err := m.deliveryRepo.InsertAttempt(ctx, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.deliveryRepo.Update(ctx, delivery)
+		if err != nil {
+			return nil, err
+		}
+
+		if attempt.Error == "" {
+			return nil, m.subscriptionRepo.ResetFailures(ctx, &subscription.ID)
+		}
+
+		disabled, err := m.subscriptionRepo.RecordFailure(ctx, &subscription.ID, m.disableAfter, attempt.Error)
+		if err != nil {
+			return nil, err
+		}
+		if disabled {
+			subscription.Active = false
+			log.WithFields(log.Fields{
+				"id":  subscription.ID,
+				"url": subscription.URL,
+			}).WarnWithCtx(ctx, "[WebhookUseCases.DeliverWebhooks] Webhook disabled")
+		}
+		return nil, nil
+	}, nil)
+	if err != nil {
+		return false, err
+	}
+
+	return attempt.Error == "", nil
+}
+
+// send posts the signed payload to the subscription url, the returned attempt holds an error unless a 2xx came back
+func (m *Module) send(ctx context.Context, subscription *model.WebhookSubscription, delivery *model.WebhookDelivery) *model.WebhookDeliveryAttempt {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	attempt := &model.WebhookDeliveryAttempt{
+		ID:         uuid.New(),
+		DeliveryID: delivery.ID,
+		Attempt:    delivery.Attempts,
+		CreatedAt:  time.Now(),
+	}
+
+	// the stored json is reformatted by the database, lib/http sends it compacted and that is what gets signed
+	body, err := json.Marshal(json.RawMessage(delivery.Payload))
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt
+	}
+
+	res, err := libHttp.NewPOSTRequest[any](subscription.URL, nil, m.client).
+		WithContext(ctx).
+		WithHeaders(map[string]string{
+			HeaderEvent:     delivery.EventType,
+			HeaderDelivery:  delivery.ID.String(),
+			HeaderTimestamp: timestamp,
+			HeaderSignature: Sign(subscription.Secret, timestamp, body),
+		}).
+		WithBody(json.RawMessage(body)).
+		IsRawResponse(true).
+		Execute()
+	attempt.DurationMs = time.Since(attempt.CreatedAt).Milliseconds()
+
+	if err == nil && (res.HttpCode < 200 || res.HttpCode > 299) {
+		err = errors.New("receiver answered with status " + strconv.Itoa(res.HttpCode))
+	}
+	if res != nil {
+		attempt.StatusCode = res.HttpCode
+		answer := res.RawResponse
+		if len(answer) > responseBodyLimit {
+			answer = answer[:responseBodyLimit]
+		}
+		attempt.ResponseBody = strings.ToValidUTF8(strings.ReplaceAll(string(answer), "\x00", ""), "")
+	}
+	if err != nil {
+		attempt.Error = err.Error()
+	}
+
+	return attempt
+}
+
+// Sign returns the signature header of a delivery, receivers compute it the same way to authenticate the payload
+func Sign(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// backoff is the delay lib/http would wait after the attempt: the initial delay, doubled after every attempt when
+// WithBackOff is set, or a random delay up to MaxJitter when it is set
+func (m *Module) backoff(attempts int) time.Duration {
+	if m.retry.MaxJitter > time.Millisecond {
+		return time.Duration(rand.Int63n(int64(m.retry.MaxJitter)))
+	}
+
+	delay := m.retry.RetryInitialDelay
+	if m.retry.WithBackOff {
+		delay <<= min(attempts-1, maxBackOffShift)
+	}
+	return delay
+}
@@ -0,0 +1,47 @@
+package webhook
+
+import (
+	"sagara_backend_test/internal/domain/repository"
+	"sagara_backend_test/internal/usecases"
+	libHttp "sagara_backend_test/lib/http"
+	"sagara_backend_test/lib/txmanager"
+	"time"
+)
+
+type Module struct {
+	subscriptionRepo repository.WebhookSubscriptionRepository
+	deliveryRepo     repository.WebhookDeliveryRepository
+	txMgr            txmanager.TxManager
+	client           libHttp.Client
+	timeout          time.Duration
+	retry            libHttp.RetryConfig
+	batchSize        int
+	disableAfter     int
+}
+
+type Opts struct {
+	SubscriptionRepo repository.WebhookSubscriptionRepository
+	DeliveryRepo     repository.WebhookDeliveryRepository
+	TxMgr            txmanager.TxManager
+	Timeout          time.Duration
+	// Retry spaces the attempts of a delivery, MaxRetry is how many attempts are made before it fails
+	Retry     libHttp.RetryConfig
+	BatchSize int
+	// DisableAfter is how many attempts in a row can fail before the subscription is disabled
+	DisableAfter int
+}
+
+func New(opts *Opts) usecases.WebhookUseCases {
+	return &Module{
+		subscriptionRepo: opts.SubscriptionRepo,
+		deliveryRepo:     opts.DeliveryRepo,
+		txMgr:            opts.TxMgr,
+		client: libHttp.NewHttpClient(&libHttp.Options{
+			Timeout: opts.Timeout,
+		}),
+		timeout:      opts.Timeout,
+		retry:        opts.Retry,
+		batchSize:    opts.BatchSize,
+		disableAfter: opts.DisableAfter,
+	}
+}
@@ -0,0 +1,312 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/google/uuid"
+	"sagara_backend_test/internal/domain/model"
+	"sagara_backend_test/internal/interfaces/dao"
+	"sagara_backend_test/internal/usecases/request"
+	"sagara_backend_test/internal/usecases/response"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/response/rest"
+	"sagara_backend_test/lib/tracing"
+	"sagara_backend_test/pkg/constants"
+	"time"
+)
+
+func (m *Module) GetWebhooks(ctx context.Context) (*[]response.WebhookResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookUseCases.GetWebhooks")
+	defer span.End()
+
+	subscriptions, err := m.subscriptionRepo.GetAll(ctx)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[WebhookUseCases.GetWebhooks] Failed to get all webhook")
+		return nil, err
+	}
+
+	webhookResponses := []response.WebhookResponse{}
+	for _, subscription := range *subscriptions {
+		webhookResponses = append(webhookResponses, *toWebhookResponse(&subscription))
+	}
+
+	return &webhookResponses, nil
+}
+
+func (m *Module) GetWebhook(ctx context.Context, id *uuid.UUID) (*response.WebhookResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookUseCases.GetWebhook")
+	defer span.End()
+
+	subscription, err := m.subscriptionRepo.GetById(ctx, id)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WebhookUseCases.GetWebhook] Failed to get webhook")
+		return nil, err
+	}
+
+	return toWebhookResponse(subscription), nil
+}
+
+// InsertWebhook subscribes the url to the events, the secret is only returned here and when it is changed
+func (m *Module) InsertWebhook(ctx context.Context, request *request.WebhookRequest) (*response.WebhookResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookUseCases.InsertWebhook")
+	defer span.End()
+
+	secret := request.Secret
+	if secret == constants.EmptyString {
+		var err error
+		secret, err = newSecret()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	subscription := &model.WebhookSubscription{
+		BaseModel: model.BaseModel{
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		ID:         uuid.New(),
+		URL:        request.URL,
+		EventTypes: request.EventTypes,
+		Secret:     secret,
+		Active:     request.Active == nil || *request.Active,
+	}
+
+	err := m.subscriptionRepo.Insert(ctx, subscription)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"url":   request.URL,
+		}).ErrorWithCtx(ctx, "[WebhookUseCases.InsertWebhook] Failed to insert webhook")
+		return nil, err
+	}
+
+	res := toWebhookResponse(subscription)
+	res.Secret = subscription.Secret
+	return res, nil
+}
+
+// UpdateWebhook replaces the url and the event types, activating a disabled subscription resumes its pending deliveries
+func (m *Module) UpdateWebhook(ctx context.Context, id *uuid.UUID, request *request.WebhookRequest) (*response.WebhookResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookUseCases.UpdateWebhook")
+	defer span.End()
+
+	subscription, err := m.subscriptionRepo.GetById(ctx, id)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WebhookUseCases.UpdateWebhook] Failed to get webhook")
+		return nil, err
+	}
+
+	subscription.URL = request.URL
+	subscription.EventTypes = request.EventTypes
+	if request.Secret != constants.EmptyString {
+		subscription.Secret = request.Secret
+	}
+	if request.Active != nil {
+		if *request.Active && !subscription.Active {
+			subscription.ConsecutiveFailures = 0
+			subscription.DisabledAt = nil
+			subscription.DisabledReason = constants.EmptyString
+		}
+		subscription.Active = *request.Active
+	}
+
+	err = m.subscriptionRepo.Update(ctx, subscription)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WebhookUseCases.UpdateWebhook] Failed to update webhook")
+		return nil, err
+	}
+
+	res := toWebhookResponse(subscription)
+	if request.Secret != constants.EmptyString {
+		res.Secret = subscription.Secret
+	}
+	return res, nil
+}
+
+// DeleteWebhook removes the subscription with its deliveries
+func (m *Module) DeleteWebhook(ctx context.Context, id *uuid.UUID) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookUseCases.DeleteWebhook")
+	defer span.End()
+
+	err := m.subscriptionRepo.Delete(ctx, id)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WebhookUseCases.DeleteWebhook] Failed to delete webhook")
+		return err
+	}
+
+	return nil
+}
+
+// GetDeliveries lists the deliveries of the subscription, the most recent first by default
+func (m *Module) GetDeliveries(ctx context.Context, id *uuid.UUID, pageReq *request.PageRequest) (*[]response.WebhookDeliveryResponse, *rest.Pagination, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookUseCases.GetDeliveries")
+	defer span.End()
+
+	_, err := m.subscriptionRepo.GetById(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if pageReq.Sort == constants.EmptyString {
+		recentReq := *pageReq
+		recentReq.Sort = "-created_at"
+		pageReq = &recentReq
+	}
+
+	page, err := pageReq.ToPage(model.WebhookDeliverySortFields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deliveries, pageInfo, err := m.deliveryRepo.GetBySubscription(ctx, id, page)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WebhookUseCases.GetDeliveries] Failed to get deliveries")
+		return nil, nil, err
+	}
+
+	deliveryResponses := []response.WebhookDeliveryResponse{}
+	for _, delivery := range *deliveries {
+		deliveryResponses = append(deliveryResponses, *toDeliveryResponse(&delivery))
+	}
+
+	return &deliveryResponses, response.NewPagination(page, pageInfo), nil
+}
+
+// GetDelivery returns the delivery with its payload and the log of every attempt
+func (m *Module) GetDelivery(ctx context.Context, id, deliveryId *uuid.UUID) (*response.WebhookDeliveryResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookUseCases.GetDelivery")
+	defer span.End()
+
+	delivery, err := m.getDelivery(ctx, id, deliveryId)
+	if err != nil {
+		return nil, err
+	}
+
+	attempts, err := m.deliveryRepo.GetAttempts(ctx, deliveryId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := toDeliveryResponse(delivery)
+	res.Payload = json.RawMessage(delivery.Payload)
+	for _, attempt := range *attempts {
+		res.AttemptLogs = append(res.AttemptLogs, response.WebhookDeliveryAttemptResponse{
+			Attempt:      attempt.Attempt,
+			StatusCode:   attempt.StatusCode,
+			ResponseBody: attempt.ResponseBody,
+			Error:        attempt.Error,
+			DurationMs:   attempt.DurationMs,
+			CreatedAt:    attempt.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// Redeliver queues the delivery again with a fresh set of attempts, whatever its status
+func (m *Module) Redeliver(ctx context.Context, id, deliveryId *uuid.UUID) (*response.WebhookDeliveryResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookUseCases.Redeliver")
+	defer span.End()
+
+	delivery, err := m.getDelivery(ctx, id, deliveryId)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery.Status = model.WebhookDeliveryPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = time.Now()
+
+	err = m.deliveryRepo.Update(ctx, delivery)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    deliveryId,
+		}).ErrorWithCtx(ctx, "[WebhookUseCases.Redeliver] Failed to redeliver")
+		return nil, err
+	}
+
+	return toDeliveryResponse(delivery), nil
+}
+
+// getDelivery returns ErrNoResult as well when the delivery belongs to another subscription
+func (m *Module) getDelivery(ctx context.Context, id, deliveryId *uuid.UUID) (*model.WebhookDelivery, error) {
+	delivery, err := m.deliveryRepo.GetById(ctx, deliveryId)
+	if err != nil {
+		return nil, err
+	}
+
+	if delivery.SubscriptionID != *id {
+		return nil, dao.ErrNoResult
+	}
+
+	return delivery, nil
+}
+
+// newSecret returns 32 random bytes hex encoded
+func newSecret() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+func toWebhookResponse(subscription *model.WebhookSubscription) *response.WebhookResponse {
+	eventTypes := []string(subscription.EventTypes)
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+
+	return &response.WebhookResponse{
+		ID:                  subscription.ID.String(),
+		URL:                 subscription.URL,
+		EventTypes:          eventTypes,
+		Active:              subscription.Active,
+		ConsecutiveFailures: subscription.ConsecutiveFailures,
+		DisabledAt:          subscription.DisabledAt,
+		DisabledReason:      subscription.DisabledReason,
+		CreatedAt:           subscription.CreatedAt,
+		UpdatedAt:           subscription.UpdatedAt,
+	}
+}
+
+func toDeliveryResponse(delivery *model.WebhookDelivery) *response.WebhookDeliveryResponse {
+	res := &response.WebhookDeliveryResponse{
+		ID:          delivery.ID.String(),
+		WebhookID:   delivery.SubscriptionID.String(),
+		EventID:     delivery.EventID.String(),
+		EventType:   delivery.EventType,
+		Status:      delivery.Status,
+		Attempts:    delivery.Attempts,
+		DeliveredAt: delivery.DeliveredAt,
+		LastError:   delivery.LastError,
+		CreatedAt:   delivery.CreatedAt,
+	}
+	if delivery.Status == model.WebhookDeliveryPending {
+		res.NextAttemptAt = &delivery.NextAttemptAt
+	}
+	return res
+}
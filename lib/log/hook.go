@@ -2,14 +2,13 @@ package log
 
 import (
 	"github.com/rs/zerolog"
+	"sagara_backend_test/lib/tracing"
 )
 
 type TracingHook struct{}
 
 func (h TracingHook) Run(e *zerolog.Event, level zerolog.Level, msg string) {
-	var requestId string
-
-	_ = e.GetCtx()
+	requestId := tracing.RequestIDFromContext(e.GetCtx())
 
 	e.Str("request-id", requestId)
 }
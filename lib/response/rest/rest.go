@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"github.com/gofiber/fiber/v2"
 	"io"
+	"mime"
 	"net/http"
 	"sagara_backend_test/lib/custerr"
 	"sagara_backend_test/lib/log"
@@ -38,6 +39,12 @@ func GetErrorCode(err error) int {
 		return http.StatusConflict
 	case response.ErrRequestTooLarge:
 		return http.StatusRequestEntityTooLarge
+	case response.ErrPreconditionFailed:
+		return http.StatusPreconditionFailed
+	case response.ErrPreconditionRequired:
+		return http.StatusPreconditionRequired
+	case response.ErrTooManyRequest:
+		return http.StatusTooManyRequests
 	case nil:
 		return http.StatusOK
 	default:
@@ -86,10 +93,20 @@ type Response interface {
 }
 
 type JSONResponse struct {
-	Data    any            `json:"data,omitempty"`
-	Code    int            `json:"code,omitempty"`
-	Message string         `json:"message,omitempty"`
-	Error   *ErrorResponse `json:"error,omitempty"`
+	Data       any            `json:"data,omitempty"`
+	Pagination *Pagination    `json:"pagination,omitempty"`
+	Code       int            `json:"code,omitempty"`
+	Message    string         `json:"message,omitempty"`
+	Error      *ErrorResponse `json:"error,omitempty"`
+	headers    map[string]string
+}
+
+// Pagination describes a page of a list response, NextCursor is empty on the last page
+// and Total is only filled when it was requested
+type Pagination struct {
+	Limit      int    `json:"limit"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	Total      *int64 `json:"total,omitempty"`
 }
 
 type AttachmentResponse struct {
@@ -112,6 +129,11 @@ func (r *JSONResponse) SetData(data any) *JSONResponse {
 	return r
 }
 
+func (r *JSONResponse) SetPagination(pagination *Pagination) *JSONResponse {
+	r.Pagination = pagination
+	return r
+}
+
 func (r *JSONResponse) SetCode(code int) *JSONResponse {
 	r.Code = code
 	return r
@@ -122,6 +144,15 @@ func (r *JSONResponse) SetMessage(msg string) *JSONResponse {
 	return r
 }
 
+// SetHeader add response header that will be written along with the body
+func (r *JSONResponse) SetHeader(key, value string) *JSONResponse {
+	if r.headers == nil {
+		r.headers = make(map[string]string)
+	}
+	r.headers[key] = value
+	return r
+}
+
 func (r *JSONResponse) SetError(err error) *JSONResponse {
 	custErr := getCustErr(err)
 	r.Code = GetErrorCode(custErr)
@@ -134,8 +165,16 @@ func (r *JSONResponse) SetError(err error) *JSONResponse {
 }
 
 func (r JSONResponse) Send(c *fiber.Ctx) error {
+	for key, value := range r.headers {
+		c.Response().Header.Set(key, value)
+	}
 
 	c.Response().SetStatusCode(r.Code)
+	if r.Code == http.StatusNotModified {
+		// 304 must not carry a body
+		return nil
+	}
+
 	c.Response().Header.Add("Content-Type", "application/json")
 
 	err := json.NewEncoder(c.Response().BodyWriter()).Encode(r)
@@ -152,3 +191,40 @@ func (r JSONResponse) Send(c *fiber.Ctx) error {
 func NewAttachmentResponse() *AttachmentResponse {
 	return &AttachmentResponse{}
 }
+
+func (r *AttachmentResponse) SetFile(file io.Reader) *AttachmentResponse {
+	r.File = file
+	return r
+}
+
+func (r *AttachmentResponse) SetFileName(fileName string) *AttachmentResponse {
+	r.FileName = fileName
+	return r
+}
+
+func (r *AttachmentResponse) SetContentType(contentType string) *AttachmentResponse {
+	r.ContentType = contentType
+	return r
+}
+
+// Send streams the file as the body, a file that is an io.Closer is closed once it has been sent
+func (r AttachmentResponse) Send(c *fiber.Ctx) error {
+	contentType := r.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	c.Response().SetStatusCode(http.StatusOK)
+	c.Response().Header.SetContentType(contentType)
+	if r.FileName != "" {
+		c.Response().Header.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": r.FileName}))
+	}
+
+	if r.File == nil {
+		return nil
+	}
+
+	// an unknown size makes the body chunked, so the file is never held in memory
+	c.Response().SetBodyStream(r.File, -1)
+	return nil
+}
@@ -0,0 +1,140 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/response"
+	"slices"
+	"strings"
+)
+
+// ErrNoCredentials is returned by an Authenticator when the request carries no credentials it knows
+var ErrNoCredentials = errors.New("no credentials")
+
+type (
+	// Authenticator verifies the credentials of a request and returns the claims they carry
+	Authenticator interface {
+		Authenticate(ctx context.Context, req *Request) (*Claims, error)
+	}
+
+	// Claims are what the router verified about the caller of a protected route
+	Claims struct {
+		Subject string
+		Scopes  []string
+		// Role and Permissions are what the caller is trusted with, they are filled by the authenticator
+		Role        string
+		Permissions []string
+		// Raw holds every claim of the credentials, as they were decoded
+		Raw map[string]any
+		// Unrestricted claims pass every scope and permission check
+		Unrestricted bool
+	}
+
+	anonymousAuthenticator struct{}
+
+	chainAuthenticator []Authenticator
+
+	claimsContextKey struct{}
+)
+
+// AllowAnonymous lets every request through protected routes with unrestricted claims, it is meant for local development
+var AllowAnonymous Authenticator = anonymousAuthenticator{}
+
+func (anonymousAuthenticator) Authenticate(context.Context, *Request) (*Claims, error) {
+	return &Claims{Unrestricted: true}, nil
+}
+
+// Chain asks the authenticators in turn, the first one finding credentials in the request decides
+func Chain(authenticators ...Authenticator) Authenticator {
+	return chainAuthenticator(authenticators)
+}
+
+func (c chainAuthenticator) Authenticate(ctx context.Context, req *Request) (*Claims, error) {
+	for _, authenticator := range c {
+		claims, err := authenticator.Authenticate(ctx, req)
+		if errors.Is(err, ErrNoCredentials) {
+			continue
+		}
+		return claims, err
+	}
+	return nil, ErrNoCredentials
+}
+
+func (c *Claims) HasScope(scope string) bool {
+	return c.Unrestricted || slices.Contains(c.Scopes, scope)
+}
+
+func (c *Claims) HasPermission(permission string) bool {
+	return c.Unrestricted || slices.Contains(c.Permissions, permission)
+}
+
+func ContextWithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsContextKey{}, claims)
+}
+
+// ClaimsFromContext returns the claims verified for the request, false on public routes
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsContextKey{}).(*Claims)
+	return claims, ok
+}
+
+// BearerToken returns the token of the Authorization header, empty when there is no bearer token
+func (r *Request) BearerToken() string {
+	scheme, token, found := strings.Cut(r.Header("Authorization"), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+// authorize verifies the request with the authenticator of the router and checks the scopes and the permissions the
+// route requires.
+// A router without an authenticator refuses every protected route.
+func (jr *FastRouter) authorize(ctx context.Context, req *Request, opts ...Option) (context.Context, error) {
+	if jr.Options.Authenticator == nil {
+		return nil, errUnauthorized
+	}
+
+	claims, err := jr.Options.Authenticator.Authenticate(ctx, req)
+	if errors.Is(err, ErrNoCredentials) {
+		return nil, errUnauthorized
+	}
+	var custErr *custerr.ErrChain
+	if errors.As(err, &custErr) {
+		// the authenticator already told how to answer
+		return nil, err
+	}
+	if err != nil {
+		return nil, &custerr.ErrChain{
+			Message: errUnauthorized.Message,
+			Cause:   err,
+			Code:    http.StatusUnauthorized,
+			Type:    response.ErrUnauthorized,
+		}
+	}
+
+	for _, scope := range requiredScopes(opts...) {
+		if !claims.HasScope(scope) {
+			return nil, &custerr.ErrChain{
+				Message: fmt.Sprintf("missing scope %s", scope),
+				Code:    http.StatusForbidden,
+				Type:    response.ErrForbiddenResource,
+			}
+		}
+	}
+
+	for _, permission := range requiredPermissions(opts...) {
+		if !claims.HasPermission(permission) {
+			return nil, &custerr.ErrChain{
+				Message: fmt.Sprintf("missing permission %s", permission),
+				Code:    http.StatusForbidden,
+				Type:    response.ErrForbiddenResource,
+			}
+		}
+	}
+
+	return ContextWithClaims(ctx, claims), nil
+}
@@ -0,0 +1,129 @@
+package router
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/gofiber/fiber/v2"
+	"net/http"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/response"
+	"strings"
+)
+
+const (
+	HeaderIdempotencyKey = "Idempotency-Key"
+	// HeaderIdempotentReplayed marks the responses replayed from the idempotency store
+	HeaderIdempotentReplayed = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+)
+
+type (
+	// StoredResponse is the response kept for an idempotency key
+	StoredResponse struct {
+		StatusCode  int
+		ContentType string
+		Body        []byte
+	}
+
+	// IdempotencyStore keeps the idempotency keys with the fingerprint of their request and its response. A retry can
+	// reach any instance, so the store has to be shared by all of them.
+	IdempotencyStore interface {
+		// Begin claims the key for the request of the fingerprint and returns the stored response when the request was
+		// already answered. Its errors are answered as they are, such as the conflict of a key used for another request.
+		Begin(ctx context.Context, key, fingerprint string) (*StoredResponse, error)
+		// Complete stores the response of the request that claimed the key
+		Complete(ctx context.Context, key string, res *StoredResponse) error
+		// Release gives the key up without a response, the request can be sent again
+		Release(ctx context.Context, key string) error
+	}
+)
+
+// beginIdempotent claims the Idempotency-Key of a request to an idempotent route, the key is empty when the request did
+// not send one. A request that was already answered is replayed from the store and replayed is true.
+func (jr *FastRouter) beginIdempotent(ctx *fiber.Ctx, opts ...Option) (key string, replayed bool, err error) {
+	if !isIdempotent(opts...) || jr.Options.IdempotencyStore == nil {
+		return "", false, nil
+	}
+
+	header := strings.TrimSpace(ctx.Get(HeaderIdempotencyKey))
+	if header == "" {
+		return "", false, nil
+	}
+	if len(header) > maxIdempotencyKeyLength {
+		return "", false, &custerr.ErrChain{
+			Message: "idempotency key is longer than 255 characters",
+			Code:    http.StatusBadRequest,
+			Type:    response.ErrBadRequest,
+		}
+	}
+
+	// two callers can pick the same key, each one has its own keys
+	key = header
+	if claims, ok := ClaimsFromContext(ctx.UserContext()); ok && claims.Subject != "" {
+		key = claims.Subject + "|" + header
+	}
+
+	stored, err := jr.Options.IdempotencyStore.Begin(ctx.UserContext(), key, fingerprint(ctx))
+	if err != nil {
+		return "", false, err
+	}
+	if stored != nil {
+		ctx.Set(HeaderIdempotentReplayed, "true")
+		ctx.Set(fiber.HeaderContentType, stored.ContentType)
+		return "", true, ctx.Status(stored.StatusCode).Send(stored.Body)
+	}
+
+	return key, false, nil
+}
+
+// completeIdempotent renders the outcome of the request right away so it can be stored with the key, the error handler
+// is not called again afterwards. Server errors and timeouts are not final, they release the key for a retry. A handler
+// still running after a timeout keeps the key, completeAbandoned completes it once the handler returns.
+func (jr *FastRouter) completeIdempotent(ctx *fiber.Ctx, key string, err error) error {
+	if err != nil {
+		err = jr.app.ErrorHandler(ctx, err)
+	}
+
+	// the request may have timed out, what it left must still be stored
+	storeCtx := context.WithoutCancel(ctx.UserContext())
+
+	status := ctx.Response().StatusCode()
+	if err != nil || status >= http.StatusInternalServerError || status == http.StatusRequestTimeout {
+		if releaseErr := jr.Options.IdempotencyStore.Release(storeCtx, key); releaseErr != nil {
+			log.WithFields(log.Fields{
+				"error": releaseErr,
+				"key":   key,
+			}).WarnWithCtx(storeCtx, "[router.completeIdempotent] Failed to release idempotency key, it is held until its lock expires")
+		}
+		return err
+	}
+
+	err = jr.Options.IdempotencyStore.Complete(storeCtx, key, &StoredResponse{
+		StatusCode:  status,
+		ContentType: string(ctx.Response().Header.ContentType()),
+		Body:        bytes.Clone(ctx.Response().Body()),
+	})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"key":   key,
+		}).WarnWithCtx(storeCtx, "[router.completeIdempotent] Failed to store the response of idempotency key, it is held until its lock expires")
+	}
+
+	return nil
+}
+
+// fingerprint tells the requests apart by their method, their URL and their body
+func fingerprint(ctx *fiber.Ctx) string {
+	hash := sha256.New()
+	hash.Write([]byte(ctx.Method()))
+	hash.Write([]byte{0})
+	hash.Write([]byte(ctx.OriginalURL()))
+	hash.Write([]byte{0})
+	hash.Write(ctx.Body())
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,240 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/response"
+	"sagara_backend_test/lib/response/rest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type (
+	// memoryIdempotencyStore answers the way the database store does
+	memoryIdempotencyStore struct {
+		mu   sync.Mutex
+		keys map[string]*storedKey
+	}
+
+	storedKey struct {
+		fingerprint string
+		response    *StoredResponse
+	}
+)
+
+func (s *memoryIdempotencyStore) Begin(_ context.Context, key, fingerprint string) (*StoredResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.keys[key]
+	if !ok {
+		s.keys[key] = &storedKey{fingerprint: fingerprint}
+		return nil, nil
+	}
+	if stored.fingerprint != fingerprint {
+		return nil, &custerr.ErrChain{Message: "key reused", Code: 40914, Type: response.ErrConflict}
+	}
+	if stored.response == nil {
+		return nil, &custerr.ErrChain{Message: "key in progress", Code: 40915, Type: response.ErrConflict}
+	}
+	return stored.response, nil
+}
+
+func (s *memoryIdempotencyStore) Complete(_ context.Context, key string, res *StoredResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys[key].response = res
+	return nil
+}
+
+func (s *memoryIdempotencyStore) Release(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.keys, key)
+	return nil
+}
+
+func (s *memoryIdempotencyStore) has(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.keys[key]
+	return ok
+}
+
+func (s *memoryIdempotencyStore) completed(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.keys[key]
+	return ok && stored.response != nil
+}
+
+func TestIdempotent(t *testing.T) {
+	var calls atomic.Int32
+	handler := func(_ context.Context, req *Request) (*rest.JSONResponse, error) {
+		call := calls.Add(1)
+		switch string(req.RawBody()) {
+		case "invalid":
+			return nil, &custerr.ErrChain{Message: "invalid", Code: 40001, Type: response.ErrBadRequest}
+		case "fail":
+			return nil, errors.New("database is down")
+		}
+		return rest.NewJSONResponse().SetData(call), nil
+	}
+	// the request is reused once the route timed out, a handler still running must not read it
+	var slowCalls atomic.Int32
+	slowHandler := func(context.Context, *Request) (*rest.JSONResponse, error) {
+		call := slowCalls.Add(1)
+		time.Sleep(200 * time.Millisecond)
+		return rest.NewJSONResponse().SetData(call), nil
+	}
+
+	store := &memoryIdempotencyStore{keys: map[string]*storedKey{}}
+	r := New(&Options{
+		Authenticator: tokenAuthenticator{
+			"alice": {Subject: "alice", Unrestricted: true},
+			"bob":   {Subject: "bob", Unrestricted: true},
+		},
+		RequestTimeout:   50 * time.Millisecond,
+		IdempotencyStore: store,
+	})
+	r.POST("/items", handler, Idempotent())
+	r.POST("/slow", slowHandler, Idempotent())
+	r.POST("/plain", handler)
+
+	type result struct {
+		status   int
+		body     string
+		replayed bool
+	}
+	send := func(path, token, key, body string) result {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		if key != "" {
+			req.Header.Set(HeaderIdempotencyKey, key)
+		}
+
+		resp, err := r.Test(req, 2000)
+		require.NoError(t, err)
+		raw, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return result{
+			status:   resp.StatusCode,
+			body:     string(raw),
+			replayed: resp.Header.Get(HeaderIdempotentReplayed) == "true",
+		}
+	}
+
+	t.Run("repeat is replayed without running the handler", func(t *testing.T) {
+		calls.Store(0)
+		first := send("/items", "alice", "create-1", "shirt")
+		second := send("/items", "alice", "create-1", "shirt")
+
+		assert.Equal(t, http.StatusOK, first.status)
+		assert.False(t, first.replayed)
+		assert.Equal(t, first.status, second.status)
+		assert.Equal(t, first.body, second.body)
+		assert.True(t, second.replayed)
+		assert.EqualValues(t, 1, calls.Load())
+	})
+
+	t.Run("same key with another body is a conflict", func(t *testing.T) {
+		calls.Store(0)
+		send("/items", "alice", "create-2", "shirt")
+		res := send("/items", "alice", "create-2", "trousers")
+
+		assert.Equal(t, http.StatusConflict, res.status)
+		assert.Contains(t, res.body, "40914")
+		assert.EqualValues(t, 1, calls.Load())
+	})
+
+	t.Run("same key on another url is a conflict", func(t *testing.T) {
+		send("/items", "alice", "create-3", "shirt")
+		res := send("/items?dry_run=true", "alice", "create-3", "shirt")
+
+		assert.Equal(t, http.StatusConflict, res.status)
+	})
+
+	t.Run("client errors are final and replayed", func(t *testing.T) {
+		calls.Store(0)
+		first := send("/items", "alice", "create-4", "invalid")
+		second := send("/items", "alice", "create-4", "invalid")
+
+		assert.Equal(t, http.StatusBadRequest, first.status)
+		assert.Equal(t, first.body, second.body)
+		assert.True(t, second.replayed)
+		assert.EqualValues(t, 1, calls.Load())
+	})
+
+	t.Run("server errors release the key", func(t *testing.T) {
+		calls.Store(0)
+		first := send("/items", "alice", "create-5", "fail")
+		assert.Equal(t, http.StatusInternalServerError, first.status)
+		assert.False(t, store.has("alice|create-5"))
+
+		second := send("/items", "alice", "create-5", "fail")
+		assert.Equal(t, http.StatusInternalServerError, second.status)
+		assert.False(t, second.replayed)
+		assert.EqualValues(t, 2, calls.Load())
+	})
+
+	t.Run("timeouts keep the key until the handler is done", func(t *testing.T) {
+		first := send("/slow", "alice", "create-6", "shirt")
+		assert.Equal(t, http.StatusRequestTimeout, first.status)
+
+		retry := send("/slow", "alice", "create-6", "shirt")
+		assert.Equal(t, http.StatusConflict, retry.status)
+		assert.Contains(t, retry.body, "40915")
+
+		require.Eventually(t, func() bool { return store.completed("alice|create-6") }, 2*time.Second, 10*time.Millisecond)
+		replay := send("/slow", "alice", "create-6", "shirt")
+		assert.Equal(t, http.StatusOK, replay.status)
+		assert.True(t, replay.replayed)
+		assert.JSONEq(t, `{"code":200,"data":1}`, replay.body)
+		assert.EqualValues(t, 1, slowCalls.Load())
+	})
+
+	t.Run("keys belong to their caller", func(t *testing.T) {
+		calls.Store(0)
+		alice := send("/items", "alice", "create-7", "shirt")
+		bob := send("/items", "bob", "create-7", "trousers")
+
+		assert.Equal(t, http.StatusOK, alice.status)
+		assert.Equal(t, http.StatusOK, bob.status)
+		assert.False(t, bob.replayed)
+		assert.EqualValues(t, 2, calls.Load())
+	})
+
+	t.Run("requests without a key always run", func(t *testing.T) {
+		calls.Store(0)
+		send("/items", "alice", "", "shirt")
+		res := send("/items", "alice", "", "shirt")
+
+		assert.False(t, res.replayed)
+		assert.EqualValues(t, 2, calls.Load())
+	})
+
+	t.Run("routes that are not idempotent ignore the key", func(t *testing.T) {
+		calls.Store(0)
+		send("/plain", "alice", "create-8", "shirt")
+		res := send("/plain", "alice", "create-8", "shirt")
+
+		assert.False(t, res.replayed)
+		assert.False(t, store.has("alice|create-8"))
+		assert.EqualValues(t, 2, calls.Load())
+	})
+
+	t.Run("too long a key is refused", func(t *testing.T) {
+		res := send("/items", "alice", strings.Repeat("k", maxIdempotencyKeyLength+1), "shirt")
+
+		assert.Equal(t, http.StatusBadRequest, res.status)
+	})
+}
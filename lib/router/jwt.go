@@ -0,0 +1,161 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/MicahParks/keyfunc/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"os"
+	"sagara_backend_test/lib/log"
+	"strings"
+	"time"
+)
+
+type (
+	// JWTConfig tells how the bearer tokens are verified, at least a Secret or a JWKS is needed
+	JWTConfig struct {
+		// Secret verifies the HS256 tokens
+		Secret string
+		// JWKSFile and JWKSURL hold the public keys verifying the RS256 and ES256 tokens, the URL is fetched again
+		// every JWKSRefresh and when a token is signed with a key it does not know yet
+		JWKSFile    string
+		JWKSURL     string
+		JWKSRefresh time.Duration
+		// Issuer and Audience are checked when they are set
+		Issuer   string
+		Audience string
+		// Leeway is the clock skew allowed on the expiry and not before times
+		Leeway time.Duration
+	}
+
+	JWTAuthenticator struct {
+		secret []byte
+		jwks   *keyfunc.JWKS
+		parser *jwt.Parser
+	}
+)
+
+func NewJWTAuthenticator(cfg *JWTConfig) (*JWTAuthenticator, error) {
+	authenticator := &JWTAuthenticator{}
+
+	var methods []string
+	if cfg.Secret != "" {
+		authenticator.secret = []byte(cfg.Secret)
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+
+	var err error
+	switch {
+	case cfg.JWKSFile != "" && cfg.JWKSURL != "":
+		return nil, errors.New("jwks file and url can not be both set")
+	case cfg.JWKSFile != "":
+		var jwksJSON []byte
+		jwksJSON, err = os.ReadFile(cfg.JWKSFile)
+		if err != nil {
+			return nil, fmt.Errorf("read jwks file: %w", err)
+		}
+		authenticator.jwks, err = keyfunc.NewJSON(jwksJSON)
+	case cfg.JWKSURL != "":
+		authenticator.jwks, err = keyfunc.Get(cfg.JWKSURL, keyfunc.Options{
+			RefreshInterval:   cfg.JWKSRefresh,
+			RefreshRateLimit:  time.Minute,
+			RefreshTimeout:    10 * time.Second,
+			RefreshUnknownKID: true,
+			RefreshErrorHandler: func(err error) {
+				log.WithFields(log.Fields{
+					"error": err,
+					"url":   cfg.JWKSURL,
+				}).Error("[router.JWTAuthenticator] Failed to refresh jwks")
+			},
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load jwks: %w", err)
+	}
+	if authenticator.jwks != nil {
+		methods = append(methods, jwt.SigningMethodRS256.Alg(), jwt.SigningMethodES256.Alg())
+	}
+
+	if len(methods) == 0 {
+		return nil, errors.New("a secret or a jwks is needed to verify tokens")
+	}
+
+	parserOpts := []jwt.ParserOption{
+		jwt.WithValidMethods(methods),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(cfg.Leeway),
+	}
+	if cfg.Issuer != "" {
+		parserOpts = append(parserOpts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		parserOpts = append(parserOpts, jwt.WithAudience(cfg.Audience))
+	}
+	authenticator.parser = jwt.NewParser(parserOpts...)
+
+	return authenticator, nil
+}
+
+// Authenticate verifies the bearer token of the request, ErrNoCredentials is returned when there is none
+func (a *JWTAuthenticator) Authenticate(_ context.Context, req *Request) (*Claims, error) {
+	tokenStr := req.BearerToken()
+	if tokenStr == "" {
+		return nil, ErrNoCredentials
+	}
+
+	mapClaims := jwt.MapClaims{}
+	_, err := a.parser.ParseWithClaims(tokenStr, mapClaims, a.key)
+	if err != nil {
+		return nil, err
+	}
+
+	subject, err := mapClaims.GetSubject()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Claims{
+		Subject: subject,
+		Scopes:  tokenScopes(mapClaims),
+		Raw:     mapClaims,
+	}, nil
+}
+
+// Close stops the refresh of the jwks fetched from a url
+func (a *JWTAuthenticator) Close() {
+	if a.jwks != nil {
+		a.jwks.EndBackground()
+	}
+}
+
+// key picks the key by the algorithm of the token so an HS256 token is never checked against a public key,
+// the allowed algorithms are already enforced by the parser
+func (a *JWTAuthenticator) key(token *jwt.Token) (any, error) {
+	if token.Method.Alg() == jwt.SigningMethodHS256.Alg() {
+		return a.secret, nil
+	}
+	return a.jwks.Keyfunc(token)
+}
+
+// tokenScopes reads the space separated scope claim, or the scp claim some issuers use instead
+func tokenScopes(claims jwt.MapClaims) []string {
+	if scope, ok := claims["scope"].(string); ok {
+		return strings.Fields(scope)
+	}
+
+	switch scp := claims["scp"].(type) {
+	case string:
+		return strings.Fields(scp)
+	case []any:
+		var scopes []string
+		for _, s := range scp {
+			if str, ok := s.(string); ok {
+				scopes = append(scopes, str)
+			}
+		}
+		return scopes
+	}
+
+	return nil
+}
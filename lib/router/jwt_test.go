@@ -0,0 +1,237 @@
+package router
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const testSecret = "test-secret"
+
+func bearerRequest(token string) *Request {
+	req := &fasthttp.Request{}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return newRequest(&requestOptions{Req: req})
+}
+
+func signHS(t *testing.T, method jwt.SigningMethod, key any, claims jwt.MapClaims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+	require.NoError(t, err)
+	return token
+}
+
+// writeJWKS writes the public half of the key as a jwks file and returns its path
+func writeJWKS(t *testing.T, key *rsa.PrivateKey, kid string) string {
+	t.Helper()
+	jwks := map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": kid,
+			"alg": "RS256",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	}
+	raw, err := json.Marshal(jwks)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, raw, 0o600))
+	return path
+}
+
+func TestJWTAuthenticator_Authenticate(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	now := time.Now()
+	valid := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"sub":   "user-1",
+			"iss":   "issuer",
+			"aud":   "api",
+			"exp":   now.Add(time.Hour).Unix(),
+			"scope": "wardrobe:read wardrobe:write",
+		}
+	}
+	with := func(key string, value any) jwt.MapClaims {
+		claims := valid()
+		if value == nil {
+			delete(claims, key)
+		} else {
+			claims[key] = value
+		}
+		return claims
+	}
+	rs256 := func(key *rsa.PrivateKey, kid string, claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = kid
+		signed, err := token.SignedString(key)
+		require.NoError(t, err)
+		return signed
+	}
+
+	hsCfg := &JWTConfig{Secret: testSecret, Issuer: "issuer", Audience: "api", Leeway: 30 * time.Second}
+	rsCfg := &JWTConfig{JWKSFile: writeJWKS(t, rsaKey, "k1"), Issuer: "issuer", Audience: "api"}
+
+	tests := []struct {
+		name    string
+		cfg     *JWTConfig
+		token   string
+		wantErr error
+		noCreds bool
+	}{
+		{
+			name:  "valid hs256",
+			cfg:   hsCfg,
+			token: signHS(t, jwt.SigningMethodHS256, []byte(testSecret), valid()),
+		},
+		{
+			name:  "valid rs256",
+			cfg:   rsCfg,
+			token: rs256(rsaKey, "k1", valid()),
+		},
+		{
+			name:    "no token",
+			cfg:     hsCfg,
+			noCreds: true,
+		},
+		{
+			name:    "wrong secret",
+			cfg:     hsCfg,
+			token:   signHS(t, jwt.SigningMethodHS256, []byte("other"), valid()),
+			wantErr: jwt.ErrTokenSignatureInvalid,
+		},
+		{
+			name:    "algorithm not allowed",
+			cfg:     hsCfg,
+			token:   signHS(t, jwt.SigningMethodHS384, []byte(testSecret), valid()),
+			wantErr: jwt.ErrTokenSignatureInvalid,
+		},
+		{
+			name:    "none algorithm",
+			cfg:     hsCfg,
+			token:   signHS(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, valid()),
+			wantErr: jwt.ErrTokenSignatureInvalid,
+		},
+		{
+			name:    "hs256 when only a jwks is configured",
+			cfg:     rsCfg,
+			token:   signHS(t, jwt.SigningMethodHS256, []byte(testSecret), valid()),
+			wantErr: jwt.ErrTokenSignatureInvalid,
+		},
+		{
+			name:    "rs256 when only a secret is configured",
+			cfg:     hsCfg,
+			token:   rs256(rsaKey, "k1", valid()),
+			wantErr: jwt.ErrTokenSignatureInvalid,
+		},
+		{
+			name:    "rs256 signed by an unknown key",
+			cfg:     rsCfg,
+			token:   rs256(otherKey, "k1", valid()),
+			wantErr: jwt.ErrTokenSignatureInvalid,
+		},
+		{
+			name:    "wrong issuer",
+			cfg:     hsCfg,
+			token:   signHS(t, jwt.SigningMethodHS256, []byte(testSecret), with("iss", "someone-else")),
+			wantErr: jwt.ErrTokenInvalidIssuer,
+		},
+		{
+			name:    "wrong audience",
+			cfg:     hsCfg,
+			token:   signHS(t, jwt.SigningMethodHS256, []byte(testSecret), with("aud", "other-api")),
+			wantErr: jwt.ErrTokenInvalidAudience,
+		},
+		{
+			name:    "missing expiry",
+			cfg:     hsCfg,
+			token:   signHS(t, jwt.SigningMethodHS256, []byte(testSecret), with("exp", nil)),
+			wantErr: jwt.ErrTokenRequiredClaimMissing,
+		},
+		{
+			name:  "expired within the leeway",
+			cfg:   hsCfg,
+			token: signHS(t, jwt.SigningMethodHS256, []byte(testSecret), with("exp", now.Add(-10*time.Second).Unix())),
+		},
+		{
+			name:    "expired past the leeway",
+			cfg:     hsCfg,
+			token:   signHS(t, jwt.SigningMethodHS256, []byte(testSecret), with("exp", now.Add(-time.Minute).Unix())),
+			wantErr: jwt.ErrTokenExpired,
+		},
+		{
+			name:    "not valid yet past the leeway",
+			cfg:     hsCfg,
+			token:   signHS(t, jwt.SigningMethodHS256, []byte(testSecret), with("nbf", now.Add(time.Minute).Unix())),
+			wantErr: jwt.ErrTokenNotValidYet,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authenticator, err := NewJWTAuthenticator(tt.cfg)
+			require.NoError(t, err)
+			defer authenticator.Close()
+
+			claims, err := authenticator.Authenticate(context.Background(), bearerRequest(tt.token))
+			switch {
+			case tt.noCreds:
+				assert.ErrorIs(t, err, ErrNoCredentials)
+			case tt.wantErr != nil:
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, claims)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, "user-1", claims.Subject)
+				assert.Equal(t, []string{"wardrobe:read", "wardrobe:write"}, claims.Scopes)
+			}
+		})
+	}
+}
+
+func TestNewJWTAuthenticator_Config(t *testing.T) {
+	_, err := NewJWTAuthenticator(&JWTConfig{})
+	assert.Error(t, err, "a secret or a jwks is needed")
+
+	_, err = NewJWTAuthenticator(&JWTConfig{JWKSFile: "a.json", JWKSURL: "http://localhost"})
+	assert.Error(t, err, "the jwks file and url are exclusive")
+}
+
+func TestTokenScopes(t *testing.T) {
+	tests := []struct {
+		name   string
+		claims jwt.MapClaims
+		want   []string
+	}{
+		{name: "scope string", claims: jwt.MapClaims{"scope": "a b"}, want: []string{"a", "b"}},
+		{name: "scp string", claims: jwt.MapClaims{"scp": "a b"}, want: []string{"a", "b"}},
+		{name: "scp list", claims: jwt.MapClaims{"scp": []any{"a", 1, "b"}}, want: []string{"a", "b"}},
+		{name: "scope wins over scp", claims: jwt.MapClaims{"scope": "a", "scp": "b"}, want: []string{"a"}},
+		{name: "none", claims: jwt.MapClaims{}, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tokenScopes(tt.claims))
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package router
+
+import (
+	"context"
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"net/http"
+	"sagara_backend_test/lib/custerr"
+	"sagara_backend_test/lib/log"
+	"sagara_backend_test/lib/response"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	HeaderRateLimitLimit     = "RateLimit-Limit"
+	HeaderRateLimitRemaining = "RateLimit-Remaining"
+	HeaderRateLimitReset     = "RateLimit-Reset"
+	HeaderRateLimitPolicy    = "RateLimit-Policy"
+
+	// rateLimitSweepInterval is how often the memory store forgets the buckets that are full again
+	rateLimitSweepInterval = time.Minute
+)
+
+type (
+	// RateLimit is a token bucket, Burst requests can be made at once and the bucket refills at Limit requests per
+	// Period. A Limit or a Period of zero does not limit.
+	RateLimit struct {
+		Limit  int
+		Period time.Duration
+		// Burst is Limit when empty
+		Burst int
+	}
+
+	// RateLimitResult is the bucket once the request took its token
+	RateLimitResult struct {
+		Allowed   bool
+		Remaining int
+		// RetryAfter is how long until a token is back, it is only set when the request is not allowed
+		RetryAfter time.Duration
+		// Reset is how long until the bucket is full again
+		Reset time.Duration
+	}
+
+	// RateLimitStore keeps the buckets of the callers. The memory one only counts the requests of its own instance,
+	// instances sharing their limits need a store every one of them reaches, taking the tokens atomically.
+	RateLimitStore interface {
+		Take(ctx context.Context, key string, limit RateLimit) (*RateLimitResult, error)
+	}
+
+	memoryRateLimitStore struct {
+		mu        sync.Mutex
+		buckets   map[string]*tokenBucket
+		lastSweep time.Time
+		now       func() time.Time
+	}
+
+	tokenBucket struct {
+		tokens    float64
+		updatedAt time.Time
+		fullAt    time.Time
+	}
+)
+
+func (l RateLimit) enabled() bool {
+	return l.Limit > 0 && l.Period > 0
+}
+
+func (l RateLimit) burst() int {
+	if l.Burst > 0 {
+		return l.Burst
+	}
+	return l.Limit
+}
+
+// NewMemoryRateLimitStore keeps the buckets in the memory of the instance, which is enough for a single instance
+func NewMemoryRateLimitStore() RateLimitStore {
+	return &memoryRateLimitStore{buckets: map[string]*tokenBucket{}, now: time.Now}
+}
+
+func (s *memoryRateLimitStore) Take(_ context.Context, key string, limit RateLimit) (*RateLimitResult, error) {
+	now := s.now()
+	burst := float64(limit.burst())
+	// tokens refilled per nanosecond
+	rate := float64(limit.Limit) / float64(limit.Period)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+
+	bucket, ok := s.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{tokens: burst, updatedAt: now}
+		s.buckets[key] = bucket
+	}
+	bucket.tokens = min(burst, bucket.tokens+float64(now.Sub(bucket.updatedAt))*rate)
+	bucket.updatedAt = now
+
+	res := &RateLimitResult{}
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		res.Allowed = true
+	} else {
+		res.RetryAfter = time.Duration((1 - bucket.tokens) / rate)
+	}
+	res.Remaining = int(bucket.tokens)
+	res.Reset = time.Duration((burst - bucket.tokens) / rate)
+	bucket.fullAt = now.Add(res.Reset)
+
+	return res, nil
+}
+
+// sweep forgets the buckets that are full again, a caller coming back starts with a full bucket anyway
+func (s *memoryRateLimitStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	s.lastSweep = now
+
+	for key, bucket := range s.buckets {
+		if !now.Before(bucket.fullAt) {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+// rateLimitIP takes a token from the bucket of the IP before the credentials of the request are checked, the buckets of
+// the callers only see the requests that got through authentication, so guessing keys or tokens would go unlimited
+func (jr *FastRouter) rateLimitIP(ctx *fiber.Ctx, route string) error {
+	limit := jr.Options.IPRateLimit
+	if limit == nil || !limit.enabled() || jr.Options.RateLimitStore == nil {
+		return nil
+	}
+
+	return jr.takeToken(ctx, route, "ip|ip:"+ctx.IP(), *limit)
+}
+
+// rateLimit takes a token from the bucket of the caller and tells how much is left in the RateLimit headers. A route
+// with a limit of its own has its own buckets, the others share the ones of the default limit. Callers are told apart
+// by the subject of their claims, an API key or a user, and by their IP on the public routes.
+func (jr *FastRouter) rateLimit(ctx *fiber.Ctx, route string, opts ...Option) error {
+	limit, scope := jr.Options.RateLimit, "default"
+	if routeLimit := routeRateLimit(opts...); routeLimit != nil {
+		limit, scope = routeLimit, route
+	}
+	if limit == nil || !limit.enabled() || jr.Options.RateLimitStore == nil {
+		return nil
+	}
+
+	caller := "ip:" + ctx.IP()
+	if claims, ok := ClaimsFromContext(ctx.UserContext()); ok && claims.Subject != "" {
+		caller = "sub:" + claims.Subject
+	}
+
+	return jr.takeToken(ctx, route, scope+"|"+caller, *limit)
+}
+
+// takeToken refuses the request with too many requests when the bucket is empty, a failing store lets the request
+// through rather than refusing every caller
+func (jr *FastRouter) takeToken(ctx *fiber.Ctx, route, key string, limit RateLimit) error {
+	res, err := jr.Options.RateLimitStore.Take(ctx.UserContext(), key, limit)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"route": route,
+			"key":   key,
+		}).WarnWithCtx(ctx.UserContext(), "[router.takeToken] Failed to take a token, the request is let through")
+		return nil
+	}
+
+	ctx.Set(HeaderRateLimitLimit, strconv.Itoa(limit.burst()))
+	ctx.Set(HeaderRateLimitRemaining, strconv.Itoa(res.Remaining))
+	ctx.Set(HeaderRateLimitReset, strconv.Itoa(ceilSeconds(res.Reset)))
+	ctx.Set(HeaderRateLimitPolicy, fmt.Sprintf("%d;w=%d", limit.Limit, ceilSeconds(limit.Period)))
+
+	if !res.Allowed {
+		ctx.Set(fiber.HeaderRetryAfter, strconv.Itoa(ceilSeconds(res.RetryAfter)))
+		return &custerr.ErrChain{
+			Message: "too many requests",
+			Code:    http.StatusTooManyRequests,
+			Type:    response.ErrTooManyRequest,
+		}
+	}
+
+	return nil
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int((d + time.Second - 1) / time.Second)
+}
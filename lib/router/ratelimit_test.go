@@ -0,0 +1,176 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"sagara_backend_test/lib/response/rest"
+	"testing"
+	"time"
+)
+
+// tokenAuthenticator knows a fixed set of bearer tokens
+type tokenAuthenticator map[string]*Claims
+
+func (a tokenAuthenticator) Authenticate(_ context.Context, req *Request) (*Claims, error) {
+	token := req.BearerToken()
+	if token == "" {
+		return nil, ErrNoCredentials
+	}
+
+	claims, ok := a[token]
+	if !ok {
+		return nil, errors.New("unknown token")
+	}
+	return claims, nil
+}
+
+func okHandler(context.Context, *Request) (*rest.JSONResponse, error) {
+	return rest.NewJSONResponse().SetData("ok"), nil
+}
+
+func doRequest(t *testing.T, r *FastRouter, method, path, token string) *http.Response {
+	t.Helper()
+	req := httptest.NewRequest(method, path, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := r.Test(req, 2000)
+	require.NoError(t, err)
+	return resp
+}
+
+func TestMemoryRateLimitStore_Take(t *testing.T) {
+	// one token per second, three at once
+	limit := RateLimit{Limit: 60, Period: time.Minute, Burst: 3}
+
+	tests := []struct {
+		name          string
+		advance       time.Duration
+		wantAllowed   bool
+		wantRemaining int
+		wantRetry     time.Duration
+		wantReset     time.Duration
+	}{
+		{name: "first request", wantAllowed: true, wantRemaining: 2, wantReset: time.Second},
+		{name: "second request", wantAllowed: true, wantRemaining: 1, wantReset: 2 * time.Second},
+		{name: "third request empties the burst", wantAllowed: true, wantRemaining: 0, wantReset: 3 * time.Second},
+		{name: "empty bucket", wantAllowed: false, wantRemaining: 0, wantRetry: time.Second, wantReset: 3 * time.Second},
+		{name: "half a token back", advance: 500 * time.Millisecond, wantAllowed: false, wantRetry: 500 * time.Millisecond, wantReset: 2500 * time.Millisecond},
+		{name: "a whole token back", advance: 500 * time.Millisecond, wantAllowed: true, wantRemaining: 0, wantReset: 3 * time.Second},
+		{name: "refill stops at the burst", advance: time.Hour, wantAllowed: true, wantRemaining: 2, wantReset: time.Second},
+	}
+
+	now := time.Date(2024, 9, 18, 9, 0, 0, 0, time.UTC)
+	store := &memoryRateLimitStore{buckets: map[string]*tokenBucket{}, now: func() time.Time { return now }}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now = now.Add(tt.advance)
+
+			res, err := store.Take(context.Background(), "caller", limit)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAllowed, res.Allowed)
+			assert.Equal(t, tt.wantRemaining, res.Remaining)
+			assert.InDelta(t, float64(tt.wantRetry), float64(res.RetryAfter), float64(time.Millisecond))
+			assert.InDelta(t, float64(tt.wantReset), float64(res.Reset), float64(time.Millisecond))
+		})
+	}
+
+	t.Run("other keys have their own bucket", func(t *testing.T) {
+		res, err := store.Take(context.Background(), "other", limit)
+		require.NoError(t, err)
+		assert.True(t, res.Allowed)
+		assert.Equal(t, 2, res.Remaining)
+	})
+
+	t.Run("burst defaults to the limit", func(t *testing.T) {
+		res, err := store.Take(context.Background(), "no-burst", RateLimit{Limit: 5, Period: time.Minute})
+		require.NoError(t, err)
+		assert.Equal(t, 4, res.Remaining)
+	})
+}
+
+func TestRateLimit_Headers(t *testing.T) {
+	r := New(&Options{RateLimit: &RateLimit{Limit: 2, Period: time.Minute}})
+	r.GET("/limited", okHandler, MustAuthorized(false))
+	r.GET("/unlimited", okHandler, MustAuthorized(false), WithRateLimit(RateLimit{}))
+
+	tests := []struct {
+		name           string
+		path           string
+		wantStatus     int
+		wantRemaining  string
+		wantReset      string
+		wantRetryAfter string
+	}{
+		{name: "first request", path: "/limited", wantStatus: http.StatusOK, wantRemaining: "1", wantReset: "30"},
+		{name: "second request", path: "/limited", wantStatus: http.StatusOK, wantRemaining: "0", wantReset: "60"},
+		{name: "refused", path: "/limited", wantStatus: http.StatusTooManyRequests, wantRemaining: "0", wantReset: "60", wantRetryAfter: "30"},
+		{name: "route without a limit", path: "/unlimited", wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := doRequest(t, r, http.MethodGet, tt.path, "")
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+			assert.Equal(t, tt.wantRemaining, resp.Header.Get(HeaderRateLimitRemaining))
+			assert.Equal(t, tt.wantReset, resp.Header.Get(HeaderRateLimitReset))
+			assert.Equal(t, tt.wantRetryAfter, resp.Header.Get("Retry-After"))
+			if tt.wantRemaining != "" {
+				assert.Equal(t, "2", resp.Header.Get(HeaderRateLimitLimit))
+				assert.Equal(t, "2;w=60", resp.Header.Get(HeaderRateLimitPolicy))
+			}
+		})
+	}
+}
+
+func TestRateLimit_PerCaller(t *testing.T) {
+	r := New(&Options{
+		Authenticator: tokenAuthenticator{
+			"alice": {Subject: "alice", Unrestricted: true},
+			"bob":   {Subject: "bob", Unrestricted: true},
+		},
+		RateLimit: &RateLimit{Limit: 1, Period: time.Minute},
+	})
+	r.GET("/items", okHandler)
+
+	assert.Equal(t, http.StatusOK, doRequest(t, r, http.MethodGet, "/items", "alice").StatusCode)
+	assert.Equal(t, http.StatusTooManyRequests, doRequest(t, r, http.MethodGet, "/items", "alice").StatusCode)
+	// same IP, but another caller
+	assert.Equal(t, http.StatusOK, doRequest(t, r, http.MethodGet, "/items", "bob").StatusCode)
+}
+
+func TestRateLimit_IPBeforeAuthentication(t *testing.T) {
+	r := New(&Options{
+		Authenticator: tokenAuthenticator{"valid": {Subject: "alice", Unrestricted: true}},
+		RateLimit:     &RateLimit{Limit: 100, Period: time.Minute},
+		IPRateLimit:   &RateLimit{Limit: 2, Period: time.Minute},
+	})
+	r.GET("/items", okHandler)
+
+	tests := []struct {
+		name       string
+		token      string
+		wantStatus int
+	}{
+		{name: "wrong token", token: "guess-1", wantStatus: http.StatusUnauthorized},
+		{name: "another wrong token", token: "guess-2", wantStatus: http.StatusUnauthorized},
+		{name: "guessing is limited", token: "guess-3", wantStatus: http.StatusTooManyRequests},
+		{name: "the IP is limited whatever the token", token: "valid", wantStatus: http.StatusTooManyRequests},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := doRequest(t, r, http.MethodGet, "/items", tt.token)
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+			if tt.wantStatus == http.StatusTooManyRequests {
+				assert.NotEmpty(t, resp.Header.Get("Retry-After"))
+			}
+		})
+	}
+}
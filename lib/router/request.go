@@ -2,6 +2,7 @@ package router
 
 import (
 	"github.com/valyala/fasthttp"
+	"mime/multipart"
 	"unsafe"
 )
 
@@ -48,6 +49,20 @@ func (r *Request) Header(key string, defaultValue ...string) string {
 	return defaultString(byteToString(r.req.Header.Peek(key)), defaultValue)
 }
 
+// FormFile returns the first file uploaded under the key of a multipart form, fasthttp.ErrMissingFile when there is none
+func (r *Request) FormFile(key string) (*multipart.FileHeader, error) {
+	form, err := r.req.MultipartForm()
+	if err != nil {
+		return nil, err
+	}
+
+	files := form.File[key]
+	if len(files) == 0 {
+		return nil, fasthttp.ErrMissingFile
+	}
+	return files[0], nil
+}
+
 func byteToString(b []byte) string {
 	return *(*string)(unsafe.Pointer(&b))
 }
@@ -6,8 +6,11 @@ import (
 	"fmt"
 	"github.com/getsentry/sentry-go"
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 	_ "github.com/newrelic/go-agent/v3/integrations/nrmysql"
 	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/valyala/fasthttp"
+	"io"
 	"net/http"
 	"sagara_backend_test/lib/custerr"
 	"sagara_backend_test/lib/response"
@@ -37,6 +40,20 @@ type (
 		CorsConfig       *CorsConfig
 		NewRelicOpts     *newrelicLib.Options
 		SentryConfig     *sentryLib.Config
+		// Authenticator verifies the callers of the routes that must be authorized, without one they are all refused
+		Authenticator Authenticator
+		// RateLimit applies to every caller of the routes without a limit of their own, nil does not limit
+		RateLimit *RateLimit
+		// IPRateLimit applies per IP before the credentials are checked, nil does not limit. Callers sharing an IP
+		// share it, it should stay well above RateLimit.
+		IPRateLimit *RateLimit
+		// RateLimitStore keeps the buckets of the rate limits, in memory when nil
+		RateLimitStore RateLimitStore
+		// ProxyHeader holds the IP of the client when the API is behind a proxy, the rate limits of the public routes
+		// are per IP. Only set it when the proxy overwrites the header, a client could send any IP otherwise.
+		ProxyHeader string
+		// IdempotencyStore keeps the responses of the idempotent routes, without one the Idempotency-Key is ignored
+		IdempotencyStore IdempotencyStore
 	}
 
 	CorsConfig struct {
@@ -78,12 +95,17 @@ func New(opt *Options) *FastRouter {
 		ErrorHandler:          errHandler,
 		DisableStartupMessage: true,
 		StreamRequestBody:     true,
+		ProxyHeader:           opt.ProxyHeader,
 	}
 
 	if opt.RequestBodyLimit > 0 {
 		config.BodyLimit = opt.RequestBodyLimit
 	}
 
+	if opt.RateLimitStore == nil {
+		opt.RateLimitStore = NewMemoryRateLimitStore()
+	}
+
 	app := fiber.New(config)
 
 	if opt.CorsConfig != nil {
@@ -134,11 +156,16 @@ func (jr *FastRouter) Group(prefix string, fn func(r *FastRouter)) {
 	nr := &FastRouter{
 		app: jr.app,
 		Options: &Options{
-			Prefix:         jr.Options.Prefix + prefix,
-			ReadTimeout:    jr.Options.ReadTimeout,
-			WriteTimeout:   jr.Options.WriteTimeout,
-			ErrorHandler:   jr.Options.ErrorHandler,
-			RequestTimeout: jr.Options.RequestTimeout,
+			Prefix:           jr.Options.Prefix + prefix,
+			ReadTimeout:      jr.Options.ReadTimeout,
+			WriteTimeout:     jr.Options.WriteTimeout,
+			ErrorHandler:     jr.Options.ErrorHandler,
+			RequestTimeout:   jr.Options.RequestTimeout,
+			Authenticator:    jr.Options.Authenticator,
+			RateLimit:        jr.Options.RateLimit,
+			IPRateLimit:      jr.Options.IPRateLimit,
+			RateLimitStore:   jr.Options.RateLimitStore,
+			IdempotencyStore: jr.Options.IdempotencyStore,
 		},
 		newRelic: jr.newRelic,
 	}
@@ -149,9 +176,14 @@ func (jr *FastRouter) Handle(method, path string, handler Handler[rest.JSONRespo
 	handle(method, path, handler, jr, opts...)
 }
 
+// HandleAttachment registers a handler answering with a file, its errors are rendered by the error handler
+func (jr *FastRouter) HandleAttachment(method, path string, handler Handler[rest.AttachmentResponse], opts ...Option) {
+	handle(method, path, handler, jr, opts...)
+}
+
 func handle[T rest.Response](method, path string, handler Handler[T], jr *FastRouter, opts ...Option) {
 	fullPath := jr.Options.Prefix + path
-	jr.app.Add(method, fullPath, func(ctx *fiber.Ctx) error {
+	jr.app.Add(method, fullPath, func(ctx *fiber.Ctx) (err error) {
 		timeout := jr.Options.RequestTimeout
 		if ok, tOut := isUsedSpecificTimeout(opts...); ok {
 			timeout = *tOut
@@ -163,6 +195,13 @@ func handle[T rest.Response](method, path string, handler Handler[T], jr *FastRo
 			ctx.SetUserContext(timeoutContext)
 		}
 
+		requestId := ctx.Get(tracing.RequestIDHeader)
+		if requestId == "" {
+			requestId = uuid.NewString()
+		}
+		ctx.Set(tracing.RequestIDHeader, requestId)
+		ctx.SetUserContext(tracing.ContextWithRequestID(ctx.UserContext(), requestId))
+
 		req := newRequest(&requestOptions{
 			Req:    ctx.Request(),
 			Params: ctx.AllParams(),
@@ -199,10 +238,45 @@ func handle[T rest.Response](method, path string, handler Handler[T], jr *FastRo
 		defOpts = append(defOpts, defaultMustAuthorized)
 		defOpts = append(defOpts, opts...)
 
-		respChan := make(chan handlerResult[T])
+		if isMustAuthorized(defOpts...) {
+			if err := jr.rateLimitIP(ctx, method+" "+fullPath); err != nil {
+				return err
+			}
+
+			authCtx, err := jr.authorize(ctx.UserContext(), req, defOpts...)
+			if err != nil {
+				if rest.GetErrorCode(err) == http.StatusUnauthorized {
+					ctx.Set(fiber.HeaderWWWAuthenticate, "Bearer")
+				}
+				return err
+			}
+			ctx.SetUserContext(authCtx)
+		}
+
+		if err := jr.rateLimit(ctx, method+" "+fullPath, defOpts...); err != nil {
+			return err
+		}
+
+		idempotencyKey, replayed, err := jr.beginIdempotent(ctx, defOpts...)
+		if err != nil || replayed {
+			return err
+		}
+		if idempotencyKey != "" {
+			defer func() {
+				// a key handed over to a handler that outlived the request is completed by it
+				if idempotencyKey != "" {
+					err = jr.completeIdempotent(ctx, idempotencyKey, err)
+				}
+			}()
+		}
 
+		// buffered so the handler never waits on a result that was given up on
+		respChan := make(chan handlerResult[T], 1)
+
+		// fiber reuses ctx once the route returned, which a timeout makes happen while the handler still runs
+		userCtx := ctx.UserContext()
 		go func() {
-			result, err := panicHandler(handler)(ctx.UserContext(), req)
+			result, err := panicHandler(handler)(userCtx, req)
 			respChan <- handlerResult[T]{
 				Resp: result,
 				Err:  err,
@@ -211,41 +285,88 @@ func handle[T rest.Response](method, path string, handler Handler[T], jr *FastRo
 
 		select {
 		case <-ctx.UserContext().Done():
+			if idempotencyKey != "" {
+				// the handler may still apply the request, its key stays claimed until what it did is stored
+				go completeAbandoned(jr, context.WithoutCancel(userCtx), idempotencyKey, respChan)
+				idempotencyKey = ""
+			} else {
+				go discardResult(respChan)
+			}
 			if errors.Is(ctx.UserContext().Err(), context.DeadlineExceeded) {
 				return fiber.ErrRequestTimeout
 			}
 		case resp := <-respChan:
-			result := resp.Resp
-			err := resp.Err
+			return sendResult(ctx, resp)
+		}
 
-			if err != nil {
-				if errors.Is(err, context.DeadlineExceeded) {
-					return fiber.ErrRequestTimeout
-				}
-				// send to global error handler
-				return err
-			}
+		// can't get result response, set to internal server error
+		return &custerr.ErrChain{
+			Message: "Internal server error",
+			Code:    http.StatusInternalServerError,
+			Type:    response.ErrInternalServerError,
+		}
+	})
+}
 
-			if result == nil {
-				// something went wrong when result is nil
-				return &custerr.ErrChain{
-					Message: "Internal server error",
-					Code:    http.StatusInternalServerError,
-					Type:    response.ErrInternalServerError,
-				}
-			}
+// sendResult writes what the handler returned, its errors are left to the error handler
+func sendResult[T rest.Response](ctx *fiber.Ctx, resp handlerResult[T]) error {
+	result := resp.Resp
+	err := resp.Err
 
-			sendRes := *result
-			return sendRes.Send(ctx)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return fiber.ErrRequestTimeout
 		}
+		// send to global error handler
+		return err
+	}
 
-		// can't get result response, set to internal server error
+	if result == nil {
+		// something went wrong when result is nil
 		return &custerr.ErrChain{
 			Message: "Internal server error",
 			Code:    http.StatusInternalServerError,
 			Type:    response.ErrInternalServerError,
 		}
-	})
+	}
+
+	sendRes := *result
+	return sendRes.Send(ctx)
+}
+
+// discardResult closes the file of a result that will never be sent, whatever writes the file would wait on it forever
+func discardResult[T rest.Response](respChan <-chan handlerResult[T]) {
+	closeAttachment(<-respChan)
+}
+
+// closeAttachment closes the file of an attachment result, it tells whether the result was one
+func closeAttachment[T rest.Response](resp handlerResult[T]) bool {
+	attachment, ok := any(resp.Resp).(*rest.AttachmentResponse)
+	if ok && attachment != nil {
+		if closer, ok := attachment.File.(io.Closer); ok {
+			_ = closer.Close()
+		}
+	}
+	return ok
+}
+
+// completeAbandoned waits for a handler that outlived its request and stores its outcome under the idempotency key the
+// way an answered request is. A retry meanwhile is refused as in progress instead of applying the request twice.
+func completeAbandoned[T rest.Response](jr *FastRouter, ctx context.Context, key string, respChan <-chan handlerResult[T]) {
+	resp := <-respChan
+
+	c := jr.app.AcquireCtx(&fasthttp.RequestCtx{})
+	defer jr.app.ReleaseCtx(c)
+	c.SetUserContext(ctx)
+
+	// files are never stored
+	var err error
+	if closeAttachment(resp) {
+		err = fiber.ErrRequestTimeout
+	} else {
+		err = sendResult(c, resp)
+	}
+	_ = jr.completeIdempotent(c, key, err)
 }
 
 func (jr *FastRouter) CustomHandler(method, path string, handler fiber.Handler, opts ...Option) {
@@ -293,6 +414,28 @@ func (jr *FastRouter) CustomHandler(method, path string, handler fiber.Handler,
 		defOpts = append(defOpts, defaultMustAuthorized)
 		defOpts = append(defOpts, opts...)
 
+		if isMustAuthorized(defOpts...) {
+			if err := jr.rateLimitIP(ctx, method+" "+fullPath); err != nil {
+				return err
+			}
+
+			authCtx, err := jr.authorize(ctx.UserContext(), newRequest(&requestOptions{
+				Req:    ctx.Request(),
+				Params: ctx.AllParams(),
+			}), defOpts...)
+			if err != nil {
+				if rest.GetErrorCode(err) == http.StatusUnauthorized {
+					ctx.Set(fiber.HeaderWWWAuthenticate, "Bearer")
+				}
+				return err
+			}
+			ctx.SetUserContext(authCtx)
+		}
+
+		if err := jr.rateLimit(ctx, method+" "+fullPath, defOpts...); err != nil {
+			return err
+		}
+
 		respChan := make(chan error)
 
 		go func() {
@@ -9,6 +9,10 @@ var (
 type option struct {
 	mustAuthorized bool
 	requestTimeout *time.Duration
+	scopes         []string
+	permissions    []string
+	rateLimit      *RateLimit
+	idempotent     bool
 }
 
 type Option interface {
@@ -36,6 +40,38 @@ func WithTimeout(val time.Duration) OptionFn {
 	}
 }
 
+// RequireScope refuses with forbidden the callers whose claims miss one of the scopes, it only applies to the routes
+// that must be authorized
+func RequireScope(scopes ...string) OptionFn {
+	return func(opt *option) {
+		opt.scopes = append(opt.scopes, scopes...)
+	}
+}
+
+// RequirePermission refuses with forbidden the callers whose role misses one of the permissions, it only applies to the
+// routes that must be authorized
+func RequirePermission(permissions ...string) OptionFn {
+	return func(opt *option) {
+		opt.permissions = append(opt.permissions, permissions...)
+	}
+}
+
+// WithRateLimit gives the route buckets of its own with the limit in place of the default limit of the router, a zero
+// RateLimit leaves the route unlimited
+func WithRateLimit(limit RateLimit) OptionFn {
+	return func(opt *option) {
+		opt.rateLimit = &limit
+	}
+}
+
+// Idempotent remembers the response of the requests sent with an Idempotency-Key header, a retry with the same key
+// gets it again without the handler being run. It needs the IdempotencyStore of the router.
+func Idempotent() OptionFn {
+	return func(opt *option) {
+		opt.idempotent = true
+	}
+}
+
 func isMustAuthorized(opts ...Option) bool {
 	opt := &option{
 		mustAuthorized: false,
@@ -53,3 +89,35 @@ func isUsedSpecificTimeout(opts ...Option) (bool, *time.Duration) {
 	}
 	return opt.requestTimeout != nil, opt.requestTimeout
 }
+
+func requiredScopes(opts ...Option) []string {
+	opt := &option{}
+	for _, op := range opts {
+		op.Apply(opt)
+	}
+	return opt.scopes
+}
+
+func requiredPermissions(opts ...Option) []string {
+	opt := &option{}
+	for _, op := range opts {
+		op.Apply(opt)
+	}
+	return opt.permissions
+}
+
+func routeRateLimit(opts ...Option) *RateLimit {
+	opt := &option{}
+	for _, op := range opts {
+		op.Apply(opt)
+	}
+	return opt.rateLimit
+}
+
+func isIdempotent(opts ...Option) bool {
+	opt := &option{}
+	for _, op := range opts {
+		op.Apply(opt)
+	}
+	return opt.idempotent
+}
@@ -0,0 +1,21 @@
+package tracing
+
+import "context"
+
+// RequestIDHeader carries the id of a request, the router generates one when the caller did not send it
+const RequestIDHeader = "X-Request-ID"
+
+type requestIDKey struct{}
+
+func ContextWithRequestID(ctx context.Context, requestId string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestId)
+}
+
+// RequestIDFromContext returns the id of the request the context was made for, empty outside of a request
+func RequestIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	requestId, _ := ctx.Value(requestIDKey{}).(string)
+	return requestId
+}
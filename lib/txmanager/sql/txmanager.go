@@ -35,6 +35,11 @@ func NewTxManager(_ context.Context, config any) (txmanager.TxManager, error) {
 }
 
 func (m *manager) Execute(ctx context.Context, fn txmanager.TxFn, opts any) (result any, err error) {
+	if utils.GetSqlTx(ctx) != nil {
+		// already inside a transaction, join it and let the outermost Execute commit or rollback
+		return fn(ctx)
+	}
+
 	var txOpts *sql.TxOptions
 
 	if opts != nil {
@@ -75,3 +80,32 @@ func (m *manager) Execute(ctx context.Context, fn txmanager.TxFn, opts any) (res
 	result, err = fn(txCtx)
 	return result, err
 }
+
+// Savepoint runs fn inside a savepoint of the transaction in ctx, when fn fails only its own changes are rolled back
+// and the transaction can carry on. It must be called within Execute, name must be a plain sql identifier.
+func Savepoint(ctx context.Context, name string, fn txmanager.TxFn) (any, error) {
+	sqlTx := utils.GetSqlTx(ctx)
+	if sqlTx == nil {
+		return nil, errors.New("savepoint needs a transaction")
+	}
+
+	_, err := sqlTx.ExecContext(ctx, "SAVEPOINT "+name)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := fn(ctx)
+	if err != nil {
+		_, rollbackErr := sqlTx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name)
+		if rollbackErr != nil {
+			return nil, rollbackErr
+		}
+		return nil, err
+	}
+
+	_, err = sqlTx.ExecContext(ctx, "RELEASE SAVEPOINT "+name)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -6,6 +6,14 @@ import "sagara_backend_test/cmd"
 // @version 1.0.0
 // @description Wardrobe System Service.
 // @BasePath /
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description Bearer token of the caller, as "Bearer <token>"
+// @securityDefinitions.apikey ApiKeyAuth
+// @in header
+// @name X-API-Key
+// @description API key of a machine caller, created through /v1/api-keys
 func main() {
 	cmd.Execute()
 }
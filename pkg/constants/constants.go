@@ -3,3 +3,53 @@ package constants
 const (
 	EmptyString = ""
 )
+
+const (
+	TagMatchAny = "any"
+	TagMatchAll = "all"
+)
+
+// DefaultCurrency is the ISO 4217 code of prices given without a currency
+const DefaultCurrency = "IDR"
+
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+// MaxBatchOperations is the most operations a single batch request can carry
+const MaxBatchOperations = 500
+
+// MaxOrderLines is the most lines a single order can carry
+const MaxOrderLines = 100
+
+// Scopes limit what an API key or a token can call, a route declaring one refuses the callers without it
+const (
+	ScopeWardrobeRead   = "wardrobe:read"
+	ScopeWardrobeWrite  = "wardrobe:write"
+	ScopeStockAdjust    = "stock:adjust"
+	ScopeSettingsManage = "settings:manage"
+	ScopeAPIKeysManage  = "api-keys:manage"
+	ScopeRolesManage    = "roles:manage"
+)
+
+// Scopes are every scope an API key can be given
+var Scopes = []string{ScopeWardrobeRead, ScopeWardrobeWrite, ScopeStockAdjust, ScopeSettingsManage, ScopeAPIKeysManage, ScopeRolesManage}
+
+// Permissions come with the role of the caller, a route declaring one refuses the callers whose role lacks it. Unlike
+// the scopes, which the caller chooses to narrow a key or a token, they say what the caller is trusted with.
+const (
+	PermissionWardrobeRead   = "wardrobe.read"
+	PermissionWardrobeCreate = "wardrobe.create"
+	PermissionWardrobeUpdate = "wardrobe.update"
+	// PermissionWardrobePrice is needed on top of PermissionWardrobeUpdate to change the price of a wardrobe
+	PermissionWardrobePrice  = "wardrobe.price"
+	PermissionWardrobeDelete = "wardrobe.delete"
+	PermissionStockAdjust    = "stock.adjust"
+	PermissionCatalogManage  = "catalog.manage"
+	PermissionOrdersManage   = "orders.manage"
+	PermissionSettingsManage = "settings.manage"
+	PermissionAuditRead      = "audit.read"
+	PermissionAPIKeysManage  = "api-keys.manage"
+	PermissionRolesManage    = "roles.manage"
+)
@@ -30,4 +30,272 @@ var (
 		Code:    40007,
 		Message: "Stock is Empty",
 	}
+	AmountInvalid = ErrorDefinition{
+		Code:    40008,
+		Message: "Amount must be greater than zero",
+	}
+	StockInsufficient = ErrorDefinition{
+		Code:    40009,
+		Message: "Stock is not enough",
+	}
+	VersionMismatch = ErrorDefinition{
+		Code:    41201,
+		Message: "Wardrobe has been modified, reload it and try again",
+	}
+	ImportTooManyRows = ErrorDefinition{
+		Code:    41301,
+		Message: "Import file has more rows than allowed",
+	}
+	VersionRequired = ErrorDefinition{
+		Code:    42801,
+		Message: "If-Match header is required",
+	}
+	QuantityInvalid = ErrorDefinition{
+		Code:    40010,
+		Message: "Quantity must be greater than zero",
+	}
+	TTLInvalid = ErrorDefinition{
+		Code:    40011,
+		Message: "TTL is out of the allowed range",
+	}
+	ReservationNotActive = ErrorDefinition{
+		Code:    40012,
+		Message: "Reservation is no longer active",
+	}
+	CategoryCycle = ErrorDefinition{
+		Code:    40013,
+		Message: "Category can not be moved under itself or its descendants",
+	}
+	TagMatchInvalid = ErrorDefinition{
+		Code:    40014,
+		Message: "Tag match must be either any or all",
+	}
+	TagEmpty = ErrorDefinition{
+		Code:    40015,
+		Message: "Tag is empty",
+	}
+	LimitInvalid = ErrorDefinition{
+		Code:    40016,
+		Message: "Limit must be between 1 and 100",
+	}
+	SortInvalid = ErrorDefinition{
+		Code:    40017,
+		Message: "Sort contains a field that can not be sorted by",
+	}
+	CursorInvalid = ErrorDefinition{
+		Code:    40018,
+		Message: "Cursor is invalid or does not match the sort",
+	}
+	PriceNegative = ErrorDefinition{
+		Code:    40019,
+		Message: "Price must not be negative",
+	}
+	CurrencyInvalid = ErrorDefinition{
+		Code:    40020,
+		Message: "Currency must be a three letter ISO 4217 code",
+	}
+	ScheduleInvalid = ErrorDefinition{
+		Code:    40021,
+		Message: "Effective from must be in the future and before effective until",
+	}
+	ImportFormatInvalid = ErrorDefinition{
+		Code:    40022,
+		Message: "Import file must be a csv or xlsx file",
+	}
+	ImportFileInvalid = ErrorDefinition{
+		Code:    40023,
+		Message: "Import file can not be read",
+	}
+	ImportFileEmpty = ErrorDefinition{
+		Code:    40024,
+		Message: "Import file has no rows below its header",
+	}
+	ImportColumnInvalid = ErrorDefinition{
+		Code:    40025,
+		Message: "Import header has an unknown or repeated column, the columns are sku, name, color, size, price, currency, stock and product_id",
+	}
+	ImportFileMissing = ErrorDefinition{
+		Code:    40026,
+		Message: "Import file must be uploaded in the file field of a multipart form",
+	}
+	ExportFormatInvalid = ErrorDefinition{
+		Code:    40027,
+		Message: "Export format must be csv, xlsx or json",
+	}
+	BatchModeInvalid = ErrorDefinition{
+		Code:    40028,
+		Message: "Batch mode must be either atomic or best_effort",
+	}
+	BatchSizeInvalid = ErrorDefinition{
+		Code:    40029,
+		Message: "Batch must have between 1 and 500 operations",
+	}
+	BatchOperationInvalid = ErrorDefinition{
+		Code:    40030,
+		Message: "Operation must be create, update, delete or adjust_stock with the payload it needs",
+	}
+	AuditEntityInvalid = ErrorDefinition{
+		Code:    40031,
+		Message: "Entity must be wardrobe",
+	}
+	AuditRangeInvalid = ErrorDefinition{
+		Code:    40032,
+		Message: "From and to must be RFC 3339 times with from not after to",
+	}
+	AlertRuleTargetInvalid = ErrorDefinition{
+		Code:    40033,
+		Message: "Alert rule must target either a wardrobe or a category",
+	}
+	AlertThresholdInvalid = ErrorDefinition{
+		Code:    40034,
+		Message: "Alert threshold must be positive",
+	}
+	AlertStatusInvalid = ErrorDefinition{
+		Code:    40035,
+		Message: "Alert status must be open, acknowledged or resolved",
+	}
+	WebhookURLInvalid = ErrorDefinition{
+		Code:    40036,
+		Message: "Webhook url must be an absolute http or https url",
+	}
+	WebhookEventInvalid = ErrorDefinition{
+		Code:    40037,
+		Message: "Webhook event types must be among wardrobe.created, wardrobe.updated, wardrobe.deleted, wardrobe.restored and wardrobe.stock_changed",
+	}
+	LocationStockInsufficient = ErrorDefinition{
+		Code:    40038,
+		Message: "Stock at the location is not enough",
+	}
+	LocationInvalid = ErrorDefinition{
+		Code:    40039,
+		Message: "Location must have a code, a name and a kind of store or warehouse",
+	}
+	TransferInvalid = ErrorDefinition{
+		Code:    40040,
+		Message: "Transfer must move a positive quantity between two different locations",
+	}
+	TransferStatusInvalid = ErrorDefinition{
+		Code:    40041,
+		Message: "Transfer status must be in_transit, received or cancelled",
+	}
+	PurchaseOrderInvalid = ErrorDefinition{
+		Code:    40042,
+		Message: "Purchase order must have a supplier and between 1 and 100 lines of distinct wardrobes with a positive quantity",
+	}
+	PurchaseOrderStatusInvalid = ErrorDefinition{
+		Code:    40043,
+		Message: "Purchase order status must be draft, sent, partially_received, received or cancelled",
+	}
+	ReceiptInvalid = ErrorDefinition{
+		Code:    40044,
+		Message: "Receipt must have lines of distinct purchase order lines with a positive quantity",
+	}
+	ReceiptOverOutstanding = ErrorDefinition{
+		Code:    40045,
+		Message: "Received quantity is more than what is outstanding on the line",
+	}
+	SalesOrderInvalid = ErrorDefinition{
+		Code:    40046,
+		Message: "Sales order must have between 1 and 100 lines of distinct wardrobes with a positive quantity",
+	}
+	SalesOrderStockInsufficient = ErrorDefinition{
+		Code:    40047,
+		Message: "Stock is not enough for every line of the sales order",
+	}
+	SalesOrderCurrencyMismatch = ErrorDefinition{
+		Code:    40048,
+		Message: "Every wardrobe of a sales order must be priced in the same currency",
+	}
+	SalesOrderStatusInvalid = ErrorDefinition{
+		Code:    40049,
+		Message: "Status must be placed, fulfilled or cancelled",
+	}
+	SalesOrderRangeInvalid = ErrorDefinition{
+		Code:    40050,
+		Message: "From and to must be RFC 3339 times with from not after to",
+	}
+	APIKeyInvalid = ErrorDefinition{
+		Code:    40051,
+		Message: "API key needs a name, at least one known scope and an expiry in the future",
+	}
+	RoleAssignmentInvalid = ErrorDefinition{
+		Code:    40052,
+		Message: "Role assignment needs a subject and one of the roles admin, manager, clerk or read-only",
+	}
+	APIKeyRejected = ErrorDefinition{
+		Code:    40101,
+		Message: "API key is unknown, revoked or expired",
+	}
+	PriceChangeForbidden = ErrorDefinition{
+		Code:    40301,
+		Message: "Changing the price of a wardrobe needs the wardrobe.price permission",
+	}
+	PermissionMissing = ErrorDefinition{
+		Code:    40302,
+		Message: "The role of the caller does not allow the operation",
+	}
+	ScopeMissing = ErrorDefinition{
+		Code:    40303,
+		Message: "The scopes of the credentials do not allow the operation",
+	}
+	ProductInUse = ErrorDefinition{
+		Code:    40901,
+		Message: "Product still has variants",
+	}
+	SKUDuplicate = ErrorDefinition{
+		Code:    40902,
+		Message: "SKU is already used by another variant",
+	}
+	CategoryInUse = ErrorDefinition{
+		Code:    40903,
+		Message: "Category still has subcategories or wardrobes",
+	}
+	ScheduleOverlap = ErrorDefinition{
+		Code:    40904,
+		Message: "Price schedule overlaps another pending or active schedule",
+	}
+	ScheduleNotPending = ErrorDefinition{
+		Code:    40905,
+		Message: "Only pending price schedules can be cancelled",
+	}
+	AlertNotOpen = ErrorDefinition{
+		Code:    40906,
+		Message: "Only open alerts can be acknowledged",
+	}
+	LocationInUse = ErrorDefinition{
+		Code:    40907,
+		Message: "Location is the default one, still holds stock or has transfers",
+	}
+	LocationCodeDuplicate = ErrorDefinition{
+		Code:    40908,
+		Message: "Location code is already used by another location",
+	}
+	TransferNotInTransit = ErrorDefinition{
+		Code:    40909,
+		Message: "Only transfers in transit can be received or cancelled",
+	}
+	PurchaseOrderNotDraft = ErrorDefinition{
+		Code:    40910,
+		Message: "Only draft purchase orders can be edited or sent",
+	}
+	PurchaseOrderNotOpen = ErrorDefinition{
+		Code:    40911,
+		Message: "Goods can only be received for sent or partially received purchase orders",
+	}
+	PurchaseOrderClosed = ErrorDefinition{
+		Code:    40912,
+		Message: "Received or cancelled purchase orders can not be cancelled",
+	}
+	SalesOrderNotPlaced = ErrorDefinition{
+		Code:    40913,
+		Message: "Only placed sales orders can be fulfilled or cancelled",
+	}
+	IdempotencyKeyReused = ErrorDefinition{
+		Code:    40914,
+		Message: "Idempotency key was already used for a different request",
+	}
+	IdempotencyKeyInProgress = ErrorDefinition{
+		Code:    40915,
+		Message: "A request with the same idempotency key is still being processed",
+	}
 )